@@ -0,0 +1,124 @@
+package apitest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+const (
+	// The address base used by mock requester contexts
+	MockAddressBase string = "http://mock"
+)
+
+// A requester context that returns canned responses instead of sending requests to a server, for testing API clients.
+// Responses are keyed by the request path relative to the address base (e.g. "route/method"), without the query
+// string. Requests for paths without a response get a 404.
+type MockRequesterContext struct {
+	// The paths of every request sent through the context, in order
+	Calls []string
+
+	responses map[string]*http.Response
+	bodies    map[string][]byte
+	logger    *slog.Logger
+	lock      *sync.Mutex
+}
+
+// Creates a new mock requester context that returns the provided responses.
+// Each response can be returned any number of times; its body is read once and replayed for every request.
+func NewMockRequesterContext(responses map[string]*http.Response) *MockRequesterContext {
+	if responses == nil {
+		responses = map[string]*http.Response{}
+	}
+	return &MockRequesterContext{
+		Calls:     []string{},
+		responses: responses,
+		bodies:    map[string][]byte{},
+		logger:    log.NewNopLogger().Logger,
+		lock:      &sync.Mutex{},
+	}
+}
+
+// Creates a response with the provided status code and a JSON-serialized body
+func NewJsonResponse(statusCode int, body any) (*http.Response, error) {
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing response body: %w", err)
+	}
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode: statusCode,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(string(bytes))),
+	}, nil
+}
+
+// Creates a successful response containing the provided data
+func NewSuccessResponse[DataType any](data *DataType) (*http.Response, error) {
+	return NewJsonResponse(http.StatusOK, types.ApiResponse[DataType]{
+		Data: data,
+	})
+}
+
+// Get the base of the address used for submitting server requests
+func (r *MockRequesterContext) GetAddressBase() string {
+	return MockAddressBase
+}
+
+// Get the logger for the context
+func (r *MockRequesterContext) GetLogger() *slog.Logger {
+	return r.logger
+}
+
+// Set the logger for the context
+func (r *MockRequesterContext) SetLogger(logger *slog.Logger) {
+	r.logger = logger
+}
+
+// Record the request and return the canned response for its path
+func (r *MockRequesterContext) SendRequest(request *http.Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	path := strings.TrimPrefix(request.URL.Path, "/")
+	r.Calls = append(r.Calls, path)
+
+	response, exists := r.responses[path]
+	if !exists {
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", http.StatusNotFound, http.StatusText(http.StatusNotFound)),
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{},
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+			Request:    request,
+		}, nil
+	}
+
+	// Read the body the first time the response is used so it can be replayed
+	body, exists := r.bodies[path]
+	if !exists {
+		if response.Body != nil {
+			var err error
+			body, err = io.ReadAll(response.Body)
+			if err != nil {
+				return nil, fmt.Errorf("error reading mock response body for [%s]: %w", path, err)
+			}
+			_ = response.Body.Close()
+		}
+		r.bodies[path] = body
+	}
+
+	clone := *response
+	clone.Header = response.Header.Clone()
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+	clone.Request = request
+	return &clone, nil
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"compress/gzip"
 	"fmt"
 	"io"
 	"log/slog"
@@ -10,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/goccy/go-json"
+	"github.com/google/uuid"
 	"github.com/rocket-pool/node-manager-core/api/types"
 	"github.com/rocket-pool/node-manager-core/beacon"
 	"github.com/rocket-pool/node-manager-core/log"
@@ -29,10 +31,32 @@ func SendGetRequest[DataType any](r IRequester, method string, requestName strin
 	return response, nil
 }
 
+// Submit a DELETE request to the API server
+func SendDeleteRequest[DataType any](r IRequester, method string, requestName string, args map[string]string) (*types.ApiResponse[DataType], error) {
+	if args == nil {
+		args = map[string]string{}
+	}
+	response, err := RawDeleteRequest[DataType](r.GetContext(), fmt.Sprintf("%s/%s", r.GetRoute(), method), args)
+	if err != nil {
+		return nil, fmt.Errorf("error during %s %s request: %w", r.GetName(), requestName, err)
+	}
+	return response, nil
+}
+
 // Submit a GET request to the API server
 func RawGetRequest[DataType any](context IRequesterContext, path string, params map[string]string) (*types.ApiResponse[DataType], error) {
+	return rawQueryRequest[DataType](context, http.MethodGet, path, params)
+}
+
+// Submit a DELETE request to the API server
+func RawDeleteRequest[DataType any](context IRequesterContext, path string, params map[string]string) (*types.ApiResponse[DataType], error) {
+	return rawQueryRequest[DataType](context, http.MethodDelete, path, params)
+}
+
+// Submit a request with its parameters in the query string to the API server
+func rawQueryRequest[DataType any](context IRequesterContext, httpMethod string, path string, params map[string]string) (*types.ApiResponse[DataType], error) {
 	// Create the request
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", context.GetAddressBase(), path), nil)
+	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%s/%s", context.GetAddressBase(), path), nil)
 	if err != nil {
 		return nil, fmt.Errorf("error creating HTTP request: %w", err)
 	}
@@ -43,15 +67,88 @@ func RawGetRequest[DataType any](context IRequesterContext, path string, params
 		values.Add(name, value)
 	}
 	req.URL.RawQuery = values.Encode()
+	req.Header.Set("Accept-Encoding", gzipEncoding)
+	requestId := setRequestId(req)
 
 	// Debug log
-	context.GetLogger().Debug("API Request", slog.String(log.MethodKey, http.MethodGet), slog.String(log.QueryKey, req.URL.String()))
+	context.GetLogger().Debug("API Request", slog.String(log.MethodKey, httpMethod), slog.String(log.QueryKey, req.URL.String()), slog.String(log.RequestIdKey, requestId))
 
 	// Run the request
 	resp, err := context.SendRequest(req)
 	return HandleResponse[DataType](context, resp, path, err)
 }
 
+// Submit a GET request to the API server for a single page of a paginated route
+func SendPaginatedGetRequest[DataType any](r IRequester, method string, requestName string, args map[string]string, limit uint64, offset uint64) (*types.ApiResponse[types.PaginatedResponse[DataType]], error) {
+	pageArgs := getPageArgs(args, limit)
+	pageArgs[types.PaginationOffsetArg] = strconv.FormatUint(offset, 10)
+	return SendGetRequest[types.PaginatedResponse[DataType]](r, method, requestName, pageArgs)
+}
+
+// Submit a GET request to the API server for a single page of a paginated route that pages by cursor. Use the cursor
+// returned with the previous page, or an empty cursor for the first page.
+func SendPaginatedGetRequestWithCursor[DataType any](r IRequester, method string, requestName string, args map[string]string, limit uint64, cursor string) (*types.ApiResponse[types.PaginatedResponse[DataType]], error) {
+	pageArgs := getPageArgs(args, limit)
+	if cursor != "" {
+		pageArgs[types.PaginationCursorArg] = cursor
+	}
+	return SendGetRequest[types.PaginatedResponse[DataType]](r, method, requestName, pageArgs)
+}
+
+// Submit GET requests to the API server for each page of a paginated route, returning all of the items in the collection.
+// This follows the cursors returned by routes that page by cursor, and uses offsets otherwise.
+// Use a limit of 0 to use the server's default page size.
+func SendPaginatedGetRequestAll[DataType any](r IRequester, method string, requestName string, args map[string]string, limit uint64) ([]DataType, error) {
+	items := []DataType{}
+	var offset uint64
+	cursor := ""
+	for {
+		var response *types.ApiResponse[types.PaginatedResponse[DataType]]
+		var err error
+		if cursor != "" {
+			response, err = SendPaginatedGetRequestWithCursor[DataType](r, method, requestName, args, limit, cursor)
+		} else {
+			response, err = SendPaginatedGetRequest[DataType](r, method, requestName, args, limit, offset)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if response.Data == nil {
+			return nil, fmt.Errorf("error during %s %s request: a page response did not contain any data", r.GetName(), requestName)
+		}
+		page := response.Data
+		items = append(items, page.Items...)
+		if !page.HasMore {
+			return items, nil
+		}
+
+		// Follow the cursor if the route returned one, making sure the pages are moving forward
+		if page.Cursor != "" {
+			if page.Cursor == cursor {
+				return items, nil
+			}
+			cursor = page.Cursor
+			continue
+		}
+		if cursor != "" || page.NextOffset <= offset {
+			return items, nil
+		}
+		offset = page.NextOffset
+	}
+}
+
+// Copy the args for a paginated request, adding the page limit if one is provided
+func getPageArgs(args map[string]string, limit uint64) map[string]string {
+	pageArgs := map[string]string{}
+	for name, value := range args {
+		pageArgs[name] = value
+	}
+	if limit > 0 {
+		pageArgs[types.PaginationLimitArg] = strconv.FormatUint(limit, 10)
+	}
+	return pageArgs
+}
+
 // Submit a POST request to the API server
 func SendPostRequest[DataType any](r IRequester, method string, requestName string, body any) (*types.ApiResponse[DataType], error) {
 	// Serialize the body
@@ -67,17 +164,44 @@ func SendPostRequest[DataType any](r IRequester, method string, requestName stri
 	return response, nil
 }
 
+// Submit a PUT request to the API server
+func SendPutRequest[DataType any](r IRequester, method string, requestName string, body any) (*types.ApiResponse[DataType], error) {
+	// Serialize the body
+	bytes, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing request body for %s %s: %w", r.GetName(), requestName, err)
+	}
+
+	response, err := RawPutRequest[DataType](r.GetContext(), fmt.Sprintf("%s/%s", r.GetRoute(), method), string(bytes))
+	if err != nil {
+		return nil, fmt.Errorf("error during %s %s request: %w", r.GetName(), requestName, err)
+	}
+	return response, nil
+}
+
 // Submit a POST request to the API server
 func RawPostRequest[DataType any](context IRequesterContext, path string, body string) (*types.ApiResponse[DataType], error) {
+	return rawBodyRequest[DataType](context, http.MethodPost, path, body)
+}
+
+// Submit a PUT request to the API server
+func RawPutRequest[DataType any](context IRequesterContext, path string, body string) (*types.ApiResponse[DataType], error) {
+	return rawBodyRequest[DataType](context, http.MethodPut, path, body)
+}
+
+// Submit a request with a JSON body to the API server
+func rawBodyRequest[DataType any](context IRequesterContext, httpMethod string, path string, body string) (*types.ApiResponse[DataType], error) {
 	// Create the request
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s", context.GetAddressBase(), path), strings.NewReader(body))
+	req, err := http.NewRequest(httpMethod, fmt.Sprintf("%s/%s", context.GetAddressBase(), path), strings.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("error creating HTTP request: %w", err)
 	}
 	req.Header.Set("Content-Type", jsonContentType)
+	req.Header.Set("Accept-Encoding", gzipEncoding)
+	requestId := setRequestId(req)
 
 	// Debug log
-	context.GetLogger().Debug("API Request", slog.String(log.MethodKey, http.MethodPost), slog.String(log.PathKey, path), slog.String(log.BodyKey, body))
+	context.GetLogger().Debug("API Request", slog.String(log.MethodKey, httpMethod), slog.String(log.PathKey, path), slog.String(log.BodyKey, body), slog.String(log.RequestIdKey, requestId))
 
 	// Run the request
 	resp, err := context.SendRequest(req)
@@ -91,31 +215,46 @@ func HandleResponse[DataType any](context IRequesterContext, resp *http.Response
 	}
 	logger := context.GetLogger()
 
-	// Read the body
+	// Read the body, decompressing it if necessary
 	defer resp.Body.Close()
-	bytes, err := io.ReadAll(resp.Body)
+	var body io.Reader = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), gzipEncoding) {
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing the response body for %s: %w", path, err)
+		}
+		defer gzipReader.Close()
+		body = gzipReader
+	}
+	bytes, err := io.ReadAll(body)
 	if err != nil {
 		return nil, fmt.Errorf("error reading the response body for %s: %w", path, err)
 	}
 
-	// Handle 404s specially since they won't have a JSON body
-	if resp.StatusCode == http.StatusNotFound {
-		logger.Debug("API Response (raw)", slog.String(log.CodeKey, resp.Status), slog.String(log.BodyKey, string(bytes)))
-		return nil, fmt.Errorf("route '%s' not found", path)
-	}
-
 	// Deserialize the response into the provided type
 	var parsedResponse types.ApiResponse[DataType]
 	err = json.Unmarshal(bytes, &parsedResponse)
 	if err != nil {
 		logger.Debug("API Response (raw)", slog.String(log.CodeKey, resp.Status), slog.String(log.BodyKey, string(bytes)))
+
+		// Handle 404s specially since missing routes won't have a JSON body
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("route '%s' not found", path)
+		}
 		return nil, fmt.Errorf("error deserializing response to %s: %w", path, err)
 	}
 
 	// Check if the request failed
 	if resp.StatusCode != http.StatusOK {
 		logger.Debug("API Response", slog.String(log.PathKey, path), slog.String(log.CodeKey, resp.Status), slog.String("err", parsedResponse.Error))
-		return nil, fmt.Errorf(parsedResponse.Error)
+		status := parsedResponse.ErrorCode
+		if status == types.ResponseStatus_Unknown {
+			status = types.GetResponseStatusFromHttpCode(resp.StatusCode)
+		}
+		return nil, &types.ApiError{
+			Status:  status,
+			Message: parsedResponse.Error,
+		}
 	}
 
 	// Debug log
@@ -124,9 +263,21 @@ func HandleResponse[DataType any](context IRequesterContext, resp *http.Response
 	return &parsedResponse, nil
 }
 
+// Sets the request ID header on a request so it can be correlated with the server's logs, using the ID in the
+// request's context if it has one (such as when the request is made while handling another API request) or a new
+// UUID otherwise
+func setRequestId(req *http.Request) string {
+	requestId, ok := log.RequestIdFromContext(req.Context())
+	if !ok {
+		requestId = uuid.NewString()
+	}
+	req.Header.Set(types.RequestIdHeader, requestId)
+	return requestId
+}
+
 // Types that can be batched into a comma-delmited string
 type BatchInputType interface {
-	uint64 | common.Address | beacon.ValidatorPubkey
+	uint64 | string | common.Address | common.Hash | beacon.ValidatorPubkey
 }
 
 // Converts an array of inputs into a comma-delimited string
@@ -139,10 +290,16 @@ func MakeBatchArg[DataType BatchInputType](input []DataType) string {
 		for i, index := range *typedInput {
 			results[i] = strconv.FormatUint(index, 10)
 		}
+	case *[]string:
+		copy(results, *typedInput)
 	case *[]common.Address:
 		for i, address := range *typedInput {
 			results[i] = address.Hex()
 		}
+	case *[]common.Hash:
+		for i, hash := range *typedInput {
+			results[i] = hash.Hex()
+		}
 	case *[]beacon.ValidatorPubkey:
 		for i, pubkey := range *typedInput {
 			results[i] = pubkey.HexWithPrefix()
@@ -1,9 +1,8 @@
 package client
 
 import (
-	"context"
+	"crypto/tls"
 	"log/slog"
-	"net"
 	"net/http"
 	"net/http/httptrace"
 	"net/url"
@@ -22,24 +21,75 @@ type NetworkRequesterContext struct {
 
 	// Tracer for HTTP requests
 	tracer *httptrace.ClientTrace
+
+	// The transport used by the HTTP client
+	transport *http.Transport
+
+	// The policy for retrying GET requests, if enabled
+	retryPolicy *RetryPolicy
+}
+
+// Settings for a network requester context, collected from its options before the HTTP transport is built
+type networkRequesterSettings struct {
+	transport           *http.Transport
+	tlsConfig           *tls.Config
+	maxIdleConnsPerHost int
+	retryPolicy         *RetryPolicy
+}
+
+// An option for configuring the HTTP transport of a network requester context.
+// Options can be provided in any order; the transport is built once after all of them have been applied.
+type NetworkRequesterOption func(*networkRequesterSettings)
+
+// Use a custom transport for the HTTP client instead of the default one.
+// The transport is cloned, and the other options are applied on top of the clone. A nil transport uses the default.
+func WithTransport(transport *http.Transport) NetworkRequesterOption {
+	return func(s *networkRequesterSettings) {
+		s.transport = transport
+	}
+}
+
+// Use a custom TLS configuration for requests to the server
+func WithTLSConfig(tlsConfig *tls.Config) NetworkRequesterOption {
+	return func(s *networkRequesterSettings) {
+		s.tlsConfig = tlsConfig
+	}
+}
+
+// Set the maximum number of idle connections to keep open to the server
+func WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) NetworkRequesterOption {
+	return func(s *networkRequesterSettings) {
+		s.maxIdleConnsPerHost = maxIdleConnsPerHost
+	}
+}
+
+// Retry GET requests that fail with a connection error or a 5xx response, using exponential backoff
+func WithRetry(policy RetryPolicy) NetworkRequesterOption {
+	return func(s *networkRequesterSettings) {
+		s.retryPolicy = &policy
+	}
 }
 
 // Creates a new API client requester context for network-based
 // traceOpts is optional. If nil, it will not be used.
-func NewNetworkRequesterContext(apiUrl *url.URL, log *slog.Logger, tracer *httptrace.ClientTrace) *NetworkRequesterContext {
+// By default, requests use the proxy settings from the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables.
+func NewNetworkRequesterContext(apiUrl *url.URL, log *slog.Logger, tracer *httptrace.ClientTrace, opts ...NetworkRequesterOption) *NetworkRequesterContext {
+	settings := &networkRequesterSettings{}
+	for _, opt := range opts {
+		opt(settings)
+	}
+	transport := settings.buildTransport()
+
 	requesterContext := &NetworkRequesterContext{
-		apiUrl: apiUrl,
-		logger: log,
-		tracer: tracer,
+		apiUrl:      apiUrl,
+		logger:      log,
+		tracer:      tracer,
+		transport:   transport,
+		retryPolicy: settings.retryPolicy,
 		client: &http.Client{
-			Transport: &http.Transport{
-				DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
-					return net.Dial("tcp", apiUrl.Host)
-				},
-			},
+			Transport: transport,
 		},
 	}
-
 	return requesterContext
 }
 
@@ -63,5 +113,28 @@ func (r *NetworkRequesterContext) SendRequest(request *http.Request) (*http.Resp
 	if r.tracer != nil {
 		request = request.WithContext(httptrace.WithClientTrace(request.Context(), r.tracer))
 	}
+	if r.retryPolicy != nil && request.Method == http.MethodGet {
+		return sendWithRetry(r.client, request, *r.retryPolicy, r.logger)
+	}
 	return r.client.Do(request)
 }
+
+// Build the HTTP transport from the settings, starting with a clone of the custom transport if one was provided.
+// Otherwise this starts with the default settings, including support for proxies from the environment.
+func (s *networkRequesterSettings) buildTransport() *http.Transport {
+	var transport *http.Transport
+	if s.transport != nil {
+		transport = s.transport.Clone()
+	} else {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyFromEnvironment
+	}
+
+	if s.tlsConfig != nil {
+		transport.TLSClientConfig = s.tlsConfig.Clone()
+	}
+	if s.maxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = s.maxIdleConnsPerHost
+	}
+	return transport
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+func TestNetworkRequesterOptionsAnyOrder(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	apiUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("error parsing server URL: %v", err)
+	}
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	tlsConfig := &tls.Config{RootCAs: rootCAs}
+
+	tests := []struct {
+		name    string
+		opts    []NetworkRequesterOption
+		isValid bool
+	}{
+		{
+			name:    "no TLS options",
+			opts:    []NetworkRequesterOption{},
+			isValid: false,
+		}, {
+			name:    "TLS config after the transport",
+			opts:    []NetworkRequesterOption{WithTransport(&http.Transport{}), WithTLSConfig(tlsConfig), WithMaxIdleConnsPerHost(8)},
+			isValid: true,
+		}, {
+			name:    "TLS config before the transport",
+			opts:    []NetworkRequesterOption{WithTLSConfig(tlsConfig), WithMaxIdleConnsPerHost(8), WithTransport(&http.Transport{})},
+			isValid: true,
+		}, {
+			name:    "nil transport before the other options",
+			opts:    []NetworkRequesterOption{WithTransport(nil), WithTLSConfig(tlsConfig), WithMaxIdleConnsPerHost(8)},
+			isValid: true,
+		}, {
+			name:    "nil transport after the other options",
+			opts:    []NetworkRequesterOption{WithTLSConfig(tlsConfig), WithMaxIdleConnsPerHost(8), WithTransport(nil)},
+			isValid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			context := NewNetworkRequesterContext(apiUrl, log.NewDefaultLogger().Logger, nil, test.opts...)
+			if test.isValid && context.transport.MaxIdleConnsPerHost != 8 {
+				t.Errorf("expected 8 max idle connections per host, got %d", context.transport.MaxIdleConnsPerHost)
+			}
+
+			request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			response, err := context.SendRequest(request)
+			if err == nil {
+				_ = response.Body.Close()
+			}
+			if test.isValid && err != nil {
+				t.Errorf("error sending request: %v", err)
+			}
+			if !test.isValid && err == nil {
+				t.Error("expected the server's certificate to be rejected")
+			}
+		})
+	}
+}
+
+func TestNetworkRequesterTransportNotModified(t *testing.T) {
+	transport := &http.Transport{}
+	tlsConfig := &tls.Config{}
+	context := NewNetworkRequesterContext(&url.URL{Scheme: "http", Host: "localhost:8080"}, log.NewDefaultLogger().Logger, nil,
+		WithTransport(transport),
+		WithTLSConfig(tlsConfig),
+		WithMaxIdleConnsPerHost(64),
+	)
+	if context.transport == transport {
+		t.Fatal("expected the provided transport to be cloned")
+	}
+	if transport.MaxIdleConnsPerHost != 0 {
+		t.Errorf("expected the provided transport not to be modified, got %d max idle connections per host", transport.MaxIdleConnsPerHost)
+	}
+	if context.transport.TLSClientConfig == tlsConfig {
+		t.Error("expected the provided TLS config to be cloned")
+	}
+
+	// The default transport uses the proxy settings from the environment
+	context = NewNetworkRequesterContext(&url.URL{Scheme: "http", Host: "localhost:8080"}, log.NewDefaultLogger().Logger, nil)
+	if context.transport.Proxy == nil {
+		t.Error("expected the default transport to use a proxy function")
+	}
+}
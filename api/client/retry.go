@@ -0,0 +1,61 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// Settings for retrying GET requests when the server is temporarily unavailable
+type RetryPolicy struct {
+	// The maximum number of times to send a request, including the first attempt
+	MaxAttempts int
+
+	// The time to wait before the first retry; this doubles after each subsequent attempt
+	InitialBackoff time.Duration
+}
+
+// Send a GET request, retrying it with exponential backoff if it fails with a connection error or a 5xx response.
+// 4xx responses are never retried. Retries stop early if the request's context is cancelled or its deadline passes.
+// If every attempt fails, the last response or error is returned.
+func sendWithRetry(client *http.Client, request *http.Request, policy RetryPolicy, logger *slog.Logger) (*http.Response, error) {
+	backoff := policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		response, err := client.Do(request)
+		if attempt >= policy.MaxAttempts || !shouldRetry(request, response, err) {
+			return response, err
+		}
+
+		// Log the failure and discard the response
+		if err != nil {
+			logger.Debug("Request failed, retrying", slog.Int("attempt", attempt), slog.Duration("backoff", backoff), log.Err(err))
+		} else {
+			logger.Debug("Request failed, retrying", slog.Int("attempt", attempt), slog.Duration("backoff", backoff), slog.String(log.CodeKey, response.Status))
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		// Wait for the backoff period
+		timer := time.NewTimer(backoff)
+		select {
+		case <-request.Context().Done():
+			timer.Stop()
+			return nil, fmt.Errorf("error sending request after %d attempts: %w", attempt, request.Context().Err())
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// Check if a request should be retried based on its response
+func shouldRetry(request *http.Request, response *http.Response, err error) bool {
+	if err != nil {
+		// Don't retry if the request itself was cancelled
+		return request.Context().Err() == nil
+	}
+	return response.StatusCode >= http.StatusInternalServerError
+}
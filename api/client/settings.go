@@ -2,4 +2,5 @@ package client
 
 const (
 	jsonContentType string = "application/json"
+	gzipEncoding    string = "gzip"
 )
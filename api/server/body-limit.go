@@ -0,0 +1,28 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// Creates a middleware that limits the size of request bodies. Requests that declare a larger body are rejected
+// immediately; ones that don't will fail once the handler reads past the limit.
+func newBodySizeLimitMiddleware(logger *slog.Logger, maxBytes int64) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				logger := getRequestLogger(r, logger)
+				err := HandleRequestTooLarge(logger, w, maxBytes)
+				if err != nil {
+					logger.Error("Error handling response", log.Err(err))
+				}
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
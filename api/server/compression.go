@@ -0,0 +1,134 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Responses smaller than this (in bytes) won't be compressed since the overhead isn't worth it
+	compressionThreshold int = 1024
+
+	gzipEncoding string = "gzip"
+)
+
+// Wraps an HTTP handler (typically the router) so successful responses are gzip-compressed when the client supports it
+func newCompressionHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		if !acceptsGzip(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// Buffer the response so it can be checked before writing it
+		writer := &bufferedResponseWriter{
+			ResponseWriter: w,
+			statusCode:     http.StatusOK,
+		}
+		next.ServeHTTP(writer, r)
+		if writer.streaming {
+			// Streamed responses have already been sent
+			return
+		}
+
+		// Write the body uncompressed if it's an error or too small
+		body := writer.buffer.Bytes()
+		if writer.statusCode != http.StatusOK || len(body) < compressionThreshold {
+			w.WriteHeader(writer.statusCode)
+			_, _ = w.Write(body)
+			return
+		}
+
+		// Compress the body
+		var compressed bytes.Buffer
+		gzipWriter := gzip.NewWriter(&compressed)
+		_, err := gzipWriter.Write(body)
+		if err == nil {
+			err = gzipWriter.Close()
+		}
+		if err != nil {
+			// Fall back to the uncompressed body
+			w.WriteHeader(writer.statusCode)
+			_, _ = w.Write(body)
+			return
+		}
+
+		w.Header().Set("Content-Encoding", gzipEncoding)
+		w.Header().Del("Content-Length")
+		w.WriteHeader(writer.statusCode)
+		_, _ = w.Write(compressed.Bytes())
+	})
+}
+
+// Check if the request's Accept-Encoding header allows gzip
+func acceptsGzip(r *http.Request) bool {
+	for _, header := range r.Header.Values("Accept-Encoding") {
+		for _, encoding := range strings.Split(header, ",") {
+			encoding, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+			if !strings.EqualFold(strings.TrimSpace(encoding), gzipEncoding) {
+				continue
+			}
+			// Respect an explicit opt-out via q=0
+			qValue, hasQ := strings.CutPrefix(strings.ReplaceAll(params, " ", ""), "q=")
+			if !hasQ {
+				return true
+			}
+			q, err := strconv.ParseFloat(qValue, 64)
+			return err == nil && q > 0
+		}
+	}
+	return false
+}
+
+// A response writer that captures the status code and body instead of sending them to the client
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	streaming   bool
+	buffer      bytes.Buffer
+}
+
+// Record the status code
+func (w *bufferedResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.statusCode = statusCode
+	w.wroteHeader = true
+}
+
+// Record the body, or send it directly if the response is being streamed
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	w.wroteHeader = true
+	if w.streaming {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.buffer.Write(data)
+}
+
+// Switch to streaming mode, sending anything that's been buffered so far to the client uncompressed.
+// Everything written after this will go directly to the client.
+func (w *bufferedResponseWriter) Flush() {
+	if !w.streaming {
+		w.streaming = true
+		w.ResponseWriter.WriteHeader(w.statusCode)
+		_, _ = w.ResponseWriter.Write(w.buffer.Bytes())
+		w.buffer.Reset()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Get a channel that's notified when the client disconnects, if the underlying writer supports it
+func (w *bufferedResponseWriter) CloseNotify() <-chan bool {
+	if notifier, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+		return notifier.CloseNotify()
+	}
+	return nil
+}
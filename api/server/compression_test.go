@@ -0,0 +1,131 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// Response data for the compression tests
+type compressionTestData struct {
+	Items []string `json:"items"`
+}
+
+// Start a server that responds with an ApiResponse holding the provided number of items
+func newCompressionTestServer(t *testing.T, itemCount int) (*httptest.Server, []byte) {
+	data := compressionTestData{
+		Items: make([]string, itemCount),
+	}
+	for i := range data.Items {
+		data.Items[i] = "validator-pubkey-placeholder"
+	}
+	expected, err := json.Marshal(types.ApiResponse[compressionTestData]{Data: &data})
+	if err != nil {
+		t.Fatalf("error serializing expected response: %v", err)
+	}
+
+	logger := log.NewDefaultLogger().Logger
+	server := httptest.NewServer(newCompressionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = HandleSuccess(logger, w, types.ApiResponse[compressionTestData]{Data: &data})
+	})))
+	t.Cleanup(server.Close)
+	return server, expected
+}
+
+func TestCompressionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name           string
+		itemCount      int
+		acceptEncoding string
+		compressed     bool
+	}{
+		{
+			name:           "large response with gzip",
+			itemCount:      1000,
+			acceptEncoding: "gzip, deflate",
+			compressed:     true,
+		}, {
+			name:      "large response without accept encoding",
+			itemCount: 1000,
+		}, {
+			name:           "large response with gzip disabled",
+			itemCount:      1000,
+			acceptEncoding: "gzip;q=0",
+		}, {
+			name:           "small response with gzip",
+			itemCount:      1,
+			acceptEncoding: "gzip",
+		},
+	}
+
+	// Don't let the client negotiate or decode gzip on its own
+	client := &http.Client{
+		Transport: &http.Transport{
+			DisableCompression: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, expected := newCompressionTestServer(t, test.itemCount)
+			request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			if test.acceptEncoding != "" {
+				request.Header.Set("Accept-Encoding", test.acceptEncoding)
+			}
+			response, err := client.Do(request)
+			if err != nil {
+				t.Fatalf("error sending request: %v", err)
+			}
+			defer response.Body.Close()
+			raw, err := io.ReadAll(response.Body)
+			if err != nil {
+				t.Fatalf("error reading response: %v", err)
+			}
+
+			// Check the encoding
+			encoding := response.Header.Get("Content-Encoding")
+			if test.compressed != (encoding == gzipEncoding) {
+				t.Fatalf("expected compressed=%t, got Content-Encoding [%s]", test.compressed, encoding)
+			}
+			if !strings.Contains(response.Header.Get("Vary"), "Accept-Encoding") {
+				t.Error("expected the response to vary by Accept-Encoding")
+			}
+			body := raw
+			if test.compressed {
+				if len(raw) >= len(expected) {
+					t.Errorf("expected the compressed body to be smaller than %d bytes, got %d", len(expected), len(raw))
+				}
+				reader, err := gzip.NewReader(bytes.NewReader(raw))
+				if err != nil {
+					t.Fatalf("error creating gzip reader: %v", err)
+				}
+				body, err = io.ReadAll(reader)
+				if err != nil {
+					t.Fatalf("error decompressing response: %v", err)
+				}
+			}
+
+			// Make sure the response decodes back intact
+			if !bytes.Equal(body, expected) {
+				t.Fatalf("expected body of %d bytes to match the original %d bytes", len(body), len(expected))
+			}
+			var decoded types.ApiResponse[compressionTestData]
+			if err := json.Unmarshal(body, &decoded); err != nil {
+				t.Fatalf("error decoding response: %v", err)
+			}
+			if decoded.Data == nil || len(decoded.Data.Items) != test.itemCount {
+				t.Errorf("expected %d items in the decoded response", test.itemCount)
+			}
+		})
+	}
+}
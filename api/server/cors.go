@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// The methods allowed in cross-origin requests
+	corsAllowedMethods string = "GET, POST, PUT, DELETE, OPTIONS"
+
+	// The headers allowed in cross-origin requests if the preflight request doesn't ask for specific ones
+	corsDefaultAllowedHeaders string = "Content-Type, Authorization, Accept-Encoding"
+
+	// How long browsers can cache the result of a preflight request, in seconds
+	corsMaxAge string = "600"
+
+	// Wildcard for allowing requests from any origin
+	corsAnyOrigin string = "*"
+)
+
+// Creates a middleware that allows cross-origin requests from the provided origins and answers preflight requests.
+// Use "*" to allow requests from any origin. Requests without an Origin header are passed through untouched.
+func newCorsMiddleware(allowedOrigins []string) mux.MiddlewareFunc {
+	allowAny := slices.Contains(allowedOrigins, corsAnyOrigin)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Check the origin
+			w.Header().Add("Vary", "Origin")
+			isAllowed := allowAny || slices.Contains(allowedOrigins, origin)
+			isPreflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+			if !isAllowed {
+				if isPreflight {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Set the CORS headers
+			if allowAny {
+				w.Header().Set("Access-Control-Allow-Origin", corsAnyOrigin)
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+			}
+			w.Header().Set("Access-Control-Expose-Headers", RequestIdHeader)
+			if !isPreflight {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Answer the preflight request
+			allowedHeaders := corsDefaultAllowedHeaders
+			requestedHeaders := strings.TrimSpace(r.Header.Get("Access-Control-Request-Headers"))
+			if requestedHeaders != "" {
+				allowedHeaders = requestedHeaders
+			}
+			w.Header().Set("Access-Control-Allow-Methods", corsAllowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
+			w.Header().Set("Access-Control-Max-Age", corsMaxAge)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
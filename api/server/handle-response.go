@@ -2,6 +2,7 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -11,65 +12,103 @@ import (
 )
 
 const (
-	addressNotPresentMessage string = "The node requires an address for this request but one isn't present: %s"
-	walletNotReadyMessage    string = "A wallet is required for this request but the node wallet isn't ready: %s"
-	resourceConflictMessage  string = "Encountered a resource conflict: %s"
-	resourceNotFoundMessage  string = "The requested resource could not be found: %s"
-	clientsNotSyncedMessage  string = "The Execution Client and/or Beacon Node aren't finished syncing yet. Please try again once they've finished."
-	invalidChainStateMessage string = "The Ethereum chain's state is not correct for the request: %s"
+	addressNotPresentMessage  string = "The node requires an address for this request but one isn't present: %s"
+	walletNotReadyMessage     string = "A wallet is required for this request but the node wallet isn't ready: %s"
+	resourceConflictMessage   string = "Encountered a resource conflict: %s"
+	resourceNotFoundMessage   string = "The requested resource could not be found: %s"
+	clientsNotSyncedMessage   string = "The Execution Client and/or Beacon Node aren't finished syncing yet. Please try again once they've finished."
+	invalidChainStateMessage  string = "The Ethereum chain's state is not correct for the request: %s"
+	requestTooLargeMessage    string = "The request body is larger than the limit of %d bytes"
+	rateLimitedMessage        string = "Too many requests have been sent; please wait and try again: %s"
+	unauthorizedMessage       string = "The request is not authorized: %s"
+	serviceUnavailableMessage string = "The service is temporarily unavailable: %s"
+	invalidMethodMessage      string = "The request's HTTP method isn't allowed for this route"
 )
 
 // Handle routes called with an invalid method
 func HandleInvalidMethod(logger *slog.Logger, w http.ResponseWriter) error {
-	return writeResponse(w, logger, http.StatusMethodNotAllowed, "", nil, []byte{})
+	return writeResponse(w, logger, http.StatusMethodNotAllowed, "", nil, formatError(types.ResponseStatus_InvalidArguments, invalidMethodMessage))
 }
 
 // Handles an error related to parsing the input parameters of a request
 func HandleInputError(logger *slog.Logger, w http.ResponseWriter, err error) error {
 	msg := err.Error()
-	return writeResponse(w, logger, http.StatusBadRequest, "", err, formatError(msg))
+	return writeResponse(w, logger, http.StatusBadRequest, "", err, formatError(types.ResponseStatus_InvalidArguments, msg))
+}
+
+// Handles an error reading the body of a request, which may be because it was too large
+func HandleBodyReadError(logger *slog.Logger, w http.ResponseWriter, err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return HandleRequestTooLarge(logger, w, maxBytesErr.Limit)
+	}
+	return HandleInputError(logger, w, fmt.Errorf("error reading request body: %w", err))
+}
+
+// The request couldn't complete because its body was larger than the server's limit
+func HandleRequestTooLarge(logger *slog.Logger, w http.ResponseWriter, limit int64) error {
+	msg := fmt.Sprintf(requestTooLargeMessage, limit)
+	return writeResponse(w, logger, http.StatusRequestEntityTooLarge, "Request too large", nil, formatError(types.ResponseStatus_InvalidArguments, msg))
 }
 
 // The request couldn't complete because the node requires an address but one wasn't present
 func HandleAddressNotPresent(logger *slog.Logger, w http.ResponseWriter, err error) error {
 	msg := fmt.Sprintf(addressNotPresentMessage, err.Error())
-	return writeResponse(w, logger, http.StatusUnprocessableEntity, "Address not present", err, formatError(msg))
+	return writeResponse(w, logger, http.StatusUnprocessableEntity, "Address not present", err, formatError(types.ResponseStatus_AddressNotPresent, msg))
 }
 
 // The request couldn't complete because the node requires a wallet but one isn't present or useable
 func HandleWalletNotReady(logger *slog.Logger, w http.ResponseWriter, err error) error {
 	msg := fmt.Sprintf(walletNotReadyMessage, err.Error())
-	return writeResponse(w, logger, http.StatusUnprocessableEntity, "Wallet not ready", err, formatError(msg))
+	return writeResponse(w, logger, http.StatusUnprocessableEntity, "Wallet not ready", err, formatError(types.ResponseStatus_WalletNotReady, msg))
 }
 
 // The request couldn't complete because it's trying to create a resource that already exists, or use a resource that conflicts with what's requested
 func HandleResourceConflict(logger *slog.Logger, w http.ResponseWriter, err error) error {
 	msg := fmt.Sprintf(resourceConflictMessage, err.Error())
-	return writeResponse(w, logger, http.StatusConflict, "Resource conflict", err, formatError(msg))
+	return writeResponse(w, logger, http.StatusConflict, "Resource conflict", err, formatError(types.ResponseStatus_ResourceConflict, msg))
 }
 
 // The request couldn't complete because it's trying to access a resource that didn't exist or couldn't be found
 func HandleResourceNotFound(logger *slog.Logger, w http.ResponseWriter, err error) error {
 	msg := fmt.Sprintf(resourceNotFoundMessage, err.Error())
-	return writeResponse(w, logger, http.StatusNotFound, "Resource not found", err, formatError(msg))
+	return writeResponse(w, logger, http.StatusNotFound, "Resource not found", err, formatError(types.ResponseStatus_ResourceNotFound, msg))
 }
 
 // The request couldn't complete because the clients aren't synced yet
 func HandleClientNotSynced(logger *slog.Logger, w http.ResponseWriter, err error) error {
 	msg := clientsNotSyncedMessage
-	return writeResponse(w, logger, http.StatusUnprocessableEntity, "Clients not synced", err, formatError(msg))
+	return writeResponse(w, logger, http.StatusUnprocessableEntity, "Clients not synced", err, formatError(types.ResponseStatus_ClientsNotSynced, msg))
 }
 
 // The request couldn't complete because the chain state is preventing the request (it will revert if submitted)
 func HandleInvalidChainState(logger *slog.Logger, w http.ResponseWriter, err error) error {
 	msg := fmt.Sprintf(invalidChainStateMessage, err.Error())
-	return writeResponse(w, logger, http.StatusUnprocessableEntity, "Invalid chain state", err, formatError(msg))
+	return writeResponse(w, logger, http.StatusUnprocessableEntity, "Invalid chain state", err, formatError(types.ResponseStatus_InvalidChainState, msg))
+}
+
+// The request couldn't complete because the client has sent too many requests
+func HandleRateLimited(logger *slog.Logger, w http.ResponseWriter, err error) error {
+	msg := fmt.Sprintf(rateLimitedMessage, err.Error())
+	return writeResponse(w, logger, http.StatusTooManyRequests, "Rate limited", err, formatError(types.ResponseStatus_RateLimited, msg))
+}
+
+// The request couldn't complete because the client didn't provide valid credentials
+func HandleUnauthorized(logger *slog.Logger, w http.ResponseWriter, err error) error {
+	msg := fmt.Sprintf(unauthorizedMessage, err.Error())
+	return writeResponse(w, logger, http.StatusUnauthorized, "Unauthorized", err, formatError(types.ResponseStatus_Unauthorized, msg))
+}
+
+// The request couldn't complete because the server or one of its dependencies is temporarily unavailable
+func HandleServiceUnavailable(logger *slog.Logger, w http.ResponseWriter, err error) error {
+	msg := fmt.Sprintf(serviceUnavailableMessage, err.Error())
+	return writeResponse(w, logger, http.StatusServiceUnavailable, "Service unavailable", err, formatError(types.ResponseStatus_ServiceUnavailable, msg))
 }
 
 // The request couldn't complete because of a server error
 func HandleServerError(logger *slog.Logger, w http.ResponseWriter, err error) error {
 	msg := err.Error()
-	return writeResponse(w, logger, http.StatusInternalServerError, "", err, formatError(msg))
+	return writeResponse(w, logger, http.StatusInternalServerError, "", err, formatError(types.ResponseStatus_Error, msg))
 }
 
 // The request completed successfully
@@ -85,6 +124,63 @@ func HandleSuccess(logger *slog.Logger, w http.ResponseWriter, response any) err
 	return writeResponse(w, logger, http.StatusOK, "", nil, bytes)
 }
 
+// The request for a page of a collection completed successfully
+func HandleSuccessPaginated[DataType any](logger *slog.Logger, w http.ResponseWriter, response *types.PaginatedApiResponse[DataType]) error {
+	// Serialize the response
+	bytes, err := json.Marshal(response)
+	if err != nil {
+		return HandleServerError(logger, w, fmt.Errorf("error serializing response: %w", err))
+	}
+
+	// Write it
+	logger.Debug("Response body", slog.String(log.BodyKey, string(bytes)))
+	return writeResponse(w, logger, http.StatusOK, "", nil, bytes)
+}
+
+// Streams events to the client as Server-Sent Events until the channel is closed or the client disconnects.
+// Each event is serialized to JSON and sent as a single "data" message.
+func HandleSuccessStream(logger *slog.Logger, w http.ResponseWriter, events <-chan any) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return HandleServerError(logger, w, errors.New("the connection doesn't support streaming responses"))
+	}
+	var disconnected <-chan bool
+	if notifier, ok := w.(http.CloseNotifier); ok {
+		disconnected = notifier.CloseNotify()
+	}
+
+	// Start the stream
+	logger.Info("Responded with:", slog.String(log.CodeKey, fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK))), slog.String(log.CauseKey, "Event stream"))
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// Send each event
+	for {
+		select {
+		case <-disconnected:
+			logger.Debug("Client disconnected from event stream")
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			bytes, err := json.Marshal(event)
+			if err != nil {
+				return fmt.Errorf("error serializing event: %w", err)
+			}
+			logger.Debug("Event", slog.String(log.BodyKey, string(bytes)))
+			_, err = fmt.Fprintf(w, "data: %s\n\n", bytes)
+			if err != nil {
+				return fmt.Errorf("error writing event: %w", err)
+			}
+			flusher.Flush()
+		}
+	}
+}
+
 // Handles an API response for a request that could not be completed
 func HandleFailedResponse(logger *slog.Logger, w http.ResponseWriter, status types.ResponseStatus, err error) error {
 	switch status {
@@ -102,6 +198,12 @@ func HandleFailedResponse(logger *slog.Logger, w http.ResponseWriter, status typ
 		return HandleClientNotSynced(logger, w, err)
 	case types.ResponseStatus_InvalidChainState:
 		return HandleInvalidChainState(logger, w, err)
+	case types.ResponseStatus_RateLimited:
+		return HandleRateLimited(logger, w, err)
+	case types.ResponseStatus_Unauthorized:
+		return HandleUnauthorized(logger, w, err)
+	case types.ResponseStatus_ServiceUnavailable:
+		return HandleServiceUnavailable(logger, w, err)
 	case types.ResponseStatus_Error:
 		return HandleServerError(logger, w, err)
 	default:
@@ -152,9 +254,10 @@ func writeResponse(w http.ResponseWriter, logger *slog.Logger, statusCode int, c
 }
 
 // JSONifies an error for responding to requests
-func formatError(message string) []byte {
+func formatError(status types.ResponseStatus, message string) []byte {
 	msg := types.ApiResponse[any]{
-		Error: message,
+		Error:     message,
+		ErrorCode: status,
 	}
 
 	bytes, _ := json.Marshal(msg)
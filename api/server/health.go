@@ -0,0 +1,59 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/log"
+	"github.com/rocket-pool/node-manager-core/node/services"
+)
+
+const (
+	// The default path for the health check endpoint
+	HealthRoute string = "/health"
+)
+
+// HTTP handler that reports the combined health of the daemon as JSON, for monitoring tools to poll.
+// It responds with 200 when the daemon is healthy and 503 otherwise, so it can be used as a liveness or readiness
+// probe directly. It doesn't use the API response wrapper, so it can be registered on a separate port or path from
+// the API server.
+type HealthHandler struct {
+	logger          *slog.Logger
+	serviceProvider *services.ServiceProvider
+}
+
+// Create a new health check handler
+func NewHealthHandler(logger *slog.Logger, serviceProvider *services.ServiceProvider) *HealthHandler {
+	return &HealthHandler{
+		logger:          logger,
+		serviceProvider: serviceProvider,
+	}
+}
+
+// Serve a health check request
+func (h *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		_ = HandleInvalidMethod(h.logger, w)
+		return
+	}
+
+	status := h.serviceProvider.GetHealthStatus(r.Context())
+	bytes, err := json.Marshal(status)
+	if err != nil {
+		h.logger.Error("Error serializing health status", log.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	statusCode := http.StatusOK
+	if !status.IsHealthy {
+		statusCode = http.StatusServiceUnavailable
+	}
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, err = w.Write(bytes)
+	if err != nil {
+		h.logger.Warn("Error writing health status response", log.Err(err))
+	}
+}
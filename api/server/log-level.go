@@ -0,0 +1,100 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+	"github.com/rocket-pool/node-manager-core/node/services"
+)
+
+const (
+	// The default route name for getting or setting the level of the daemon's loggers
+	LogLevelRoute string = "logging/level"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+// Context factory for getting and setting the level of the service provider's API and tasks loggers at runtime.
+// GET requests return the current levels, and POST requests set both loggers to a new level.
+type LogLevelContextFactory struct {
+	logger          *slog.Logger
+	serviceProvider *services.ServiceProvider
+}
+
+// Create a new factory for the log level routes
+func NewLogLevelContextFactory(logger *slog.Logger, serviceProvider *services.ServiceProvider) *LogLevelContextFactory {
+	return &LogLevelContextFactory{
+		logger:          logger,
+		serviceProvider: serviceProvider,
+	}
+}
+
+// Create the context for a GET request
+func (f *LogLevelContextFactory) Create(args url.Values) (*logLevelContext, error) {
+	return &logLevelContext{
+		serviceProvider: f.serviceProvider,
+	}, nil
+}
+
+// Register the routes with the router
+func (f *LogLevelContextFactory) RegisterRoute(router *mux.Router) {
+	RegisterQuerylessGet[*logLevelContext, types.LogLevelData](
+		router.Methods(http.MethodGet).Subrouter(), LogLevelRoute, f, f.logger, f.serviceProvider,
+	)
+	RegisterQuerylessPost[*logLevelContext, types.SetLogLevelBody, types.LogLevelData](
+		router.Methods(http.MethodPost).Subrouter(), LogLevelRoute, setLogLevelContextFactory{f}, f.logger, f.serviceProvider,
+	)
+}
+
+// Creates the contexts for POST requests
+type setLogLevelContextFactory struct {
+	*LogLevelContextFactory
+}
+
+// Create the context for a POST request
+func (f setLogLevelContextFactory) Create(body types.SetLogLevelBody) (*logLevelContext, error) {
+	level, err := log.ParseLevel(body.Level)
+	if err != nil {
+		return nil, err
+	}
+	return &logLevelContext{
+		serviceProvider: f.serviceProvider,
+		newLevel:        &level,
+	}, nil
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type logLevelContext struct {
+	serviceProvider *services.ServiceProvider
+	newLevel        *slog.Level
+}
+
+func (c *logLevelContext) PrepareData(data *types.LogLevelData, opts *bind.TransactOpts) (types.ResponseStatus, error) {
+	apiLogger := c.serviceProvider.GetApiLogger()
+	tasksLogger := c.serviceProvider.GetTasksLogger()
+
+	// Set the new level if requested
+	if c.newLevel != nil {
+		apiSet := apiLogger.SetLevel(*c.newLevel)
+		tasksSet := tasksLogger.SetLevel(*c.newLevel)
+		if !apiSet || !tasksSet {
+			return types.ResponseStatus_Error, errors.New("the daemon's loggers don't support changing their level at runtime")
+		}
+		apiLogger.Info("Log level changed", slog.String("level", log.FormatLevel(*c.newLevel)))
+	}
+
+	data.ApiLevel = log.FormatLevel(apiLogger.GetLevel())
+	data.TasksLevel = log.FormatLevel(tasksLogger.GetLevel())
+	return types.ResponseStatus_Success, nil
+}
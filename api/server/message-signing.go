@@ -0,0 +1,129 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/node/services"
+	"github.com/rocket-pool/node-manager-core/wallet"
+)
+
+const (
+	// The default route name for signing a message with the node wallet
+	SignMessageRoute string = "sign-message"
+
+	// The default route name for verifying a message signature
+	VerifyMessageRoute string = "verify-message"
+)
+
+// ===============
+// === Factory ===
+// ===============
+
+// Context factory for signing messages with the node wallet, using the EIP-191 personal message format
+type SignMessageContextFactory struct {
+	logger          *slog.Logger
+	serviceProvider *services.ServiceProvider
+}
+
+// Create a new factory for the sign-message route
+func NewSignMessageContextFactory(logger *slog.Logger, serviceProvider *services.ServiceProvider) *SignMessageContextFactory {
+	return &SignMessageContextFactory{
+		logger:          logger,
+		serviceProvider: serviceProvider,
+	}
+}
+
+// Create the context for the route
+func (f *SignMessageContextFactory) Create(body types.SignMessageBody) (*signMessageContext, error) {
+	if body.Message == "" {
+		return nil, errors.New("message cannot be empty")
+	}
+	return &signMessageContext{
+		serviceProvider: f.serviceProvider,
+		message:         []byte(body.Message),
+	}, nil
+}
+
+// Register the route with the router
+func (f *SignMessageContextFactory) RegisterRoute(router *mux.Router) {
+	RegisterQuerylessPost[*signMessageContext, types.SignMessageBody, types.SignMessageData](
+		router, SignMessageRoute, f, f.logger, f.serviceProvider,
+	)
+}
+
+// Context factory for recovering the address that signed a message in the EIP-191 personal message format
+type VerifyMessageContextFactory struct {
+	logger          *slog.Logger
+	serviceProvider *services.ServiceProvider
+}
+
+// Create a new factory for the verify-message route
+func NewVerifyMessageContextFactory(logger *slog.Logger, serviceProvider *services.ServiceProvider) *VerifyMessageContextFactory {
+	return &VerifyMessageContextFactory{
+		logger:          logger,
+		serviceProvider: serviceProvider,
+	}
+}
+
+// Create the context for the route
+func (f *VerifyMessageContextFactory) Create(body types.VerifyMessageBody) (*verifyMessageContext, error) {
+	return &verifyMessageContext{
+		message:   []byte(body.Message),
+		signature: body.Signature,
+	}, nil
+}
+
+// Register the route with the router
+func (f *VerifyMessageContextFactory) RegisterRoute(router *mux.Router) {
+	RegisterQuerylessPost[*verifyMessageContext, types.VerifyMessageBody, types.VerifyMessageData](
+		router, VerifyMessageRoute, f, f.logger, f.serviceProvider,
+	)
+}
+
+// ===============
+// === Context ===
+// ===============
+
+type signMessageContext struct {
+	serviceProvider *services.ServiceProvider
+	message         []byte
+}
+
+func (c *signMessageContext) PrepareData(data *types.SignMessageData, opts *bind.TransactOpts) (types.ResponseStatus, error) {
+	w := c.serviceProvider.GetWallet()
+	status, err := w.GetStatus()
+	if err != nil {
+		return types.ResponseStatus_Error, fmt.Errorf("error getting wallet status: %w", err)
+	}
+	switch wallet.GetSigningCapability(status) {
+	case wallet.SigningCapability_None:
+		return types.ResponseStatus_AddressNotPresent, errors.New("the node doesn't have an address yet")
+	case wallet.SigningCapability_AddressOnly:
+		return types.ResponseStatus_WalletNotReady, errors.New("the node wallet isn't loaded, so it can't sign messages")
+	}
+
+	data.SignedMessage, err = w.SignMessage(c.message)
+	if err != nil {
+		return types.ResponseStatus_Error, fmt.Errorf("error signing message: %w", err)
+	}
+	return types.ResponseStatus_Success, nil
+}
+
+type verifyMessageContext struct {
+	message   []byte
+	signature []byte
+}
+
+func (c *verifyMessageContext) PrepareData(data *types.VerifyMessageData, opts *bind.TransactOpts) (types.ResponseStatus, error) {
+	signer, err := wallet.VerifyMessageSignature(c.message, c.signature)
+	if err != nil {
+		return types.ResponseStatus_InvalidArguments, err
+	}
+	data.Signer = signer
+	return types.ResponseStatus_Success, nil
+}
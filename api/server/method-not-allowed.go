@@ -0,0 +1,56 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// The methods that routes can be registered for, used to check if a path exists for a different method
+var routeMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodPatch}
+
+// Add the middlewares to the router, in order.
+// The router doesn't run its middlewares for requests that don't match a route, including ones that only fail to match
+// because of the method, so requests like CORS preflights for method-specific routes would skip them. The router's
+// not found and method not allowed handlers are wrapped in the same middlewares so every request goes through them.
+func useMiddlewares(router *mux.Router, logger *slog.Logger, middlewares []mux.MiddlewareFunc) {
+	var unmatchedHandler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := getRequestLogger(r, logger)
+		logger.Info("New request", slog.String(log.MethodKey, r.Method), slog.String(log.PathKey, r.URL.Path))
+
+		// Mux doesn't always report a method mismatch when other routes share the path prefix, so check for it here
+		if !hasRouteForOtherMethod(router, r) {
+			logger.Warn("Responded with:", slog.String(log.CodeKey, "404 Not Found"))
+			http.NotFound(w, r)
+			return
+		}
+		err := HandleInvalidMethod(logger, w)
+		if err != nil {
+			logger.Error("Error handling response", log.Err(err))
+		}
+	})
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		unmatchedHandler = middlewares[i](unmatchedHandler)
+	}
+	router.NotFoundHandler = unmatchedHandler
+	router.MethodNotAllowedHandler = unmatchedHandler
+	router.Use(middlewares...)
+}
+
+// Check if the request's path matches a route with a different method
+func hasRouteForOtherMethod(router *mux.Router, r *http.Request) bool {
+	for _, method := range routeMethods {
+		if method == r.Method {
+			continue
+		}
+		request := r.Clone(r.Context())
+		request.Method = method
+		var match mux.RouteMatch
+		if router.Match(request, &match) && match.MatchErr == nil {
+			return true
+		}
+	}
+	return false
+}
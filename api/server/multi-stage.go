@@ -0,0 +1,143 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	batch "github.com/rocket-pool/batch-query"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+	"github.com/rocket-pool/node-manager-core/node/services"
+)
+
+// Wrapper for callbacks used by call runners that follow a two-stage pattern:
+// Create bindings, query the chain for any preconditions, check them, query the chain for the main data, and then do
+// whatever else they want.
+// Structs implementing this will handle the caller-specific functionality.
+type IMultiStageCallContext[DataType any] interface {
+	// Initialize the context with any bootstrapping, requirements checks, or bindings it needs to set up
+	Initialize() (types.ResponseStatus, error)
+
+	// Used to get any state required to check the request's preconditions - anything in here will be fed into an hd.Query() multicall
+	GetPreCheckState(mc *batch.MultiCaller)
+
+	// Check the request's preconditions using the state retrieved in GetPreCheckState; if this returns an error,
+	// the main query won't be run
+	PreCheck() (types.ResponseStatus, error)
+
+	// Used to get the main state required by the context - anything in here will be fed into an hd.Query() multicall
+	GetState(mc *batch.MultiCaller)
+
+	// Prepare the response data in whatever way the context needs to do
+	PrepareData(data *DataType, opts *bind.TransactOpts) (types.ResponseStatus, error)
+}
+
+// Interface for multi-stage call context factories - these will be invoked during route handling to create the
+// unique context for the route
+type IMultiStageGetContextFactory[ContextType IMultiStageCallContext[DataType], DataType any] interface {
+	// Create the context for the route
+	Create(args url.Values) (ContextType, error)
+}
+
+// Registers a new route with the router, which will invoke the provided factory to create and execute the context
+// for the route when it's called; use this for calls that need to check preconditions on the chain before querying
+// the main data
+func RegisterMultiStageRoute[ContextType IMultiStageCallContext[DataType], DataType any](
+	router *mux.Router,
+	functionName string,
+	factory IMultiStageGetContextFactory[ContextType, DataType],
+	logger *slog.Logger,
+	serviceProvider *services.ServiceProvider,
+) {
+	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+		logger := getRequestLogger(r, logger)
+
+		// Log
+		args := r.URL.Query()
+		logger.Info("Request", slog.String(log.MethodKey, r.Method), slog.String(log.PathKey, r.URL.Path))
+		logger.Debug("Params", slog.String(log.QueryKey, r.URL.RawQuery))
+
+		// Check the method
+		if r.Method != http.MethodGet {
+			err := HandleInvalidMethod(logger, w)
+			if err != nil {
+				logger.Error("Error handling response", log.Err(err))
+			}
+			return
+		}
+
+		// Create the handler and deal with any input validation errors
+		context, err := factory.Create(args)
+		if err != nil {
+			err = HandleInputError(logger, w, err)
+			if err != nil {
+				logger.Error("Error handling response", log.Err(err))
+			}
+			return
+		}
+
+		// Run the context's processing routine
+		status, response, err := runMultiStageRoute[DataType](context, serviceProvider)
+		err = HandleResponse(logger, w, status, response, err)
+		if err != nil {
+			logger.Error("Error handling response", log.Err(err))
+		}
+	})
+}
+
+// Run a route registered with the multi-stage querying pattern
+func runMultiStageRoute[DataType any](ctx IMultiStageCallContext[DataType], serviceProvider *services.ServiceProvider) (types.ResponseStatus, *types.ApiResponse[DataType], error) {
+	// Get the services
+	w := serviceProvider.GetWallet()
+	q := serviceProvider.GetQueryManager()
+
+	// Initialize the context with any bootstrapping, requirements checks, or bindings it needs to set up
+	status, err := ctx.Initialize()
+	if err != nil {
+		return status, nil, err
+	}
+
+	// Get the precondition state
+	err = q.Query(func(mc *batch.MultiCaller) error {
+		ctx.GetPreCheckState(mc)
+		return nil
+	}, nil)
+	if err != nil {
+		return types.ResponseStatus_Error, nil, fmt.Errorf("error running pre-check state query: %w", err)
+	}
+
+	// Check the preconditions
+	status, err = ctx.PreCheck()
+	if err != nil {
+		return status, nil, err
+	}
+
+	// Get the main contract state
+	err = q.Query(func(mc *batch.MultiCaller) error {
+		ctx.GetState(mc)
+		return nil
+	}, nil)
+	if err != nil {
+		return types.ResponseStatus_Error, nil, fmt.Errorf("error running chain state query: %w", err)
+	}
+
+	// Get the transact opts if this node is ready for transaction
+	opts, err := getTransactOpts(w, ctx)
+	if err != nil {
+		return types.ResponseStatus_Error, nil, err
+	}
+
+	// Create the response and data
+	data := new(DataType)
+	response := &types.ApiResponse[DataType]{
+		Data: data,
+	}
+
+	// Prep the data with the context-specific behavior
+	status, err = ctx.PrepareData(data, opts)
+	return status, response, err
+}
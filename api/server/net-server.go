@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -13,17 +14,65 @@ import (
 	"github.com/rocket-pool/node-manager-core/log"
 )
 
+const (
+	// The default limit on the size of request bodies, in bytes
+	DefaultMaxBodySize int64 = 10 * 1024 * 1024
+)
+
 type NetworkSocketApiServer struct {
-	logger   *slog.Logger
-	handlers []IHandler
-	ip       string
-	port     uint16
-	socket   net.Listener
-	server   http.Server
-	router   *mux.Router
+	logger      *slog.Logger
+	handlers    []IHandler
+	ip          string
+	port        uint16
+	socket      net.Listener
+	server      http.Server
+	router      *mux.Router
+	maxBodySize int64
+	tlsConfig   *tls.Config
+	middlewares []mux.MiddlewareFunc
+}
+
+// Optional settings for a NetworkSocketApiServer
+type ServerOption func(*NetworkSocketApiServer)
+
+// Allow cross-origin requests from browsers on the provided origins; use "*" to allow any origin.
+// Without this option, the server doesn't send any CORS headers so browsers will restrict it to same-origin requests.
+func WithCORS(allowedOrigins []string) ServerOption {
+	return func(s *NetworkSocketApiServer) {
+		s.middlewares = append(s.middlewares, newCorsMiddleware(allowedOrigins))
+	}
+}
+
+// Limit the size of request bodies to the provided number of bytes; requests with larger bodies will be rejected
+// with a 413 error. Use 0 to remove the limit. Without this option, the limit is DefaultMaxBodySize.
+func WithBodySizeLimit(maxBytes int64) ServerOption {
+	return func(s *NetworkSocketApiServer) {
+		s.maxBodySize = maxBytes
+	}
+}
+
+// Serve the API over TLS with the provided config, such as one created with NewTLSConfig. Use this when the API is
+// accessible over the network instead of just localhost. Without this option, the server uses plain HTTP.
+func WithTLS(tlsConfig *tls.Config) ServerOption {
+	return func(s *NetworkSocketApiServer) {
+		s.tlsConfig = tlsConfig
+	}
+}
+
+// Create a TLS config for WithTLS from a PEM-encoded certificate and private key on disk.
+// Self-signed certificates are acceptable for localhost-only deployments, as long as clients are set up to trust them.
+func NewTLSConfig(certFile string, keyFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("error loading TLS certificate [%s] and key [%s]: %w", certFile, keyFile, err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
 }
 
-func NewNetworkSocketApiServer(logger *slog.Logger, ip string, port uint16, handlers []IHandler, baseRoute string, apiVersion string) (*NetworkSocketApiServer, error) {
+func NewNetworkSocketApiServer(logger *slog.Logger, ip string, port uint16, handlers []IHandler, baseRoute string, apiVersion string, opts ...ServerOption) (*NetworkSocketApiServer, error) {
 	// Create the router
 	router := mux.NewRouter()
 
@@ -35,10 +84,23 @@ func NewNetworkSocketApiServer(logger *slog.Logger, ip string, port uint16, hand
 		port:     port,
 		router:   router,
 		server: http.Server{
-			Handler: router,
+			Handler: newCompressionHandler(router),
 		},
+		maxBodySize: DefaultMaxBodySize,
 	}
 
+	// Tag each request with a unique ID so it can be traced through the logs
+	server.middlewares = append(server.middlewares, RequestIDMiddleware(logger))
+
+	// Apply the options
+	for _, opt := range opts {
+		opt(server)
+	}
+	if server.maxBodySize > 0 {
+		server.middlewares = append(server.middlewares, newBodySizeLimitMiddleware(logger, server.maxBodySize))
+	}
+	useMiddlewares(router, logger, server.middlewares)
+
 	// Register each route
 	nmcRouter := router.PathPrefix("/" + baseRoute + "/api/v" + apiVersion).Subrouter()
 	for _, handler := range server.handlers {
@@ -62,6 +124,12 @@ func (s *NetworkSocketApiServer) Start(wg *sync.WaitGroup) error {
 		s.port = uint16(socket.Addr().(*net.TCPAddr).Port)
 	}
 
+	// Wrap the socket with TLS if enabled
+	if s.tlsConfig != nil {
+		socket = tls.NewListener(socket, s.tlsConfig)
+		s.socket = socket
+	}
+
 	// Start listening
 	wg.Add(1)
 	go func() {
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/goccy/go-json"
+	"github.com/gorilla/mux"
+	batch "github.com/rocket-pool/batch-query"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// A context that's never run, for registering routes that are only called with the wrong method
+type unusedContext struct{}
+
+func (c *unusedContext) Initialize() (types.ResponseStatus, error) {
+	return types.ResponseStatus_Success, nil
+}
+func (c *unusedContext) GetState(mc *batch.MultiCaller) {}
+func (c *unusedContext) PrepareData(data *types.SuccessData, opts *bind.TransactOpts) (types.ResponseStatus, error) {
+	return types.ResponseStatus_Success, nil
+}
+
+type unusedFactory struct{}
+
+func (f unusedFactory) Create(args url.Values) (*unusedContext, error) {
+	return &unusedContext{}, nil
+}
+
+type unusedPostFactory struct{}
+
+func (f unusedPostFactory) Create(body types.SuccessData) (*unusedContext, error) {
+	return &unusedContext{}, nil
+}
+
+// A handler with routes that only match specific methods
+type methodRoutesHandler struct{}
+
+func (h methodRoutesHandler) RegisterRoutes(router *mux.Router) {
+	logger := log.NewDefaultLogger().Logger
+	RegisterSingleStageRoute[*unusedContext, types.SuccessData](router, "get-only", unusedFactory{}, logger, nil)
+	RegisterSingleStagePost[*unusedContext, types.SuccessData, types.SuccessData](router, "post-only", unusedPostFactory{}, logger, nil)
+	NewLogLevelContextFactory(logger, nil).RegisterRoute(router)
+}
+
+func newMethodRoutesServer(t *testing.T, opts ...ServerOption) *httptest.Server {
+	apiServer, err := NewNetworkSocketApiServer(log.NewDefaultLogger().Logger, "127.0.0.1", 0, []IHandler{methodRoutesHandler{}}, "test", "1", opts...)
+	if err != nil {
+		t.Fatalf("error creating server: %v", err)
+	}
+	server := httptest.NewServer(apiServer.server.Handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCorsPreflightOnMethodRoutes(t *testing.T) {
+	server := newMethodRoutesServer(t, WithCORS([]string{"https://dashboard.example"}))
+	for _, route := range []string{"get-only", "post-only", LogLevelRoute} {
+		t.Run(route, func(t *testing.T) {
+			request, err := http.NewRequest(http.MethodOptions, server.URL+"/test/api/v1/"+route, nil)
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			request.Header.Set("Origin", "https://dashboard.example")
+			request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatalf("error sending preflight request: %v", err)
+			}
+			_ = response.Body.Close()
+
+			if response.StatusCode != http.StatusNoContent {
+				t.Errorf("expected status 204, got %d", response.StatusCode)
+			}
+			if origin := response.Header.Get("Access-Control-Allow-Origin"); origin != "https://dashboard.example" {
+				t.Errorf("expected the origin to be allowed, got [%s]", origin)
+			}
+			if methods := response.Header.Get("Access-Control-Allow-Methods"); !strings.Contains(methods, http.MethodPost) {
+				t.Errorf("expected the allowed methods to include POST, got [%s]", methods)
+			}
+			if response.Header.Get(RequestIdHeader) == "" {
+				t.Error("expected the preflight response to have a request ID")
+			}
+		})
+	}
+}
+
+func TestMethodNotAllowedResponse(t *testing.T) {
+	server := newMethodRoutesServer(t)
+	for _, route := range []string{"get-only", "post-only", LogLevelRoute} {
+		t.Run(route, func(t *testing.T) {
+			request, err := http.NewRequest(http.MethodPut, server.URL+"/test/api/v1/"+route, nil)
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatalf("error sending request: %v", err)
+			}
+			defer response.Body.Close()
+
+			if response.StatusCode != http.StatusMethodNotAllowed {
+				t.Errorf("expected status 405, got %d", response.StatusCode)
+			}
+			if response.Header.Get(RequestIdHeader) == "" {
+				t.Error("expected the response to have a request ID")
+			}
+			var body types.ApiResponse[any]
+			if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+				t.Fatalf("error decoding response: %v", err)
+			}
+			if body.ErrorCode != types.ResponseStatus_InvalidArguments || body.Error == "" {
+				t.Errorf("expected an invalid arguments error, got %+v", body)
+			}
+		})
+	}
+}
+
+func TestMethodNotAllowedBodyLimit(t *testing.T) {
+	server := newMethodRoutesServer(t, WithBodySizeLimit(16))
+	response, err := http.Post(server.URL+"/test/api/v1/get-only", "application/json", strings.NewReader(strings.Repeat("a", 64)))
+	if err != nil {
+		t.Fatalf("error sending request: %v", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected the body limit to apply before the method check, got status %d", response.StatusCode)
+	}
+}
+
+func TestUnknownRouteNotFound(t *testing.T) {
+	server := newMethodRoutesServer(t)
+	response, err := http.Get(server.URL + "/test/api/v1/missing")
+	if err != nil {
+		t.Fatalf("error sending request: %v", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", response.StatusCode)
+	}
+	if response.Header.Get(RequestIdHeader) == "" {
+		t.Error("expected the response to have a request ID")
+	}
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// Wrapper for callbacks used by call runners that return a large collection one page at a time, using opaque cursors
+// to track the position in the collection. This is a simpler alternative to IPaginatedCallContext for routes that
+// only page by cursor; the pages are run through the same pagination logic as RegisterPaginatedRoute.
+// Structs implementing this will handle the caller-specific functionality.
+type IPageableCallContext[DataType any] interface {
+	// Get the total number of items in the collection
+	GetTotal() (int, error)
+
+	// Get the page of items starting at the cursor, which is empty for the first page. Returns the items and the cursor
+	// for the next page, which should be empty if there are no more items.
+	GetPage(cursor string, limit int) ([]DataType, string, error)
+}
+
+// Interface for cursor-paginated call context factories that handle GET calls.
+// These will be invoked during route handling to create the unique context for the route.
+type IPageableGetContextFactory[ContextType IPageableCallContext[DataType], DataType any] interface {
+	// Create the context for the route
+	Create(args url.Values) (ContextType, error)
+}
+
+// Registers a new route with the router, which will invoke the provided factory to create and execute the context
+// for the route when it's called via GET; use this for calls that return large collections with cursor pagination
+func RegisterPageableRoute[ContextType IPageableCallContext[DataType], DataType any](
+	router *mux.Router,
+	functionName string,
+	factory IPageableGetContextFactory[ContextType, DataType],
+	logger *slog.Logger,
+) {
+	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+		logger := getRequestLogger(r, logger)
+
+		// Log
+		args := r.URL.Query()
+		logger.Info("New request", slog.String(log.MethodKey, r.Method), slog.String(log.PathKey, r.URL.Path))
+		logger.Debug("Request params:", slog.String(log.QueryKey, r.URL.RawQuery))
+
+		// Check the method
+		if r.Method != http.MethodGet {
+			err := HandleInvalidMethod(logger, w)
+			if err != nil {
+				logger.Error("Error handling response", log.Err(err))
+			}
+			return
+		}
+
+		// Get the pagination args
+		page, err := getPageRequest(args)
+		if err != nil {
+			err = HandleInputError(logger, w, err)
+			if err != nil {
+				logger.Error("Error handling response", log.Err(err))
+			}
+			return
+		}
+
+		// Create the handler and deal with any input validation errors
+		context, err := factory.Create(args)
+		if err != nil {
+			err = HandleInputError(logger, w, err)
+			if err != nil {
+				logger.Error("Error handling response", log.Err(err))
+			}
+			return
+		}
+
+		// Run the context's processing routine
+		response, status, err := runPageableRoute[DataType](context, page)
+		if err != nil {
+			err = HandleFailedResponse(logger, w, status, err)
+		} else {
+			err = HandleSuccessPaginated(logger, w, response)
+		}
+		if err != nil {
+			logger.Error("Error handling response", log.Err(err))
+		}
+	})
+}
+
+// Adapts a pageable context to the paginated call context used by RegisterPaginatedRoute
+type pageableCallContext[DataType any] struct {
+	ctx  IPageableCallContext[DataType]
+	page PageRequest
+}
+
+// Get the total and the requested page from the pageable context
+func (c *pageableCallContext[DataType]) PrepareData(data *types.PaginatedResponse[DataType], opts *bind.TransactOpts) (types.ResponseStatus, error) {
+	total, err := c.ctx.GetTotal()
+	if err != nil {
+		return getPageableErrorStatus(err), fmt.Errorf("error getting collection size: %w", err)
+	}
+	items, cursor, err := c.ctx.GetPage(c.page.Cursor, int(c.page.Limit))
+	if err != nil {
+		return getPageableErrorStatus(err), fmt.Errorf("error getting page: %w", err)
+	}
+
+	collectionSize := uint64(max(total, 0))
+	data.Items = items
+	data.Total = &collectionSize
+	data.Cursor = cursor
+	return types.ResponseStatus_Success, nil
+}
+
+// Run a route registered with the cursor pagination pattern
+func runPageableRoute[DataType any](ctx IPageableCallContext[DataType], page PageRequest) (*types.PaginatedApiResponse[DataType], types.ResponseStatus, error) {
+	// Pages are only requested by cursor, so ignore any offset in the request
+	page.Offset = 0
+	data := &types.PaginatedResponse[DataType]{}
+	adapter := &pageableCallContext[DataType]{
+		ctx:  ctx,
+		page: page,
+	}
+	status, err := adapter.PrepareData(data, nil)
+	if err != nil {
+		return nil, status, err
+	}
+	if data.Items == nil {
+		data.Items = []DataType{}
+	}
+	setPageInfo(data, page)
+
+	// Only return a cursor if there's another page to request
+	cursor := ""
+	if data.HasMore {
+		cursor = data.Cursor
+	}
+	return &types.PaginatedApiResponse[DataType]{
+		Data:   data.Items,
+		Total:  int(*data.Total),
+		Cursor: cursor,
+	}, status, nil
+}
+
+// Get the response status for an error returned by a pageable context, using the status of an API error if present
+func getPageableErrorStatus(err error) types.ResponseStatus {
+	var apiErr *types.ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr.Status
+	}
+	return types.ResponseStatus_Error
+}
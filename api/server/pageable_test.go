@@ -0,0 +1,137 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// A pageable context over a fixed collection, using offset cursors
+type sliceContext struct {
+	items []int
+}
+
+func (c *sliceContext) GetTotal() (int, error) {
+	return len(c.items), nil
+}
+
+func (c *sliceContext) GetPage(cursor string, limit int) ([]int, string, error) {
+	offset, err := DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	start := min(int(offset), len(c.items))
+	end := min(start+limit, len(c.items))
+	next := ""
+	if end < len(c.items) {
+		next = EncodeOffsetCursor(uint64(end))
+	}
+	return c.items[start:end], next, nil
+}
+
+type sliceFactory struct {
+	items []int
+}
+
+func (f sliceFactory) Create(args url.Values) (*sliceContext, error) {
+	return &sliceContext{items: f.items}, nil
+}
+
+func TestPageableRoute(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	router := mux.NewRouter()
+	RegisterPageableRoute[*sliceContext, int](router, "items", sliceFactory{items: items}, log.NewDefaultLogger().Logger)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	// Follow the cursors until the last page
+	collected := []int{}
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > len(items) {
+			t.Fatalf("cursors didn't reach the end of the collection")
+		}
+		query := url.Values{}
+		query.Set(types.PaginationLimitArg, strconv.Itoa(2))
+		if cursor != "" {
+			query.Set(types.PaginationCursorArg, cursor)
+		}
+		response, err := http.Get(server.URL + "/items?" + query.Encode())
+		if err != nil {
+			t.Fatalf("error requesting page: %v", err)
+		}
+		var page types.PaginatedApiResponse[int]
+		err = json.NewDecoder(response.Body).Decode(&page)
+		_ = response.Body.Close()
+		if err != nil {
+			t.Fatalf("error decoding page: %v", err)
+		}
+		if response.StatusCode != http.StatusOK {
+			t.Fatalf("expected status 200, got %d (%s)", response.StatusCode, page.Error)
+		}
+		if page.Total != len(items) {
+			t.Errorf("expected total %d, got %d", len(items), page.Total)
+		}
+		collected = append(collected, page.Data...)
+		if page.Cursor == "" {
+			break
+		}
+		cursor = page.Cursor
+	}
+
+	if len(collected) != len(items) {
+		t.Fatalf("expected %d items, got %v", len(items), collected)
+	}
+	for i, item := range items {
+		if collected[i] != item {
+			t.Errorf("expected item %d to be %d, got %d", i, item, collected[i])
+		}
+	}
+}
+
+func TestPageableRouteErrors(t *testing.T) {
+	router := mux.NewRouter()
+	RegisterPageableRoute[*sliceContext, int](router, "items", sliceFactory{items: []int{1}}, log.NewDefaultLogger().Logger)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name     string
+		query    string
+		expected int
+	}{
+		{
+			name:     "invalid cursor",
+			query:    types.PaginationCursorArg + "=!!!",
+			expected: http.StatusBadRequest,
+		}, {
+			name:     "limit too large",
+			query:    types.PaginationLimitArg + "=" + strconv.FormatUint(maxPageLimit+1, 10),
+			expected: http.StatusBadRequest,
+		}, {
+			name:     "zero limit",
+			query:    types.PaginationLimitArg + "=0",
+			expected: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response, err := http.Get(server.URL + "/items?" + test.query)
+			if err != nil {
+				t.Fatalf("error requesting page: %v", err)
+			}
+			_ = response.Body.Close()
+			if response.StatusCode != test.expected {
+				t.Errorf("expected status %d, got %d", test.expected, response.StatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+	"github.com/rocket-pool/node-manager-core/node/services"
+	"github.com/rocket-pool/node-manager-core/utils/input"
+)
+
+const (
+	// The page size to use if the request doesn't specify one
+	defaultPageLimit uint64 = 1000
+
+	// The largest page size that can be requested
+	maxPageLimit uint64 = 10000
+)
+
+// The page of a collection requested from a paginated route
+type PageRequest struct {
+	// The maximum number of items to return
+	Limit uint64
+
+	// The index of the first item to return; only used if Cursor is empty
+	Offset uint64
+
+	// The opaque cursor returned with the previous page, for routes that page by cursor; empty for the first page
+	Cursor string
+}
+
+// Wrapper for callbacks used by call runners that return a large collection one page at a time.
+// Structs implementing this will handle the caller-specific functionality.
+type IPaginatedCallContext[DataType any] interface {
+	// Prepare the requested page of response data. Implementations should set the page's items, and can set the total
+	// number of items in the collection if it's known.
+	// Collections that may change between requests should page by cursor instead of offset: set the response's Cursor
+	// to an opaque cursor for the next page (such as one from EncodeOffsetCursor or a keyset key), or leave it empty on
+	// the last page. The other pagination fields are handled by the runner.
+	PrepareData(data *types.PaginatedResponse[DataType], opts *bind.TransactOpts) (types.ResponseStatus, error)
+}
+
+// Interface for paginated call context factories that handle GET calls.
+// These will be invoked during route handling to create the unique context for the route.
+type IPaginatedGetContextFactory[ContextType IPaginatedCallContext[DataType], DataType any] interface {
+	// Create the context for the route, using the requested page
+	Create(args url.Values, page PageRequest) (ContextType, error)
+}
+
+// Registers a new route with the router, which will invoke the provided factory to create and execute the context
+// for the route when it's called via GET; use this for calls that return large collections
+func RegisterPaginatedRoute[ContextType IPaginatedCallContext[DataType], DataType any](
+	router *mux.Router,
+	functionName string,
+	factory IPaginatedGetContextFactory[ContextType, DataType],
+	logger *slog.Logger,
+	serviceProvider *services.ServiceProvider,
+) {
+	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+		logger := getRequestLogger(r, logger)
+
+		// Log
+		args := r.URL.Query()
+		logger.Info("New request", slog.String(log.MethodKey, r.Method), slog.String(log.PathKey, r.URL.Path))
+		logger.Debug("Request params:", slog.String(log.QueryKey, r.URL.RawQuery))
+
+		// Check the method
+		if r.Method != http.MethodGet {
+			err := HandleInvalidMethod(logger, w)
+			if err != nil {
+				logger.Error("Error handling response", log.Err(err))
+			}
+			return
+		}
+
+		// Get the pagination args
+		page, err := getPageRequest(args)
+		if err != nil {
+			err = HandleInputError(logger, w, err)
+			if err != nil {
+				logger.Error("Error handling response", log.Err(err))
+			}
+			return
+		}
+
+		// Create the handler and deal with any input validation errors
+		context, err := factory.Create(args, page)
+		if err != nil {
+			err = HandleInputError(logger, w, err)
+			if err != nil {
+				logger.Error("Error handling response", log.Err(err))
+			}
+			return
+		}
+
+		// Run the context's processing routine
+		status, response, err := runPaginatedRoute[DataType](context, serviceProvider, page)
+		err = HandleResponse(logger, w, status, response, err)
+		if err != nil {
+			logger.Error("Error handling response", log.Err(err))
+		}
+	})
+}
+
+// Get the requested page from the pagination args of a request
+func getPageRequest(args url.Values) (PageRequest, error) {
+	page := PageRequest{
+		Limit:  defaultPageLimit,
+		Cursor: args.Get(types.PaginationCursorArg),
+	}
+	err := ValidateOptionalArg(types.PaginationLimitArg, args, input.ValidatePositiveUint, &page.Limit, nil)
+	if err == nil && page.Limit > maxPageLimit {
+		err = fmt.Errorf("invalid %s: must be at most %d", types.PaginationLimitArg, maxPageLimit)
+	}
+	if err == nil {
+		err = ValidateOptionalArg(types.PaginationOffsetArg, args, input.ValidateUint, &page.Offset, nil)
+	}
+	return page, err
+}
+
+// Run a route registered with the paginated pattern
+func runPaginatedRoute[DataType any](ctx IPaginatedCallContext[DataType], serviceProvider *services.ServiceProvider, page PageRequest) (types.ResponseStatus, *types.ApiResponse[types.PaginatedResponse[DataType]], error) {
+	// Get the services
+	w := serviceProvider.GetWallet()
+
+	// Get the transact opts if this node is ready for transaction
+	opts, err := getTransactOpts(w, ctx)
+	if err != nil {
+		return types.ResponseStatus_Error, nil, err
+	}
+
+	// Create the response and data
+	data := &types.PaginatedResponse[DataType]{
+		Items: []DataType{},
+	}
+	response := &types.ApiResponse[types.PaginatedResponse[DataType]]{
+		Data: data,
+	}
+
+	// Prep the data with the context-specific behavior
+	status, err := ctx.PrepareData(data, opts)
+	if err != nil {
+		return status, response, err
+	}
+	if data.Items == nil {
+		data.Items = []DataType{}
+	}
+
+	// Set the pagination info
+	setPageInfo(data, page)
+	return status, response, nil
+}
+
+// Set the pagination fields of a page prepared by a paginated context
+func setPageInfo[DataType any](data *types.PaginatedResponse[DataType], page PageRequest) {
+	count := uint64(len(data.Items))
+	if page.Cursor == "" && data.Cursor == "" {
+		data.NextOffset = page.Offset + count
+	}
+
+	switch {
+	case data.Cursor != "":
+		// Cursor routes return a cursor for every page but the last
+		data.HasMore = count > 0
+	case page.Cursor != "":
+		// The context didn't return a cursor, so this was the last page
+		data.HasMore = false
+	case data.Total != nil:
+		data.HasMore = count > 0 && data.NextOffset < *data.Total
+	default:
+		// Without a total, a full page means there may be more items
+		data.HasMore = count > 0 && count >= page.Limit
+	}
+}
+
+// Encode an offset into an opaque cursor, for paginated contexts that page by cursor through collections by index
+func EncodeOffsetCursor(offset uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(offset, 10)))
+}
+
+// Decode an opaque cursor created with EncodeOffsetCursor back into an offset; an empty cursor is offset 0.
+// Invalid cursors return an ErrInvalidArguments error.
+func DecodeOffsetCursor(cursor string) (uint64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid cursor [%s]", types.ErrInvalidArguments, cursor)
+	}
+	offset, err := strconv.ParseUint(string(bytes), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid cursor [%s]", types.ErrInvalidArguments, cursor)
+	}
+	return offset, nil
+}
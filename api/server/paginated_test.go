@@ -0,0 +1,111 @@
+package server
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rocket-pool/node-manager-core/api/types"
+)
+
+func TestSetPageInfo(t *testing.T) {
+	total := uint64(5)
+	tests := []struct {
+		name               string
+		page               PageRequest
+		items              int
+		total              *uint64
+		cursor             string
+		expectedNextOffset uint64
+		expectedHasMore    bool
+	}{
+		{
+			name:               "offset page with more after it",
+			page:               PageRequest{Limit: 2, Offset: 0},
+			items:              2,
+			total:              &total,
+			expectedNextOffset: 2,
+			expectedHasMore:    true,
+		}, {
+			name:               "last offset page",
+			page:               PageRequest{Limit: 2, Offset: 4},
+			items:              1,
+			total:              &total,
+			expectedNextOffset: 5,
+			expectedHasMore:    false,
+		}, {
+			name:               "full page without a total",
+			page:               PageRequest{Limit: 2, Offset: 2},
+			items:              2,
+			expectedNextOffset: 4,
+			expectedHasMore:    true,
+		}, {
+			name:               "partial page without a total",
+			page:               PageRequest{Limit: 2, Offset: 4},
+			items:              1,
+			expectedNextOffset: 5,
+			expectedHasMore:    false,
+		}, {
+			name:               "empty page",
+			page:               PageRequest{Limit: 2, Offset: 10},
+			items:              0,
+			expectedNextOffset: 10,
+			expectedHasMore:    false,
+		}, {
+			name:            "first cursor page",
+			page:            PageRequest{Limit: 2},
+			items:           2,
+			cursor:          "next",
+			expectedHasMore: true,
+		}, {
+			name:            "middle cursor page",
+			page:            PageRequest{Limit: 2, Cursor: "previous"},
+			items:           2,
+			cursor:          "next",
+			expectedHasMore: true,
+		}, {
+			name:            "last cursor page",
+			page:            PageRequest{Limit: 2, Cursor: "previous"},
+			items:           2,
+			expectedHasMore: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &types.PaginatedResponse[int]{
+				Items:  make([]int, test.items),
+				Total:  test.total,
+				Cursor: test.cursor,
+			}
+			setPageInfo(data, test.page)
+			if data.NextOffset != test.expectedNextOffset {
+				t.Errorf("expected next offset %d, got %d", test.expectedNextOffset, data.NextOffset)
+			}
+			if data.HasMore != test.expectedHasMore {
+				t.Errorf("expected hasMore %t, got %t", test.expectedHasMore, data.HasMore)
+			}
+		})
+	}
+}
+
+func TestOffsetCursor(t *testing.T) {
+	for _, offset := range []uint64{0, 1, 1000, 1<<64 - 1} {
+		decoded, err := DecodeOffsetCursor(EncodeOffsetCursor(offset))
+		if err != nil {
+			t.Fatalf("error decoding cursor for offset %d: %v", offset, err)
+		}
+		if decoded != offset {
+			t.Errorf("expected offset %d, got %d", offset, decoded)
+		}
+	}
+
+	offset, err := DecodeOffsetCursor("")
+	if err != nil || offset != 0 {
+		t.Errorf("expected an empty cursor to be offset 0, got %d (%v)", offset, err)
+	}
+	for _, cursor := range []string{"!!!", EncodeOffsetCursor(1)[:1] + "=", "bm90LWEtbnVtYmVy"} {
+		if _, err := DecodeOffsetCursor(cursor); !errors.Is(err, types.ErrInvalidArguments) {
+			t.Errorf("expected cursor [%s] to be invalid, got %v", cursor, err)
+		}
+	}
+}
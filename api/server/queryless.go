@@ -12,7 +12,6 @@ import (
 	"github.com/rocket-pool/node-manager-core/api/types"
 	"github.com/rocket-pool/node-manager-core/log"
 	"github.com/rocket-pool/node-manager-core/node/services"
-	"github.com/rocket-pool/node-manager-core/utils"
 
 	"github.com/gorilla/mux"
 )
@@ -51,6 +50,8 @@ func RegisterQuerylessGet[ContextType IQuerylessCallContext[DataType], DataType
 	serviceProvider *services.ServiceProvider,
 ) {
 	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+		logger := getRequestLogger(r, logger)
+
 		// Log
 		args := r.URL.Query()
 		logger.Info("New request", slog.String(log.MethodKey, r.Method), slog.String(log.PathKey, r.URL.Path))
@@ -94,6 +95,8 @@ func RegisterQuerylessPost[ContextType IQuerylessCallContext[DataType], BodyType
 	serviceProvider *services.ServiceProvider,
 ) {
 	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+		logger := getRequestLogger(r, logger)
+
 		// Log
 		logger.Info("New request", slog.String(log.MethodKey, r.Method), slog.String(log.PathKey, r.URL.Path))
 
@@ -109,7 +112,7 @@ func RegisterQuerylessPost[ContextType IQuerylessCallContext[DataType], BodyType
 		// Read the body
 		bodyBytes, err := io.ReadAll(r.Body)
 		if err != nil {
-			err = HandleInputError(logger, w, fmt.Errorf("error reading request body: %w", err))
+			err = HandleBodyReadError(logger, w, err)
 			if err != nil {
 				logger.Error("Error handling response", log.Err(err))
 			}
@@ -153,21 +156,9 @@ func runQuerylessRoute[DataType any](ctx IQuerylessCallContext[DataType], servic
 	w := serviceProvider.GetWallet()
 
 	// Get the transact opts if this node is ready for transaction
-	var opts *bind.TransactOpts
-	walletStatus, err := w.GetStatus()
+	opts, err := getTransactOpts(w, ctx)
 	if err != nil {
-		return types.ResponseStatus_Error, nil, fmt.Errorf("error getting wallet status: %w", err)
-	}
-	if utils.IsWalletReady(walletStatus) {
-		var err error
-		opts, err = w.GetTransactor()
-		if err != nil {
-			return types.ResponseStatus_Error, nil, fmt.Errorf("error getting node account transactor: %w", err)
-		}
-	} else {
-		opts = &bind.TransactOpts{
-			From: walletStatus.Address.NodeAddress,
-		}
+		return types.ResponseStatus_Error, nil, err
 	}
 
 	// Create the response and data
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/api/types"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+const (
+	// The header used to return the request ID to the client
+	RequestIdHeader string = types.RequestIdHeader
+
+	// The longest request ID that will be accepted from a client
+	maxRequestIdLength int = 64
+)
+
+// Creates a middleware that assigns each request a UUID, or uses the one provided by the client in the request ID
+// header. The ID is stored in the request's context along with a sublogger that includes it, so every log line for the
+// request can be correlated. The ID is also returned to the client in a header.
+func RequestIDMiddleware(logger *slog.Logger) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestId := r.Header.Get(RequestIdHeader)
+			if !isValidRequestId(requestId) {
+				requestId = uuid.NewString()
+			}
+			ctx := log.CreateContextWithRequestId(r.Context(), requestId)
+			requestLogger := (&log.Logger{Logger: logger}).CreateRequestSubLogger(ctx)
+			w.Header().Set(RequestIdHeader, requestId)
+			ctx = requestLogger.CreateContextWithLogger(ctx)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Get the logger for a request, falling back to the provided logger if the request doesn't have one in its context
+func getRequestLogger(r *http.Request, fallback *slog.Logger) *slog.Logger {
+	requestLogger, ok := log.FromContext(r.Context())
+	if ok && requestLogger != nil {
+		return requestLogger.Logger
+	}
+	return fallback
+}
+
+// Check if a request ID provided by a client is safe to use in logs and headers
+func isValidRequestId(requestId string) bool {
+	if requestId == "" || len(requestId) > maxRequestIdLength {
+		return false
+	}
+	for _, char := range requestId {
+		isAlphanumeric := (char >= '0' && char <= '9') || (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z')
+		if !isAlphanumeric && char != '-' && char != '_' {
+			return false
+		}
+	}
+	return true
+}
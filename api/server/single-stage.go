@@ -14,7 +14,6 @@ import (
 	"github.com/rocket-pool/node-manager-core/api/types"
 	"github.com/rocket-pool/node-manager-core/log"
 	"github.com/rocket-pool/node-manager-core/node/services"
-	"github.com/rocket-pool/node-manager-core/utils"
 )
 
 // Wrapper for callbacks used by call runners that follow a common single-stage pattern:
@@ -53,22 +52,64 @@ func RegisterSingleStageRoute[ContextType ISingleStageCallContext[DataType], Dat
 	factory ISingleStageGetContextFactory[ContextType, DataType],
 	logger *slog.Logger,
 	serviceProvider *services.ServiceProvider,
+) {
+	registerSingleStageQueryRoute(router, http.MethodGet, functionName, factory, logger, serviceProvider)
+}
+
+// Registers a new route with the router, which will invoke the provided factory to create and execute the context
+// for the route when it's called via DELETE; use this for calls that remove a resource
+func RegisterSingleStageDelete[ContextType ISingleStageCallContext[DataType], DataType any](
+	router *mux.Router,
+	functionName string,
+	factory ISingleStageGetContextFactory[ContextType, DataType],
+	logger *slog.Logger,
+	serviceProvider *services.ServiceProvider,
+) {
+	registerSingleStageQueryRoute(router, http.MethodDelete, functionName, factory, logger, serviceProvider)
+}
+
+// Registers a new route with the router, which will invoke the provided factory to create and execute the context
+// for the route when it's called via POST; use this for typical general-purpose calls
+func RegisterSingleStagePost[ContextType ISingleStageCallContext[DataType], BodyType any, DataType any](
+	router *mux.Router,
+	functionName string,
+	factory ISingleStagePostContextFactory[ContextType, BodyType, DataType],
+	logger *slog.Logger,
+	serviceProvider *services.ServiceProvider,
+) {
+	registerSingleStageBodyRoute(router, http.MethodPost, functionName, factory, logger, serviceProvider)
+}
+
+// Registers a new route with the router, which will invoke the provided factory to create and execute the context
+// for the route when it's called via PUT; use this for calls that update a resource
+func RegisterSingleStagePut[ContextType ISingleStageCallContext[DataType], BodyType any, DataType any](
+	router *mux.Router,
+	functionName string,
+	factory ISingleStagePostContextFactory[ContextType, BodyType, DataType],
+	logger *slog.Logger,
+	serviceProvider *services.ServiceProvider,
+) {
+	registerSingleStageBodyRoute(router, http.MethodPut, functionName, factory, logger, serviceProvider)
+}
+
+// Registers a new route that reads its arguments from the query string, for the provided HTTP method.
+// The route only matches that method, so other methods can be registered on the same path.
+func registerSingleStageQueryRoute[ContextType ISingleStageCallContext[DataType], DataType any](
+	router *mux.Router,
+	httpMethod string,
+	functionName string,
+	factory ISingleStageGetContextFactory[ContextType, DataType],
+	logger *slog.Logger,
+	serviceProvider *services.ServiceProvider,
 ) {
 	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+		logger := getRequestLogger(r, logger)
+
 		// Log
 		args := r.URL.Query()
 		logger.Info("Request", slog.String(log.MethodKey, r.Method), slog.String(log.PathKey, r.URL.Path))
 		logger.Debug("Params", slog.String(log.QueryKey, r.URL.RawQuery))
 
-		// Check the method
-		if r.Method != http.MethodGet {
-			err := HandleInvalidMethod(logger, w)
-			if err != nil {
-				logger.Error("Error handling response", log.Err(err))
-			}
-			return
-		}
-
 		// Create the handler and deal with any input validation errors
 		context, err := factory.Create(args)
 		if err != nil {
@@ -85,35 +126,29 @@ func RegisterSingleStageRoute[ContextType ISingleStageCallContext[DataType], Dat
 		if err != nil {
 			logger.Error("Error handling response", log.Err(err))
 		}
-	})
+	}).Methods(httpMethod)
 }
 
-// Registers a new route with the router, which will invoke the provided factory to create and execute the context
-// for the route when it's called via POST; use this for typical general-purpose calls
-func RegisterSingleStagePost[ContextType ISingleStageCallContext[DataType], BodyType any, DataType any](
+// Registers a new route that reads its arguments from the request body, for the provided HTTP method.
+// The route only matches that method, so other methods can be registered on the same path.
+func registerSingleStageBodyRoute[ContextType ISingleStageCallContext[DataType], BodyType any, DataType any](
 	router *mux.Router,
+	httpMethod string,
 	functionName string,
 	factory ISingleStagePostContextFactory[ContextType, BodyType, DataType],
 	logger *slog.Logger,
 	serviceProvider *services.ServiceProvider,
 ) {
 	router.HandleFunc(fmt.Sprintf("/%s", functionName), func(w http.ResponseWriter, r *http.Request) {
+		logger := getRequestLogger(r, logger)
+
 		// Log
 		logger.Info("Request", slog.String(log.MethodKey, r.Method), slog.String(log.PathKey, r.URL.Path))
 
-		// Check the method
-		if r.Method != http.MethodPost {
-			err := HandleInvalidMethod(logger, w)
-			if err != nil {
-				logger.Error("Error handling response", log.Err(err))
-			}
-			return
-		}
-
 		// Read the body
 		bodyBytes, err := io.ReadAll(r.Body)
 		if err != nil {
-			err = HandleInputError(logger, w, fmt.Errorf("error reading request body: %w", err))
+			err = HandleBodyReadError(logger, w, err)
 			if err != nil {
 				logger.Error("Error handling response", log.Err(err))
 			}
@@ -148,7 +183,7 @@ func RegisterSingleStagePost[ContextType ISingleStageCallContext[DataType], Body
 		if err != nil {
 			logger.Error("Error handling response", log.Err(err))
 		}
-	})
+	}).Methods(httpMethod)
 }
 
 // Run a route registered with the common single-stage querying pattern
@@ -173,21 +208,9 @@ func runSingleStageRoute[DataType any](ctx ISingleStageCallContext[DataType], se
 	}
 
 	// Get the transact opts if this node is ready for transaction
-	var opts *bind.TransactOpts
-	walletStatus, err := w.GetStatus()
+	opts, err := getTransactOpts(w, ctx)
 	if err != nil {
-		return types.ResponseStatus_Error, nil, fmt.Errorf("error getting wallet status: %w", err)
-	}
-	if utils.IsWalletReady(walletStatus) {
-		var err error
-		opts, err = w.GetTransactor()
-		if err != nil {
-			return types.ResponseStatus_Error, nil, fmt.Errorf("error getting node account transactor: %w", err)
-		}
-	} else {
-		opts = &bind.TransactOpts{
-			From: walletStatus.Address.NodeAddress,
-		}
+		return types.ResponseStatus_Error, nil, err
 	}
 
 	// Create the response and data
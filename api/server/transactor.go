@@ -0,0 +1,41 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	nodewallet "github.com/rocket-pool/node-manager-core/node/wallet"
+	"github.com/rocket-pool/node-manager-core/wallet"
+)
+
+// Optional interface for call contexts that want to know whether the node wallet can sign transactions.
+// If a context implements this, the route runner will call it before PrepareData() so the context can refuse to build
+// transactions that couldn't be submitted (e.g., when the node is masquerading as another address).
+type ISigningCapabilityAware interface {
+	// Set the signing capability of the node wallet
+	SetSigningCapability(capability wallet.SigningCapability)
+}
+
+// Get the transact opts for a call context. If the wallet can't sign for the node address, the opts will only have
+// the node address set so transactions can still be built and simulated.
+func getTransactOpts(w *nodewallet.Wallet, ctx any) (*bind.TransactOpts, error) {
+	walletStatus, err := w.GetStatus()
+	if err != nil {
+		return nil, fmt.Errorf("error getting wallet status: %w", err)
+	}
+	capability := wallet.GetSigningCapability(walletStatus)
+	if aware, ok := ctx.(ISigningCapabilityAware); ok {
+		aware.SetSigningCapability(capability)
+	}
+
+	if capability == wallet.SigningCapability_Full {
+		opts, err := w.GetTransactor()
+		if err != nil {
+			return nil, fmt.Errorf("error getting node account transactor: %w", err)
+		}
+		return opts, nil
+	}
+	return &bind.TransactOpts{
+		From: walletStatus.Address.NodeAddress,
+	}, nil
+}
@@ -1,6 +1,10 @@
 package server
 
-import "github.com/gorilla/mux"
+import (
+	"sync"
+
+	"github.com/gorilla/mux"
+)
 
 // Context factories can implement this generally so they can register themselves with an HTTP router.
 type IContextFactory interface {
@@ -10,3 +14,12 @@ type IContextFactory interface {
 type IHandler interface {
 	RegisterRoutes(router *mux.Router)
 }
+
+// Common interface for API servers, regardless of the kind of socket they listen on
+type IApiServer interface {
+	// Start listening for incoming HTTP requests
+	Start(wg *sync.WaitGroup) error
+
+	// Stop the HTTP listener
+	Stop() error
+}
@@ -36,10 +36,17 @@ func NewUnixSocketApiServer(logger *slog.Logger, socketPath string, handlers []I
 		socketPath: socketPath,
 		router:     router,
 		server: http.Server{
-			Handler: router,
+			Handler: newCompressionHandler(router),
 		},
 	}
 
+	// Tag each request with a unique ID so it can be traced through the logs, and answer requests that use the wrong
+	// method for a route with an error response
+	middlewares := []mux.MiddlewareFunc{
+		RequestIDMiddleware(logger),
+	}
+	useMiddlewares(router, logger, middlewares)
+
 	// Register each route
 	nmcRouter := router.Host(baseRoute).PathPrefix("/api/v" + apiVersion).Subrouter()
 	for _, handler := range server.handlers {
@@ -107,3 +114,24 @@ func (s *UnixSocketApiServer) Stop() error {
 	}
 	return nil
 }
+
+// Get a wrapper around the server that implements IApiServer, assigning the socket to the provided owner when it starts
+func (s *UnixSocketApiServer) WithSocketOwner(socketOwnerUid uint32, socketOwnerGid uint32) IApiServer {
+	return &ownedUnixSocketApiServer{
+		UnixSocketApiServer: s,
+		socketOwnerUid:      socketOwnerUid,
+		socketOwnerGid:      socketOwnerGid,
+	}
+}
+
+// A Unix socket API server with a predetermined socket owner
+type ownedUnixSocketApiServer struct {
+	*UnixSocketApiServer
+	socketOwnerUid uint32
+	socketOwnerGid uint32
+}
+
+// Starts listening for incoming HTTP requests
+func (s *ownedUnixSocketApiServer) Start(wg *sync.WaitGroup) error {
+	return s.UnixSocketApiServer.Start(wg, s.socketOwnerUid, s.socketOwnerGid)
+}
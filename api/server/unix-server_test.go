@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// The header used by the test route to return the request ID it saw in its context
+const contextRequestIdHeader string = "X-Context-Request-ID"
+
+// A handler with a route that reports the request ID from its context
+type requestIdHandler struct{}
+
+func (h requestIdHandler) RegisterRoutes(router *mux.Router) {
+	router.HandleFunc("/request-id", func(w http.ResponseWriter, r *http.Request) {
+		requestId, _ := log.RequestIdFromContext(r.Context())
+		w.Header().Set(contextRequestIdHeader, requestId)
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// Start a Unix socket server with the request ID handler, returning a client that sends requests to it
+func newUnixSocketTestClient(t *testing.T) *http.Client {
+	socketPath := filepath.Join(t.TempDir(), "api.sock")
+	apiServer, err := NewUnixSocketApiServer(log.NewDefaultLogger().Logger, socketPath, []IHandler{requestIdHandler{}}, "test", "1")
+	if err != nil {
+		t.Fatalf("error creating server: %v", err)
+	}
+	wg := &sync.WaitGroup{}
+	err = apiServer.Start(wg, uint32(os.Getuid()), uint32(os.Getgid()))
+	if err != nil {
+		t.Fatalf("error starting server: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = apiServer.Stop()
+		wg.Wait()
+	})
+
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return net.Dial("unix", socketPath)
+			},
+		},
+	}
+}
+
+func TestUnixSocketRequestId(t *testing.T) {
+	client := newUnixSocketTestClient(t)
+	tests := []struct {
+		name      string
+		requestId string
+		generated bool
+	}{
+		{
+			name:      "client provided ID",
+			requestId: "cli-request-1",
+		}, {
+			name:      "no ID",
+			generated: true,
+		}, {
+			name:      "invalid ID",
+			requestId: "not a valid id",
+			generated: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, err := http.NewRequest(http.MethodGet, "http://test/api/v1/request-id", nil)
+			if err != nil {
+				t.Fatalf("error creating request: %v", err)
+			}
+			if test.requestId != "" {
+				request.Header.Set(RequestIdHeader, test.requestId)
+			}
+			response, err := client.Do(request)
+			if err != nil {
+				t.Fatalf("error sending request: %v", err)
+			}
+			_ = response.Body.Close()
+
+			requestId := response.Header.Get(RequestIdHeader)
+			if test.generated {
+				if requestId == "" || requestId == test.requestId {
+					t.Errorf("expected a generated request ID, got [%s]", requestId)
+				}
+			} else if requestId != test.requestId {
+				t.Errorf("expected request ID [%s], got [%s]", test.requestId, requestId)
+			}
+			if contextId := response.Header.Get(contextRequestIdHeader); contextId != requestId {
+				t.Errorf("expected the route to see request ID [%s], got [%s]", requestId, contextId)
+			}
+		})
+	}
+
+	// Requests that don't match a route should still get an ID
+	response, err := client.Get("http://test/api/v1/missing")
+	if err != nil {
+		t.Fatalf("error sending request: %v", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusNotFound || response.Header.Get(RequestIdHeader) == "" {
+		t.Errorf("expected a 404 with a request ID, got status %d and ID [%s]", response.StatusCode, response.Header.Get(RequestIdHeader))
+	}
+}
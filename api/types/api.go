@@ -4,9 +4,25 @@ import (
 	"github.com/rocket-pool/node-manager-core/eth"
 )
 
+const (
+	// The header used to correlate a request with the server's logs for it
+	RequestIdHeader string = "X-Request-ID"
+
+	// The query parameter for the maximum number of items to return in a paginated request
+	PaginationLimitArg string = "limit"
+
+	// The query parameter for the index of the first item to return in a paginated request
+	PaginationOffsetArg string = "offset"
+
+	// The query parameter for the opaque cursor of the page to return in a paginated request; used instead of the
+	// offset by routes that return a cursor
+	PaginationCursorArg string = "cursor"
+)
+
 type ApiResponse[Data any] struct {
-	Data  *Data  `json:"data,omitempty"`
-	Error string `json:"error,omitempty"`
+	Data      *Data          `json:"data,omitempty"`
+	Error     string         `json:"error,omitempty"`
+	ErrorCode ResponseStatus `json:"errorCode,omitempty"`
 }
 
 type SuccessData struct {
@@ -16,6 +32,42 @@ type DataBatch[DataType any] struct {
 	Batch []DataType `json:"batch"`
 }
 
+// A single page of a collection that is too large to return in one response.
+// Pages are requested either by offset or, for collections that may change between requests, by an opaque cursor
+// returned with the previous page.
+type PaginatedResponse[DataType any] struct {
+	// The items in this page
+	Items []DataType `json:"items"`
+
+	// The total number of items in the collection, if it's known
+	Total *uint64 `json:"total,omitempty"`
+
+	// The offset to request to get the next page
+	NextOffset uint64 `json:"nextOffset"`
+
+	// The opaque cursor to request to get the next page, for routes that page by cursor instead of offset
+	Cursor string `json:"cursor,omitempty"`
+
+	// True if there are more items after this page
+	HasMore bool `json:"hasMore"`
+}
+
+// A response for a paginated request that pages by cursor, containing a single page of a collection that is too
+// large to return in one response
+type PaginatedApiResponse[DataType any] struct {
+	// The items in this page
+	Data []DataType `json:"data"`
+
+	// The total number of items in the collection
+	Total int `json:"total"`
+
+	// The opaque cursor to request to get the next page; empty if this is the last page
+	Cursor string `json:"cursor,omitempty"`
+
+	Error     string         `json:"error,omitempty"`
+	ErrorCode ResponseStatus `json:"errorCode,omitempty"`
+}
+
 type TxInfoData struct {
 	TxInfo *eth.TransactionInfo `json:"txInfo"`
 }
@@ -58,4 +110,13 @@ const (
 	// The request failed because the chain's state won't allow it to proceed. This is usually used for methods that
 	// build transactions, but the preconditions for it aren't correct (and executing it will revert)
 	ResponseStatus_InvalidChainState
+
+	// The request failed because the client has sent too many requests and needs to wait before trying again
+	ResponseStatus_RateLimited
+
+	// The request failed because the client didn't provide valid credentials
+	ResponseStatus_Unauthorized
+
+	// The request failed because the server, or a service it depends on, is temporarily unavailable
+	ResponseStatus_ServiceUnavailable
 )
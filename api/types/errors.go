@@ -0,0 +1,66 @@
+package types
+
+import "net/http"
+
+// Sentinel errors for each failure status, for use with errors.Is
+var (
+	ErrUnknown            *ApiError = &ApiError{Status: ResponseStatus_Unknown, Message: "unknown error"}
+	ErrServer             *ApiError = &ApiError{Status: ResponseStatus_Error, Message: "server error"}
+	ErrInvalidArguments   *ApiError = &ApiError{Status: ResponseStatus_InvalidArguments, Message: "invalid arguments"}
+	ErrAddressNotPresent  *ApiError = &ApiError{Status: ResponseStatus_AddressNotPresent, Message: "address not present"}
+	ErrWalletNotReady     *ApiError = &ApiError{Status: ResponseStatus_WalletNotReady, Message: "wallet not ready"}
+	ErrResourceConflict   *ApiError = &ApiError{Status: ResponseStatus_ResourceConflict, Message: "resource conflict"}
+	ErrResourceNotFound   *ApiError = &ApiError{Status: ResponseStatus_ResourceNotFound, Message: "resource not found"}
+	ErrClientsNotSynced   *ApiError = &ApiError{Status: ResponseStatus_ClientsNotSynced, Message: "clients not synced"}
+	ErrInvalidChainState  *ApiError = &ApiError{Status: ResponseStatus_InvalidChainState, Message: "invalid chain state"}
+	ErrRateLimited        *ApiError = &ApiError{Status: ResponseStatus_RateLimited, Message: "rate limited"}
+	ErrUnauthorized       *ApiError = &ApiError{Status: ResponseStatus_Unauthorized, Message: "unauthorized"}
+	ErrServiceUnavailable *ApiError = &ApiError{Status: ResponseStatus_ServiceUnavailable, Message: "service unavailable"}
+)
+
+// An error returned by the API server for a request that could not be completed
+type ApiError struct {
+	// The status of the failed request
+	Status ResponseStatus
+
+	// The error message provided by the server
+	Message string
+}
+
+// Get the error message
+func (e *ApiError) Error() string {
+	return e.Message
+}
+
+// Check if the target is an ApiError with the same status; this lets errors.Is match against the sentinel errors
+func (e *ApiError) Is(target error) bool {
+	apiErr, ok := target.(*ApiError)
+	if !ok {
+		return false
+	}
+	return apiErr.Status == e.Status
+}
+
+// Get the most likely response status for an HTTP status code, for servers that don't provide an error code
+func GetResponseStatusFromHttpCode(code int) ResponseStatus {
+	switch code {
+	case http.StatusOK:
+		return ResponseStatus_Success
+	case http.StatusBadRequest:
+		return ResponseStatus_InvalidArguments
+	case http.StatusConflict:
+		return ResponseStatus_ResourceConflict
+	case http.StatusNotFound:
+		return ResponseStatus_ResourceNotFound
+	case http.StatusTooManyRequests:
+		return ResponseStatus_RateLimited
+	case http.StatusUnauthorized:
+		return ResponseStatus_Unauthorized
+	case http.StatusInternalServerError:
+		return ResponseStatus_Error
+	case http.StatusServiceUnavailable:
+		return ResponseStatus_ServiceUnavailable
+	default:
+		return ResponseStatus_Unknown
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+// The request body for changing the log level of the daemon's loggers
+type SetLogLevelBody struct {
+	// The new minimum level to log (debug, info, warn, or error)
+	Level string `json:"level"`
+}
+
+type LogLevelData struct {
+	// The minimum level logged by the API logger
+	ApiLevel string `json:"apiLevel"`
+
+	// The minimum level logged by the tasks logger
+	TasksLevel string `json:"tasksLevel"`
+}
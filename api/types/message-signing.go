@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// The request body for signing a message with the node wallet
+type SignMessageBody struct {
+	// The message to sign; the EIP-191 personal message prefix will be added to it
+	Message string `json:"message"`
+}
+
+type SignMessageData struct {
+	SignedMessage hexutil.Bytes `json:"signedMessage"`
+}
+
+// The request body for verifying a message signature
+type VerifyMessageBody struct {
+	// The message that was signed, without the EIP-191 personal message prefix
+	Message string `json:"message"`
+
+	// The signature of the message
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+type VerifyMessageData struct {
+	Signer common.Address `json:"signer"`
+}
@@ -1,5 +1,7 @@
 package types
 
+import "github.com/rocket-pool/node-manager-core/wallet"
+
 // This is a wrapper for the EC / BN status report
 type ClientStatus struct {
 	IsWorking    bool    `json:"isWorking"`
@@ -15,3 +17,24 @@ type ClientManagerStatus struct {
 	FallbackEnabled      bool         `json:"fallbackEnabled"`
 	FallbackClientStatus ClientStatus `json:"fallbackEcStatus"`
 }
+
+// The combined health of the daemon and the services it depends on
+type HealthStatus struct {
+	// True if the EC and BN each have a working, synced client and the wallet can sign for the node address
+	IsHealthy bool `json:"isHealthy"`
+
+	// The status of the Execution client manager
+	ExecutionClientStatus ClientManagerStatus `json:"ecStatus"`
+
+	// The status of the Beacon Node manager
+	BeaconNodeStatus ClientManagerStatus `json:"bnStatus"`
+
+	// The status of the node wallet
+	WalletStatus wallet.WalletStatus `json:"walletStatus"`
+
+	// What the node wallet is able to do with transactions
+	SigningCapability wallet.SigningCapability `json:"signingCapability"`
+
+	// How long the daemon has been running, in seconds
+	UptimeSeconds float64 `json:"uptimeSeconds"`
+}
@@ -20,11 +20,17 @@ type IBeaconClient interface {
 	GetValidatorStatuses(ctx context.Context, pubkeys []ValidatorPubkey, opts *ValidatorStatusOptions) (map[ValidatorPubkey]ValidatorStatus, error)
 	GetValidatorIndex(ctx context.Context, pubkey ValidatorPubkey) (string, error)
 	GetValidatorSyncDuties(ctx context.Context, indices []string, epoch uint64) (map[string]bool, error)
+	GetValidatorSyncCommitteeIndices(ctx context.Context, indices []string, epoch uint64) (map[string][]uint64, error)
+	GetSyncCommittee(ctx context.Context, stateId string, epoch *uint64) (SyncCommittee, error)
 	GetValidatorProposerDuties(ctx context.Context, indices []string, epoch uint64) (map[string]uint64, error)
+	GetValidatorProposerSlots(ctx context.Context, indices []string, epoch uint64) (map[string][]uint64, error)
 	GetDomainData(ctx context.Context, domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error)
+	GetDomainDataForState(ctx context.Context, domainType []byte, epoch uint64, stateId string) ([]byte, error)
+	GetFork(ctx context.Context, stateId string) (ForkInfo, bool, error)
 	ExitValidator(ctx context.Context, validatorIndex string, epoch uint64, signature ValidatorSignature) error
 	Close(ctx context.Context) error
 	GetEth1DataForEth2Block(ctx context.Context, blockId string) (Eth1Data, bool, error)
 	GetCommitteesForEpoch(ctx context.Context, epoch *uint64) (Committees, error)
 	ChangeWithdrawalCredentials(ctx context.Context, validatorIndex string, fromBlsPubkey ValidatorPubkey, toExecutionAddress common.Address, signature ValidatorSignature) error
+	SubscribeToEvents(ctx context.Context, topics []EventTopic) (<-chan BeaconEvent, error)
 }
@@ -1,6 +1,9 @@
 package client
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 type IBeaconApiProvider interface {
 	Beacon_Attestations(ctx context.Context, blockId string) (AttestationsResponse, bool, error)
@@ -8,11 +11,15 @@ type IBeaconApiProvider interface {
 	Beacon_BlsToExecutionChanges_Post(ctx context.Context, request BLSToExecutionChangeRequest) error
 	Beacon_Committees(ctx context.Context, stateId string, epoch *uint64) (CommitteesResponse, error)
 	Beacon_FinalityCheckpoints(ctx context.Context, stateId string) (FinalityCheckpointsResponse, error)
+	Beacon_Fork(ctx context.Context, stateId string) (ForkResponse, bool, error)
 	Beacon_Genesis(ctx context.Context) (GenesisResponse, error)
 	Beacon_Header(ctx context.Context, blockId string) (BeaconBlockHeaderResponse, bool, error)
+	Beacon_SyncCommittees(ctx context.Context, stateId string, epoch *uint64) (SyncCommitteesResponse, error)
 	Beacon_Validators(ctx context.Context, stateId string, ids []string) (ValidatorsResponse, error)
 	Beacon_VoluntaryExits_Post(ctx context.Context, request VoluntaryExitRequest) error
+	Events_Subscribe(ctx context.Context, topics []string) (io.ReadCloser, error)
 	Config_DepositContract(ctx context.Context) (Eth2DepositContractResponse, error)
+	Config_ForkSchedule(ctx context.Context) (ForkScheduleResponse, error)
 	Config_Spec(ctx context.Context) (Eth2ConfigResponse, error)
 	Node_Syncing(ctx context.Context) (SyncStatusResponse, error)
 	Validator_DutiesProposer(ctx context.Context, indices []string, epoch uint64) (ProposerDutiesResponse, error)
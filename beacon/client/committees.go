@@ -66,6 +66,31 @@ func (c *CommitteesResponse) Validators(idx int) []string {
 	return c.Data[idx].Validators
 }
 
+// Get all of the committees assigned to the provided slot.
+// Returns false if there aren't any committees for that slot in the response.
+func (c *CommitteesResponse) GetCommitteeForSlot(slot uint64) ([]Committee, bool) {
+	committees := []Committee{}
+	for _, committee := range c.Data {
+		if uint64(committee.Slot) == slot {
+			committees = append(committees, committee)
+		}
+	}
+	return committees, len(committees) > 0
+}
+
+// Get the slot and index of the committee the validator with the provided index belongs to, and its position within
+// that committee. Returns false if the validator isn't in any of the committees in the response.
+func (c *CommitteesResponse) GetCommitteeForValidatorIndex(index string) (slot uint64, committeeIndex uint64, positionInCommittee int, found bool) {
+	for _, committee := range c.Data {
+		for position, validator := range committee.Validators {
+			if validator == index {
+				return uint64(committee.Slot), uint64(committee.Index), position, true
+			}
+		}
+	}
+	return 0, 0, 0, false
+}
+
 func (c *CommitteesResponse) Release() {
 	for _, committee := range c.Data {
 		// Reset the slice length to 0 (capacity stays the same)
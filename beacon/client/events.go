@@ -0,0 +1,223 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+const (
+	// The delay before the first attempt to reconnect to a dropped event stream
+	eventStreamInitialReconnectDelay time.Duration = time.Second
+
+	// The longest delay between attempts to reconnect to a dropped event stream
+	eventStreamMaxReconnectDelay time.Duration = 30 * time.Second
+
+	// The number of consecutive failed reconnection attempts before an event subscription is abandoned
+	eventStreamMaxReconnectAttempts int = 10
+
+	// The largest event frame line that will be read from the stream
+	eventStreamMaxLineSize int = 1024 * 1024
+
+	// The number of events buffered in a subscription's channel
+	eventStreamBufferSize int = 16
+)
+
+// Subscribe to events from the Beacon Node's event stream.
+// The returned channel receives an event for each of the provided topics as the Beacon Node emits them. If the stream
+// drops, it's reopened automatically with an exponential backoff; the channel is closed once the context is cancelled
+// or the Beacon Node can't be reached after several attempts in a row.
+// Problems with the stream are logged to the logger in the context, if it has one.
+func (c *StandardClient) SubscribeToEvents(ctx context.Context, topics []beacon.EventTopic) (<-chan beacon.BeaconEvent, error) {
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("at least one event topic is required")
+	}
+	topicStrings := make([]string, len(topics))
+	for i, topic := range topics {
+		topicStrings[i] = string(topic)
+	}
+
+	// Open the stream up front so connection problems are reported to the caller
+	stream, err := c.provider.Events_Subscribe(ctx, topicStrings)
+	if err != nil {
+		return nil, err
+	}
+
+	logger, _ := log.FromContext(ctx)
+	events := make(chan beacon.BeaconEvent, eventStreamBufferSize)
+	go func() {
+		defer close(events)
+		for {
+			err := readEventStream(ctx, logger, stream, events)
+			_ = stream.Close()
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil && logger != nil {
+				logger.Warn("Error reading Beacon Node event stream, reconnecting...", log.Err(err))
+			}
+
+			// Reconnect with an exponential backoff
+			stream = c.reconnectEventStream(ctx, logger, topicStrings)
+			if stream == nil {
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
+// Reopen a dropped event stream, backing off between attempts. Returns nil if the context was cancelled or every
+// attempt failed.
+func (c *StandardClient) reconnectEventStream(ctx context.Context, logger *log.Logger, topics []string) io.ReadCloser {
+	delay := eventStreamInitialReconnectDelay
+	for attempt := 1; attempt <= eventStreamMaxReconnectAttempts; attempt++ {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+
+		stream, err := c.provider.Events_Subscribe(ctx, topics)
+		if err == nil {
+			return stream
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		if logger != nil {
+			logger.Warn("Error reconnecting to Beacon Node event stream", slog.Int("attempt", attempt), slog.Int("maxAttempts", eventStreamMaxReconnectAttempts), log.Err(err))
+		}
+
+		delay *= 2
+		if delay > eventStreamMaxReconnectDelay {
+			delay = eventStreamMaxReconnectDelay
+		}
+	}
+	return nil
+}
+
+// Read server-sent event frames from the stream until it ends, sending each recognized event to the channel.
+// Returns nil if the stream ended cleanly.
+func readEventStream(ctx context.Context, logger *log.Logger, stream io.Reader, events chan<- beacon.BeaconEvent) error {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 4096), eventStreamMaxLineSize)
+
+	var eventName string
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		// A blank line ends the frame
+		if line == "" {
+			if eventName != "" && data.Len() > 0 {
+				event, err := parseEvent(beacon.EventTopic(eventName), []byte(data.String()))
+				if err != nil {
+					if logger != nil {
+						logger.Debug("Skipping malformed Beacon Node event", log.Err(err))
+					}
+				} else if event != nil {
+					select {
+					case events <- *event:
+					case <-ctx.Done():
+						return nil
+					}
+				}
+			}
+			eventName = ""
+			data.Reset()
+			continue
+		}
+
+		// Lines starting with a colon are comments, which some clients send as keepalives
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			eventName = value
+		case "data":
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(value)
+		}
+	}
+	return scanner.Err()
+}
+
+// Parse the data of an event frame into a typed event. Returns nil if the topic isn't supported.
+func parseEvent(topic beacon.EventTopic, data []byte) (*beacon.BeaconEvent, error) {
+	event := &beacon.BeaconEvent{
+		Topic: topic,
+	}
+	switch topic {
+	case beacon.EventTopic_Head:
+		var head HeadEvent
+		if err := json.Unmarshal(data, &head); err != nil {
+			return nil, fmt.Errorf("error decoding %s event: %w", topic, err)
+		}
+		event.Head = &beacon.HeadEvent{
+			Slot:                uint64(head.Slot),
+			Block:               common.BytesToHash(head.Block),
+			State:               common.BytesToHash(head.State),
+			EpochTransition:     head.EpochTransition,
+			ExecutionOptimistic: head.ExecutionOptimistic,
+		}
+
+	case beacon.EventTopic_Block:
+		var block BlockEvent
+		if err := json.Unmarshal(data, &block); err != nil {
+			return nil, fmt.Errorf("error decoding %s event: %w", topic, err)
+		}
+		event.Block = &beacon.BlockEvent{
+			Slot:                uint64(block.Slot),
+			Block:               common.BytesToHash(block.Block),
+			ExecutionOptimistic: block.ExecutionOptimistic,
+		}
+
+	case beacon.EventTopic_FinalizedCheckpoint:
+		var checkpoint FinalizedCheckpointEvent
+		if err := json.Unmarshal(data, &checkpoint); err != nil {
+			return nil, fmt.Errorf("error decoding %s event: %w", topic, err)
+		}
+		event.FinalizedCheckpoint = &beacon.FinalizedCheckpointEvent{
+			Block:               common.BytesToHash(checkpoint.Block),
+			State:               common.BytesToHash(checkpoint.State),
+			Epoch:               uint64(checkpoint.Epoch),
+			ExecutionOptimistic: checkpoint.ExecutionOptimistic,
+		}
+
+	case beacon.EventTopic_ChainReorg:
+		var reorg ChainReorgEvent
+		if err := json.Unmarshal(data, &reorg); err != nil {
+			return nil, fmt.Errorf("error decoding %s event: %w", topic, err)
+		}
+		event.ChainReorg = &beacon.ChainReorgEvent{
+			Slot:                uint64(reorg.Slot),
+			Depth:               uint64(reorg.Depth),
+			OldHeadBlock:        common.BytesToHash(reorg.OldHeadBlock),
+			NewHeadBlock:        common.BytesToHash(reorg.NewHeadBlock),
+			OldHeadState:        common.BytesToHash(reorg.OldHeadState),
+			NewHeadState:        common.BytesToHash(reorg.NewHeadState),
+			Epoch:               uint64(reorg.Epoch),
+			ExecutionOptimistic: reorg.ExecutionOptimistic,
+		}
+
+	default:
+		return nil, nil
+	}
+	return event, nil
+}
@@ -3,16 +3,20 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/goccy/go-json"
-	"github.com/rocket-pool/node-manager-core/beacon"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
@@ -22,11 +26,13 @@ const (
 	RequestSyncStatusPath                  = "/eth/v1/node/syncing"
 	RequestEth2ConfigPath                  = "/eth/v1/config/spec"
 	RequestEth2DepositContractMethod       = "/eth/v1/config/deposit_contract"
+	RequestForkSchedulePath                = "/eth/v1/config/fork_schedule"
 	RequestCommitteePath                   = "/eth/v1/beacon/states/%s/committees"
 	RequestGenesisPath                     = "/eth/v1/beacon/genesis"
 	RequestFinalityCheckpointsPath         = "/eth/v1/beacon/states/%s/finality_checkpoints"
 	RequestForkPath                        = "/eth/v1/beacon/states/%s/fork"
 	RequestValidatorsPath                  = "/eth/v1/beacon/states/%s/validators"
+	RequestSyncCommitteesPath              = "/eth/v1/beacon/states/%s/sync_committees"
 	RequestVoluntaryExitPath               = "/eth/v1/beacon/pool/voluntary_exits"
 	RequestAttestationsPath                = "/eth/v1/beacon/blocks/%s/attestations"
 	RequestBeaconBlockPath                 = "/eth/v2/beacon/blocks/%s"
@@ -34,24 +40,225 @@ const (
 	RequestValidatorSyncDuties             = "/eth/v1/validator/duties/sync/%s"
 	RequestValidatorProposerDuties         = "/eth/v1/validator/duties/proposer/%s"
 	RequestWithdrawalCredentialsChangePath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	RequestEventsPath                      = "/eth/v1/events?topics=%s"
 
 	MaxRequestValidatorsCount = 600
+
+	// The default timeout for requests with large responses, such as validators and committees
+	DefaultLargeResponseTimeout time.Duration = 5 * time.Minute
 )
 
 type BeaconHttpProvider struct {
-	providerAddress string
-	client          http.Client
+	providerAddress      string
+	client               http.Client
+	largeResponseClient  http.Client
+	largeResponseTimeout time.Duration
+	transport            *http.Transport
+	headers              map[string]string
+	headersLock          *sync.RWMutex
+	requestGroup         *singleflight.Group
+}
+
+// An error for a request that the Beacon Node responded to with an unexpected HTTP status code
+type HttpStatusError struct {
+	// The HTTP status code of the response
+	StatusCode int
+
+	// The body of the response
+	Body string
+}
+
+// Creates a new HttpStatusError from a response
+func newHttpStatusError(statusCode int, body []byte) *HttpStatusError {
+	return &HttpStatusError{
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+}
+
+func (e *HttpStatusError) Error() string {
+	return fmt.Sprintf("HTTP status %d; response body: '%s'", e.StatusCode, e.Body)
+}
+
+// The response to a GET request that's shared between concurrent callers
+type sharedResponse struct {
+	body   []byte
+	status int
+}
+
+// Settings for a Beacon HTTP provider, which its options apply to before the provider is created
+type beaconHttpProviderSettings struct {
+	transport            *http.Transport
+	tlsConfig            *tls.Config
+	rootCAs              *x509.CertPool
+	insecureSkipVerify   bool
+	pool                 BeaconHttpProviderOptions
+	largeResponseTimeout time.Duration
+}
+
+// An option for configuring the HTTP transport of a Beacon HTTP provider.
+// Options can be provided in any order; the transport is built from all of them once they've been applied.
+type BeaconHttpProviderOption func(*beaconHttpProviderSettings)
+
+// Use a custom transport for the HTTP client instead of the default one.
+// The transport is cloned, and the other options are applied on top of the clone. A nil transport uses the default.
+func WithTransport(transport *http.Transport) BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.transport = transport
+	}
+}
+
+// Use a custom TLS configuration for requests to the Beacon Node
+func WithTLSConfig(tlsConfig *tls.Config) BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.tlsConfig = tlsConfig
+	}
+}
+
+// Trust the certificates in the provided pool when verifying the Beacon Node's certificate, such as a custom CA for
+// a Beacon Node with a self-signed certificate
+func WithRootCAs(rootCAs *x509.CertPool) BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.rootCAs = rootCAs
+	}
+}
+
+// Don't verify the Beacon Node's certificate. This is insecure and should only be used for testing or on trusted
+// networks.
+func WithInsecureSkipVerify() BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.insecureSkipVerify = true
+	}
+}
+
+// Set the maximum number of idle connections to keep open across all hosts
+func WithMaxIdleConns(maxIdleConns int) BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.pool.MaxIdleConns = maxIdleConns
+	}
 }
 
-func NewBeaconHttpProvider(providerAddress string, timeout time.Duration) *BeaconHttpProvider {
+// Set the maximum number of connections to the Beacon Node, including ones that are in use
+func WithMaxConnsPerHost(maxConnsPerHost int) BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.pool.MaxConnsPerHost = maxConnsPerHost
+	}
+}
+
+// Set the maximum number of idle connections to keep open to the Beacon Node.
+// Raise this when making many parallel requests, such as batched validator status lookups.
+func WithMaxIdleConnsPerHost(maxIdleConnsPerHost int) BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.pool.MaxIdleConnsPerHost = maxIdleConnsPerHost
+	}
+}
+
+// Set the interval between keep-alive probes on open connections
+func WithKeepAlive(keepAlive time.Duration) BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.pool.KeepAlive = keepAlive
+	}
+}
+
+// Connection pooling settings for a Beacon HTTP provider.
+// Zero values leave the corresponding setting of the default transport unchanged.
+type BeaconHttpProviderOptions struct {
+	// The maximum number of idle connections to keep open across all hosts
+	MaxIdleConns int
+
+	// The maximum number of connections to the Beacon Node, including ones that are in use
+	MaxConnsPerHost int
+
+	// The maximum number of idle connections to keep open to the Beacon Node
+	MaxIdleConnsPerHost int
+
+	// The interval between keep-alive probes on open connections
+	KeepAlive time.Duration
+}
+
+// Set the timeout for requests with large responses, such as validators and committees, which can take much longer
+// than the regular timeout to download. Defaults to DefaultLargeResponseTimeout.
+func WithLargeResponseTimeout(timeout time.Duration) BeaconHttpProviderOption {
+	return func(s *beaconHttpProviderSettings) {
+		s.largeResponseTimeout = timeout
+	}
+}
+
+// Creates a new Beacon HTTP provider.
+// By default, requests use the proxy settings from the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables.
+func NewBeaconHttpProvider(providerAddress string, timeout time.Duration, opts ...BeaconHttpProviderOption) *BeaconHttpProvider {
+	settings := &beaconHttpProviderSettings{
+		largeResponseTimeout: DefaultLargeResponseTimeout,
+	}
+	for _, opt := range opts {
+		opt(settings)
+	}
+
+	transport := settings.buildTransport()
 	return &BeaconHttpProvider{
 		providerAddress: providerAddress,
 		client: http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: transport,
+		},
+		largeResponseClient: http.Client{
+			Timeout:   settings.largeResponseTimeout,
+			Transport: transport,
 		},
+		largeResponseTimeout: settings.largeResponseTimeout,
+		transport:            transport,
+		headers:              map[string]string{},
+		headersLock:          &sync.RWMutex{},
+		requestGroup:         &singleflight.Group{},
 	}
 }
 
+// Creates a new Beacon HTTP provider that authenticates every request with the provided bearer token, such as for a
+// Beacon Node behind an API gateway
+func NewBeaconHttpProviderWithAuth(providerAddress string, timeout time.Duration, token string, opts ...BeaconHttpProviderOption) *BeaconHttpProvider {
+	provider := NewBeaconHttpProvider(providerAddress, timeout, opts...)
+	provider.SetCustomHeaders(map[string]string{
+		"Authorization": "Bearer " + token,
+	})
+	return provider
+}
+
+// Creates a new Beacon HTTP provider with custom connection pooling settings
+func NewBeaconHttpProviderWithOptions(providerAddress string, timeout time.Duration, opts BeaconHttpProviderOptions) *BeaconHttpProvider {
+	return NewBeaconHttpProvider(providerAddress, timeout,
+		WithMaxIdleConns(opts.MaxIdleConns),
+		WithMaxConnsPerHost(opts.MaxConnsPerHost),
+		WithMaxIdleConnsPerHost(opts.MaxIdleConnsPerHost),
+		WithKeepAlive(opts.KeepAlive),
+	)
+}
+
+// Set custom headers to send with every request to the Beacon Node, such as authentication headers.
+// These replace any custom headers that were set previously.
+func (p *BeaconHttpProvider) SetCustomHeaders(headers map[string]string) {
+	clone := make(map[string]string, len(headers))
+	for name, value := range headers {
+		clone[name] = value
+	}
+
+	p.headersLock.Lock()
+	defer p.headersLock.Unlock()
+	p.headers = clone
+}
+
+// Load a pool of CA certificates from a PEM file, for use with WithRootCAs
+func LoadCertPool(caCertPath string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(caCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading CA certificate file [%s]: %w", caCertPath, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("CA certificate file [%s] does not contain any valid PEM certificates", caCertPath)
+	}
+	return pool, nil
+}
+
 func (p *BeaconHttpProvider) Beacon_Attestations(ctx context.Context, blockId string) (AttestationsResponse, bool, error) {
 	responseBody, status, err := p.getRequest(ctx, fmt.Sprintf(RequestAttestationsPath, blockId))
 	if err != nil {
@@ -61,7 +268,7 @@ func (p *BeaconHttpProvider) Beacon_Attestations(ctx context.Context, blockId st
 		return AttestationsResponse{}, false, nil
 	}
 	if status != http.StatusOK {
-		return AttestationsResponse{}, false, fmt.Errorf("error getting attestations data for slot %s: HTTP status %d; response body: '%s'", blockId, status, string(responseBody))
+		return AttestationsResponse{}, false, fmt.Errorf("error getting attestations data for slot %s: %w", blockId, newHttpStatusError(status, responseBody))
 	}
 	var attestations AttestationsResponse
 	if err := json.Unmarshal(responseBody, &attestations); err != nil {
@@ -79,7 +286,7 @@ func (p *BeaconHttpProvider) Beacon_Block(ctx context.Context, blockId string) (
 		return BeaconBlockResponse{}, false, nil
 	}
 	if status != http.StatusOK {
-		return BeaconBlockResponse{}, false, fmt.Errorf("error getting beacon block data: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return BeaconBlockResponse{}, false, fmt.Errorf("error getting beacon block data: %w", newHttpStatusError(status, responseBody))
 	}
 	var beaconBlock BeaconBlockResponse
 	if err := json.Unmarshal(responseBody, &beaconBlock); err != nil {
@@ -95,7 +302,7 @@ func (p *BeaconHttpProvider) Beacon_BlsToExecutionChanges_Post(ctx context.Conte
 		return fmt.Errorf("error broadcasting withdrawal credentials change for validator %s: %w", request.Message.ValidatorIndex, err)
 	}
 	if status != http.StatusOK {
-		return fmt.Errorf("error broadcasting withdrawal credentials change for validator %s: HTTP status %d; response body: '%s'", request.Message.ValidatorIndex, status, string(responseBody))
+		return fmt.Errorf("error broadcasting withdrawal credentials change for validator %s: %w", request.Message.ValidatorIndex, newHttpStatusError(status, responseBody))
 	}
 	return nil
 }
@@ -109,8 +316,7 @@ func (p *BeaconHttpProvider) Beacon_Committees(ctx context.Context, stateId stri
 	}
 
 	// Committees responses are large, so let the json decoder read it in a buffered fashion
-	clientWithoutTimeout := http.Client{}
-	reader, status, err := getRequestReader(ctx, fmt.Sprintf(RequestCommitteePath, stateId)+query, p.providerAddress, clientWithoutTimeout)
+	reader, status, err := p.getRequestReader(ctx, fmt.Sprintf(RequestCommitteePath, stateId)+query, p.largeResponseClient)
 	if err != nil {
 		return CommitteesResponse{}, fmt.Errorf("error getting committees: %w", err)
 	}
@@ -120,7 +326,7 @@ func (p *BeaconHttpProvider) Beacon_Committees(ctx context.Context, stateId stri
 
 	if status != http.StatusOK {
 		body, _ := io.ReadAll(reader)
-		return CommitteesResponse{}, fmt.Errorf("error getting committees: HTTP status %d; response body: '%s'", status, string(body))
+		return CommitteesResponse{}, fmt.Errorf("error getting committees: %w", newHttpStatusError(status, body))
 	}
 
 	d := committeesDecoderPool.Get().(*committeesDecoder)
@@ -140,12 +346,12 @@ func (p *BeaconHttpProvider) Beacon_Committees(ctx context.Context, stateId stri
 }
 
 func (p *BeaconHttpProvider) Beacon_FinalityCheckpoints(ctx context.Context, stateId string) (FinalityCheckpointsResponse, error) {
-	responseBody, status, err := p.getRequest(ctx, fmt.Sprintf(RequestFinalityCheckpointsPath, stateId))
+	responseBody, status, err := p.getSharedRequest(ctx, fmt.Sprintf(RequestFinalityCheckpointsPath, stateId))
 	if err != nil {
 		return FinalityCheckpointsResponse{}, fmt.Errorf("error getting finality checkpoints: %w", err)
 	}
 	if status != http.StatusOK {
-		return FinalityCheckpointsResponse{}, fmt.Errorf("error getting finality checkpoints: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return FinalityCheckpointsResponse{}, fmt.Errorf("error getting finality checkpoints: %w", newHttpStatusError(status, responseBody))
 	}
 	var finalityCheckpoints FinalityCheckpointsResponse
 	if err := json.Unmarshal(responseBody, &finalityCheckpoints); err != nil {
@@ -154,13 +360,31 @@ func (p *BeaconHttpProvider) Beacon_FinalityCheckpoints(ctx context.Context, sta
 	return finalityCheckpoints, nil
 }
 
+func (p *BeaconHttpProvider) Beacon_Fork(ctx context.Context, stateId string) (ForkResponse, bool, error) {
+	responseBody, status, err := p.getRequest(ctx, fmt.Sprintf(RequestForkPath, stateId))
+	if err != nil {
+		return ForkResponse{}, false, fmt.Errorf("error getting fork data: %w", err)
+	}
+	if status == http.StatusNotFound {
+		return ForkResponse{}, false, nil
+	}
+	if status != http.StatusOK {
+		return ForkResponse{}, false, fmt.Errorf("error getting fork data: %w", newHttpStatusError(status, responseBody))
+	}
+	var fork ForkResponse
+	if err := json.Unmarshal(responseBody, &fork); err != nil {
+		return ForkResponse{}, false, fmt.Errorf("error decoding fork data: %w", err)
+	}
+	return fork, true, nil
+}
+
 func (p *BeaconHttpProvider) Beacon_Genesis(ctx context.Context) (GenesisResponse, error) {
-	responseBody, status, err := p.getRequest(ctx, RequestGenesisPath)
+	responseBody, status, err := p.getSharedRequest(ctx, RequestGenesisPath)
 	if err != nil {
 		return GenesisResponse{}, fmt.Errorf("error getting genesis data: %w", err)
 	}
 	if status != http.StatusOK {
-		return GenesisResponse{}, fmt.Errorf("error getting genesis data: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return GenesisResponse{}, fmt.Errorf("error getting genesis data: %w", newHttpStatusError(status, responseBody))
 	}
 	var genesis GenesisResponse
 	if err := json.Unmarshal(responseBody, &genesis); err != nil {
@@ -178,7 +402,7 @@ func (p *BeaconHttpProvider) Beacon_Header(ctx context.Context, blockId string)
 		return BeaconBlockHeaderResponse{}, false, nil
 	}
 	if status != http.StatusOK {
-		return BeaconBlockHeaderResponse{}, false, fmt.Errorf("error getting beacon block header data: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return BeaconBlockHeaderResponse{}, false, fmt.Errorf("error getting beacon block header data: %w", newHttpStatusError(status, responseBody))
 	}
 	var beaconBlock BeaconBlockHeaderResponse
 	if err := json.Unmarshal(responseBody, &beaconBlock); err != nil {
@@ -187,17 +411,36 @@ func (p *BeaconHttpProvider) Beacon_Header(ctx context.Context, blockId string)
 	return beaconBlock, true, nil
 }
 
+func (p *BeaconHttpProvider) Beacon_SyncCommittees(ctx context.Context, stateId string, epoch *uint64) (SyncCommitteesResponse, error) {
+	query := ""
+	if epoch != nil {
+		query = fmt.Sprintf("?epoch=%d", *epoch)
+	}
+	responseBody, status, err := p.getRequest(ctx, fmt.Sprintf(RequestSyncCommitteesPath, stateId)+query)
+	if err != nil {
+		return SyncCommitteesResponse{}, fmt.Errorf("error getting sync committees: %w", err)
+	}
+	if status != http.StatusOK {
+		return SyncCommitteesResponse{}, fmt.Errorf("error getting sync committees: %w", newHttpStatusError(status, responseBody))
+	}
+	var syncCommittees SyncCommitteesResponse
+	if err := json.Unmarshal(responseBody, &syncCommittees); err != nil {
+		return SyncCommitteesResponse{}, fmt.Errorf("error decoding sync committees: %w", err)
+	}
+	return syncCommittees, nil
+}
+
 func (p *BeaconHttpProvider) Beacon_Validators(ctx context.Context, stateId string, ids []string) (ValidatorsResponse, error) {
 	var query string
 	if len(ids) > 0 {
 		query = fmt.Sprintf("?id=%s", strings.Join(ids, ","))
 	}
-	responseBody, status, err := p.getRequestWithoutTimeout(ctx, fmt.Sprintf(RequestValidatorsPath, stateId)+query)
+	responseBody, status, err := p.getLargeRequest(ctx, fmt.Sprintf(RequestValidatorsPath, stateId)+query)
 	if err != nil {
 		return ValidatorsResponse{}, fmt.Errorf("error getting validators: %w", err)
 	}
 	if status != http.StatusOK {
-		return ValidatorsResponse{}, fmt.Errorf("error getting validators: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return ValidatorsResponse{}, fmt.Errorf("error getting validators: %w", newHttpStatusError(status, responseBody))
 	}
 	var validators ValidatorsResponse
 	if err := json.Unmarshal(responseBody, &validators); err != nil {
@@ -212,18 +455,43 @@ func (p *BeaconHttpProvider) Beacon_VoluntaryExits_Post(ctx context.Context, req
 		return fmt.Errorf("error broadcasting exit for validator at index %s: %w", request.Message.ValidatorIndex, err)
 	}
 	if status != http.StatusOK {
-		return fmt.Errorf("error broadcasting exit for validator at index %s: HTTP status %d; response body: '%s'", request.Message.ValidatorIndex, status, string(responseBody))
+		return fmt.Errorf("error broadcasting exit for validator at index %s: %w", request.Message.ValidatorIndex, newHttpStatusError(status, responseBody))
 	}
 	return nil
 }
 
+func (p *BeaconHttpProvider) Events_Subscribe(ctx context.Context, topics []string) (io.ReadCloser, error) {
+	// Event streams stay open indefinitely, so they can't use the client timeout
+	clientWithoutTimeout := http.Client{
+		Transport: p.transport,
+	}
+	path := fmt.Sprintf(RequestUrlFormat, p.providerAddress, fmt.Sprintf(RequestEventsPath, strings.Join(topics, ",")))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating event stream request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	p.setCustomHeaders(req)
+
+	response, err := clientWithoutTimeout.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to events: %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		return nil, fmt.Errorf("error subscribing to events: %w", newHttpStatusError(response.StatusCode, body))
+	}
+	return response.Body, nil
+}
+
 func (p *BeaconHttpProvider) Config_DepositContract(ctx context.Context) (Eth2DepositContractResponse, error) {
 	responseBody, status, err := p.getRequest(ctx, RequestEth2DepositContractMethod)
 	if err != nil {
 		return Eth2DepositContractResponse{}, fmt.Errorf("error getting eth2 deposit contract: %w", err)
 	}
 	if status != http.StatusOK {
-		return Eth2DepositContractResponse{}, fmt.Errorf("error gettingeth2 deposit contract: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return Eth2DepositContractResponse{}, fmt.Errorf("error gettingeth2 deposit contract: %w", newHttpStatusError(status, responseBody))
 	}
 	var eth2DepositContract Eth2DepositContractResponse
 	if err := json.Unmarshal(responseBody, &eth2DepositContract); err != nil {
@@ -232,13 +500,28 @@ func (p *BeaconHttpProvider) Config_DepositContract(ctx context.Context) (Eth2De
 	return eth2DepositContract, nil
 }
 
+func (p *BeaconHttpProvider) Config_ForkSchedule(ctx context.Context) (ForkScheduleResponse, error) {
+	responseBody, status, err := p.getRequest(ctx, RequestForkSchedulePath)
+	if err != nil {
+		return ForkScheduleResponse{}, fmt.Errorf("error getting fork schedule: %w", err)
+	}
+	if status != http.StatusOK {
+		return ForkScheduleResponse{}, fmt.Errorf("error getting fork schedule: %w", newHttpStatusError(status, responseBody))
+	}
+	var forkSchedule ForkScheduleResponse
+	if err := json.Unmarshal(responseBody, &forkSchedule); err != nil {
+		return ForkScheduleResponse{}, fmt.Errorf("error decoding fork schedule: %w", err)
+	}
+	return forkSchedule, nil
+}
+
 func (p *BeaconHttpProvider) Config_Spec(ctx context.Context) (Eth2ConfigResponse, error) {
-	responseBody, status, err := p.getRequest(ctx, RequestEth2ConfigPath)
+	responseBody, status, err := p.getSharedRequest(ctx, RequestEth2ConfigPath)
 	if err != nil {
 		return Eth2ConfigResponse{}, fmt.Errorf("error getting eth2 config: %w", err)
 	}
 	if status != http.StatusOK {
-		return Eth2ConfigResponse{}, fmt.Errorf("error getting eth2 config: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return Eth2ConfigResponse{}, fmt.Errorf("error getting eth2 config: %w", newHttpStatusError(status, responseBody))
 	}
 	var eth2Config Eth2ConfigResponse
 	if err := json.Unmarshal(responseBody, &eth2Config); err != nil {
@@ -253,7 +536,7 @@ func (p *BeaconHttpProvider) Node_Syncing(ctx context.Context) (SyncStatusRespon
 		return SyncStatusResponse{}, fmt.Errorf("error getting node sync status: %w", err)
 	}
 	if status != http.StatusOK {
-		return SyncStatusResponse{}, fmt.Errorf("error getting node sync status: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return SyncStatusResponse{}, fmt.Errorf("error getting node sync status: %w", newHttpStatusError(status, responseBody))
 	}
 	var syncStatus SyncStatusResponse
 	if err := json.Unmarshal(responseBody, &syncStatus); err != nil {
@@ -268,7 +551,7 @@ func (p *BeaconHttpProvider) Validator_DutiesProposer(ctx context.Context, indic
 		return ProposerDutiesResponse{}, fmt.Errorf("error getting validator proposer duties: %w", err)
 	}
 	if status != http.StatusOK {
-		return ProposerDutiesResponse{}, fmt.Errorf("error getting validator proposer duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return ProposerDutiesResponse{}, fmt.Errorf("error getting validator proposer duties: %w", newHttpStatusError(status, responseBody))
 	}
 
 	var syncDuties ProposerDutiesResponse
@@ -286,7 +569,7 @@ func (p *BeaconHttpProvider) Validator_DutiesSync_Post(ctx context.Context, indi
 		return SyncDutiesResponse{}, fmt.Errorf("error getting validator sync duties: %w", err)
 	}
 	if status != http.StatusOK {
-		return SyncDutiesResponse{}, fmt.Errorf("error getting validator sync duties: HTTP status %d; response body: '%s'", status, string(responseBody))
+		return SyncDutiesResponse{}, fmt.Errorf("error getting validator sync duties: %w", newHttpStatusError(status, responseBody))
 	}
 
 	var syncDuties SyncDutiesResponse
@@ -302,19 +585,55 @@ func (p *BeaconHttpProvider) Validator_DutiesSync_Post(ctx context.Context, indi
 
 // Make a GET request to the beacon node and read the body of the response
 func (p *BeaconHttpProvider) getRequest(ctx context.Context, requestPath string) ([]byte, int, error) {
-	return getRequestImpl(ctx, requestPath, p.providerAddress, p.client)
+	return p.getRequestImpl(ctx, requestPath, p.client)
 }
 
-// Make a GET request to the beacon node and read the body of the response
-func (p *BeaconHttpProvider) getRequestWithoutTimeout(ctx context.Context, requestPath string) ([]byte, int, error) {
-	clientWithoutTimeout := http.Client{}
-	return getRequestImpl(ctx, requestPath, p.providerAddress, clientWithoutTimeout)
+// Make a GET request to the beacon node and read the body of the response.
+// Concurrent calls for the same path share a single request, so they all get its result. The shared request isn't tied
+// to any one caller's context, so a caller cancelling only stops that caller from waiting for it; it's bounded by the
+// provider's timeout instead. Only use this for requests that have no side effects.
+func (p *BeaconHttpProvider) getSharedRequest(ctx context.Context, requestPath string) ([]byte, int, error) {
+	key := fmt.Sprintf(RequestUrlFormat, p.providerAddress, requestPath)
+	resultChannel := p.requestGroup.DoChan(key, func() (any, error) {
+		// Keep the caller's values (such as its logger) but not its cancellation or deadline
+		sharedCtx := context.WithoutCancel(ctx)
+		if p.client.Timeout > 0 {
+			var cancel context.CancelFunc
+			sharedCtx, cancel = context.WithTimeout(sharedCtx, p.client.Timeout)
+			defer cancel()
+		}
+
+		body, status, err := p.getRequest(sharedCtx, requestPath)
+		if err != nil {
+			return nil, err
+		}
+		return sharedResponse{
+			body:   body,
+			status: status,
+		}, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return []byte{}, 0, ctx.Err()
+	case result := <-resultChannel:
+		if result.Err != nil {
+			return []byte{}, 0, result.Err
+		}
+		response := result.Val.(sharedResponse)
+		return response.body, response.status, nil
+	}
+}
+
+// Make a GET request to the beacon node and read the body of the response, using the longer timeout for large responses
+func (p *BeaconHttpProvider) getLargeRequest(ctx context.Context, requestPath string) ([]byte, int, error) {
+	return p.getRequestImpl(ctx, requestPath, p.largeResponseClient)
 }
 
 // Make a GET request to the beacon node and read the body of the response
-func getRequestImpl(ctx context.Context, requestPath string, providerAddress string, client http.Client) ([]byte, int, error) {
+func (p *BeaconHttpProvider) getRequestImpl(ctx context.Context, requestPath string, client http.Client) ([]byte, int, error) {
 	// Send request
-	reader, status, err := getRequestReader(ctx, requestPath, providerAddress, client)
+	reader, status, err := p.getRequestReader(ctx, requestPath, client)
 	if err != nil {
 		return []byte{}, 0, err
 	}
@@ -348,6 +667,7 @@ func (p *BeaconHttpProvider) postRequest(ctx context.Context, requestPath string
 		return nil, 0, fmt.Errorf("error creating POST request to [%s]: %w", path, err)
 	}
 	request.Header.Set("Content-Type", RequestContentType)
+	p.setCustomHeaders(request)
 
 	// Submit the request
 	response, err := p.client.Do(request)
@@ -368,20 +688,16 @@ func (p *BeaconHttpProvider) postRequest(ctx context.Context, requestPath string
 	return body, response.StatusCode, nil
 }
 
-// Get an eth2 epoch number by time
-func epochAt(config beacon.Eth2Config, time uint64) uint64 {
-	return config.GenesisEpoch + (time-config.GenesisTime)/config.SecondsPerEpoch
-}
-
 // Make a GET request but do not read its body yet (allows buffered decoding)
-func getRequestReader(ctx context.Context, requestPath string, providerAddress string, client http.Client) (io.ReadCloser, int, error) {
+func (p *BeaconHttpProvider) getRequestReader(ctx context.Context, requestPath string, client http.Client) (io.ReadCloser, int, error) {
 	// Make the request
-	path := fmt.Sprintf(RequestUrlFormat, providerAddress, requestPath)
+	path := fmt.Sprintf(RequestUrlFormat, p.providerAddress, requestPath)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, nil)
 	if err != nil {
 		return nil, 0, fmt.Errorf("error creating GET request to [%s]: %w", path, err)
 	}
 	req.Header.Set("Content-Type", RequestContentType)
+	p.setCustomHeaders(req)
 
 	// Submit the request
 	response, err := client.Do(req)
@@ -393,6 +709,15 @@ func getRequestReader(ctx context.Context, requestPath string, providerAddress s
 	return response.Body, response.StatusCode, nil
 }
 
+// Add the custom headers to a request
+func (p *BeaconHttpProvider) setCustomHeaders(request *http.Request) {
+	p.headersLock.RLock()
+	defer p.headersLock.RUnlock()
+	for name, value := range p.headers {
+		request.Header.Set(name, value)
+	}
+}
+
 // ==========================
 // === Committees Decoder ===
 // ==========================
@@ -427,3 +752,49 @@ var committeesDecoderPool sync.Pool = sync.Pool{
 		return &out
 	},
 }
+
+// Build the HTTP transport from the settings, starting from a clone of the custom transport or the default one
+func (s *beaconHttpProviderSettings) buildTransport() *http.Transport {
+	var transport *http.Transport
+	if s.transport != nil {
+		transport = s.transport.Clone()
+	} else {
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = http.ProxyFromEnvironment
+	}
+
+	// TLS
+	if s.tlsConfig != nil {
+		transport.TLSClientConfig = s.tlsConfig.Clone()
+	}
+	if s.rootCAs != nil || s.insecureSkipVerify {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		if s.rootCAs != nil {
+			transport.TLSClientConfig.RootCAs = s.rootCAs
+		}
+		if s.insecureSkipVerify {
+			transport.TLSClientConfig.InsecureSkipVerify = true
+		}
+	}
+
+	// Connection pooling
+	if s.pool.MaxIdleConns > 0 {
+		transport.MaxIdleConns = s.pool.MaxIdleConns
+	}
+	if s.pool.MaxConnsPerHost > 0 {
+		transport.MaxConnsPerHost = s.pool.MaxConnsPerHost
+	}
+	if s.pool.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = s.pool.MaxIdleConnsPerHost
+	}
+	if s.pool.KeepAlive > 0 {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: s.pool.KeepAlive,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+	return transport
+}
@@ -0,0 +1,342 @@
+package client_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+)
+
+func TestSharedRequestOutlivesCancelledCaller(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		_, _ = w.Write([]byte(`{"data":{"genesis_time":"1606824023"}}`))
+	}))
+	defer server.Close()
+	provider := client.NewBeaconHttpProvider(server.URL, 5*time.Second)
+
+	// The first caller gives up while the request is in flight
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstResult := make(chan error, 1)
+	go func() {
+		_, err := provider.Beacon_Genesis(firstCtx)
+		firstResult <- err
+	}()
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The second caller joins the same request
+	secondResult := make(chan error, 1)
+	var genesis client.GenesisResponse
+	go func() {
+		var err error
+		genesis, err = provider.Beacon_Genesis(context.Background())
+		secondResult <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	cancelFirst()
+	select {
+	case err := <-firstResult:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected the cancelled caller to get its own cancellation, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the cancelled caller to stop waiting")
+	}
+
+	// The shared request keeps going for the second caller
+	close(release)
+	select {
+	case err := <-secondResult:
+		if err != nil {
+			t.Fatalf("expected the second caller to get the shared result, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the second caller to get a result")
+	}
+	if genesis.Data.GenesisTime != 1606824023 {
+		t.Errorf("unexpected genesis time %d", genesis.Data.GenesisTime)
+	}
+	if count := requests.Load(); count != 1 {
+		t.Errorf("expected 1 request to the Beacon Node, got %d", count)
+	}
+}
+
+// A fake Beacon Node that records the headers of each request it receives, by path
+type headerRecorder struct {
+	server  *httptest.Server
+	lock    sync.Mutex
+	headers map[string]http.Header
+}
+
+func newHeaderRecorder(t *testing.T) *headerRecorder {
+	recorder := &headerRecorder{
+		headers: map[string]http.Header{},
+	}
+	recorder.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder.lock.Lock()
+		recorder.headers[r.Method+" "+r.URL.Path] = r.Header.Clone()
+		recorder.lock.Unlock()
+
+		switch r.URL.Path {
+		case client.RequestSyncStatusPath:
+			_, _ = w.Write([]byte(`{"data":{"is_syncing":false,"head_slot":"100","sync_distance":"0"}}`))
+		case client.RequestVoluntaryExitPath:
+			w.WriteHeader(http.StatusOK)
+		case "/eth/v1/events":
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.WriteHeader(http.StatusOK)
+		default:
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		}
+	}))
+	t.Cleanup(recorder.server.Close)
+	return recorder
+}
+
+// Get the headers of the request made to the path, failing the test if there wasn't one
+func (r *headerRecorder) getHeaders(t *testing.T, method string, path string) http.Header {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	headers, exists := r.headers[method+" "+path]
+	if !exists {
+		t.Fatalf("expected a %s request to %s", method, path)
+	}
+	return headers
+}
+
+// Make a GET, streamed GET, POST, and event stream request with the provider
+func makeRequests(t *testing.T, provider *client.BeaconHttpProvider) {
+	ctx := context.Background()
+	if _, err := provider.Node_Syncing(ctx); err != nil {
+		t.Fatalf("error getting sync status: %v", err)
+	}
+	if _, err := provider.Beacon_Committees(ctx, "head", nil); err != nil {
+		t.Fatalf("error getting committees: %v", err)
+	}
+	err := provider.Beacon_VoluntaryExits_Post(ctx, client.VoluntaryExitRequest{
+		Message: client.VoluntaryExitMessage{
+			Epoch:          1,
+			ValidatorIndex: "1",
+		},
+		Signature: make([]byte, 96),
+	})
+	if err != nil {
+		t.Fatalf("error posting exit: %v", err)
+	}
+	stream, err := provider.Events_Subscribe(ctx, []string{"head"})
+	if err != nil {
+		t.Fatalf("error subscribing to events: %v", err)
+	}
+	_ = stream.Close()
+}
+
+func TestBearerTokenSent(t *testing.T) {
+	recorder := newHeaderRecorder(t)
+	provider := client.NewBeaconHttpProviderWithAuth(recorder.server.URL, 5*time.Second, "secret-token")
+	makeRequests(t, provider)
+
+	requests := []struct {
+		method string
+		path   string
+	}{
+		{method: http.MethodGet, path: client.RequestSyncStatusPath},
+		{method: http.MethodGet, path: "/eth/v1/beacon/states/head/committees"},
+		{method: http.MethodPost, path: client.RequestVoluntaryExitPath},
+		{method: http.MethodGet, path: "/eth/v1/events"},
+	}
+	for _, request := range requests {
+		headers := recorder.getHeaders(t, request.method, request.path)
+		if auth := headers.Get("Authorization"); auth != "Bearer secret-token" {
+			t.Errorf("expected the bearer token on the %s request to %s, got [%s]", request.method, request.path, auth)
+		}
+	}
+}
+
+func TestNoAuthHeaderByDefault(t *testing.T) {
+	recorder := newHeaderRecorder(t)
+	provider := client.NewBeaconHttpProvider(recorder.server.URL, 5*time.Second)
+	makeRequests(t, provider)
+
+	headers := recorder.getHeaders(t, http.MethodGet, client.RequestSyncStatusPath)
+	if auth := headers.Get("Authorization"); auth != "" {
+		t.Errorf("expected no Authorization header, got [%s]", auth)
+	}
+}
+
+func TestSetCustomHeaders(t *testing.T) {
+	recorder := newHeaderRecorder(t)
+	provider := client.NewBeaconHttpProviderWithAuth(recorder.server.URL, 5*time.Second, "secret-token")
+
+	// Custom headers replace the previous ones, and changing the map afterwards has no effect
+	headers := map[string]string{
+		"X-Api-Key": "key",
+	}
+	provider.SetCustomHeaders(headers)
+	headers["X-Api-Key"] = "changed"
+	makeRequests(t, provider)
+
+	sent := recorder.getHeaders(t, http.MethodPost, client.RequestVoluntaryExitPath)
+	if key := sent.Get("X-Api-Key"); key != "key" {
+		t.Errorf("expected the custom header to be sent, got [%s]", key)
+	}
+	if auth := sent.Get("Authorization"); auth != "" {
+		t.Errorf("expected the bearer token to be replaced, got [%s]", auth)
+	}
+	if contentType := sent.Get("Content-Type"); contentType != client.RequestContentType {
+		t.Errorf("expected the standard content type to be kept, got [%s]", contentType)
+	}
+}
+
+// Create a fake Beacon Node that reports its sync status after the delay, counting the connections opened to it
+func newSyncStatusServer(t *testing.T, useTLS bool, delay time.Duration) (*httptest.Server, *atomic.Int32) {
+	connections := &atomic.Int32{}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"is_syncing":false,"head_slot":"100","sync_distance":"0"}}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			connections.Add(1)
+		}
+	}
+	if useTLS {
+		server.StartTLS()
+	} else {
+		server.Start()
+	}
+	t.Cleanup(server.Close)
+	return server, connections
+}
+
+func TestTransportOptionsAnyOrder(t *testing.T) {
+	server, _ := newSyncStatusServer(t, true, 0)
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	tests := []struct {
+		name    string
+		opts    []client.BeaconHttpProviderOption
+		isValid bool
+	}{
+		{
+			name:    "no TLS options",
+			opts:    []client.BeaconHttpProviderOption{},
+			isValid: false,
+		}, {
+			name:    "root CAs after the transport",
+			opts:    []client.BeaconHttpProviderOption{client.WithTransport(&http.Transport{}), client.WithRootCAs(rootCAs)},
+			isValid: true,
+		}, {
+			name:    "root CAs before the transport",
+			opts:    []client.BeaconHttpProviderOption{client.WithRootCAs(rootCAs), client.WithTransport(&http.Transport{})},
+			isValid: true,
+		}, {
+			name:    "TLS config before the transport",
+			opts:    []client.BeaconHttpProviderOption{client.WithTLSConfig(&tls.Config{RootCAs: rootCAs}), client.WithTransport(&http.Transport{})},
+			isValid: true,
+		}, {
+			name:    "nil transport",
+			opts:    []client.BeaconHttpProviderOption{client.WithTransport(nil), client.WithInsecureSkipVerify()},
+			isValid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := client.NewBeaconHttpProvider(server.URL, 5*time.Second, test.opts...)
+			_, err := provider.Node_Syncing(context.Background())
+			if test.isValid && err != nil {
+				t.Errorf("error getting sync status: %v", err)
+			}
+			if !test.isValid && err == nil {
+				t.Error("expected the server's certificate to be rejected")
+			}
+		})
+	}
+}
+
+func TestWithTransportNotModified(t *testing.T) {
+	transport := &http.Transport{}
+	tlsConfig := &tls.Config{}
+	_ = client.NewBeaconHttpProvider("http://localhost:5052", 5*time.Second,
+		client.WithTransport(transport),
+		client.WithTLSConfig(tlsConfig),
+		client.WithInsecureSkipVerify(),
+		client.WithMaxIdleConnsPerHost(64),
+	)
+	// Cloning sets up HTTP/2 on the original transport, which can add a TLS config, so only the option fields are checked
+	if transport.MaxIdleConnsPerHost != 0 {
+		t.Errorf("expected the provided transport not to be modified, got %d max idle connections per host", transport.MaxIdleConnsPerHost)
+	}
+	if transport.TLSClientConfig != nil && transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected the provided transport's TLS config not to be modified")
+	}
+	if tlsConfig.InsecureSkipVerify {
+		t.Error("expected the provided TLS config not to be modified")
+	}
+}
+
+func TestConnectionPoolLimits(t *testing.T) {
+	tests := []struct {
+		name                string
+		provider            func(url string) *client.BeaconHttpProvider
+		expectedConnections int32
+	}{
+		{
+			name: "options struct",
+			provider: func(url string) *client.BeaconHttpProvider {
+				return client.NewBeaconHttpProviderWithOptions(url, 5*time.Second, client.BeaconHttpProviderOptions{
+					MaxConnsPerHost:     1,
+					MaxIdleConnsPerHost: 1,
+					KeepAlive:           15 * time.Second,
+				})
+			},
+			expectedConnections: 1,
+		}, {
+			name: "limit before the transport",
+			provider: func(url string) *client.BeaconHttpProvider {
+				return client.NewBeaconHttpProvider(url, 5*time.Second, client.WithMaxConnsPerHost(1), client.WithTransport(&http.Transport{}))
+			},
+			expectedConnections: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, connections := newSyncStatusServer(t, false, 50*time.Millisecond)
+			provider := test.provider(server.URL)
+
+			// Parallel requests have to share the connection
+			var wg sync.WaitGroup
+			for i := 0; i < 4; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := provider.Node_Syncing(context.Background()); err != nil {
+						t.Errorf("error getting sync status: %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+			if count := connections.Load(); count != test.expectedConnections {
+				t.Errorf("expected %d connections, got %d", test.expectedConnections, count)
+			}
+		})
+	}
+}
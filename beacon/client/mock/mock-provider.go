@@ -0,0 +1,608 @@
+package mock
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+)
+
+// A Beacon API provider that returns configurable fixtures instead of sending requests to a Beacon Node, for testing
+// StandardClient without network I/O.
+// Each method returns the response set with its SetXxxResponse function (or the zero value if none was set), or the
+// error set with its SetXxxError function if there is one. Methods that report whether the requested object was found
+// return true once a response has been set for them.
+// Beacon_Validators only returns the validators in its response whose index or pubkey was requested, so batched
+// lookups behave like they do against a real Beacon Node.
+type MockBeaconApiProvider struct {
+	attestationsResponse client.AttestationsResponse
+	attestationsFound    bool
+	attestationsError    error
+
+	blockResponse client.BeaconBlockResponse
+	blockFound    bool
+	blockError    error
+
+	blsToExecutionChangesError error
+
+	committeesResponse client.CommitteesResponse
+	committeesError    error
+
+	finalityCheckpointsResponse client.FinalityCheckpointsResponse
+	finalityCheckpointsError    error
+
+	forkResponse client.ForkResponse
+	forkFound    bool
+	forkError    error
+
+	genesisResponse client.GenesisResponse
+	genesisError    error
+
+	headerResponse client.BeaconBlockHeaderResponse
+	headerFound    bool
+	headerError    error
+
+	syncCommitteesResponse client.SyncCommitteesResponse
+	syncCommitteesError    error
+
+	validatorsResponse client.ValidatorsResponse
+	validatorsError    error
+
+	voluntaryExitsError error
+
+	eventsResponse string
+	eventsError    error
+
+	depositContractResponse client.Eth2DepositContractResponse
+	depositContractError    error
+
+	forkScheduleResponse client.ForkScheduleResponse
+	forkScheduleError    error
+
+	specResponse client.Eth2ConfigResponse
+	specError    error
+
+	syncingResponse client.SyncStatusResponse
+	syncingError    error
+
+	proposerDutiesResponse client.ProposerDutiesResponse
+	proposerDutiesError    error
+
+	syncDutiesResponse client.SyncDutiesResponse
+	syncDutiesError    error
+
+	calls map[string]int
+	lock  *sync.Mutex
+}
+
+// Creates a new mock Beacon API provider with no fixtures
+func NewMockBeaconApiProvider() *MockBeaconApiProvider {
+	return &MockBeaconApiProvider{
+		calls: map[string]int{},
+		lock:  &sync.Mutex{},
+	}
+}
+
+// Get the number of times the provided method (e.g. "Beacon_Validators") has been called
+func (p *MockBeaconApiProvider) GetCallCount(method string) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.calls[method]
+}
+
+// Record a call to a method
+func (p *MockBeaconApiProvider) recordCall(method string) {
+	p.calls[method]++
+}
+
+// ===========================
+// === Beacon_Attestations ===
+// ===========================
+
+// Set the response for Beacon_Attestations
+func (p *MockBeaconApiProvider) SetAttestationsResponse(response client.AttestationsResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.attestationsResponse = response
+	p.attestationsFound = true
+}
+
+// Set the error for Beacon_Attestations to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetAttestationsError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.attestationsError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_Attestations(ctx context.Context, blockId string) (client.AttestationsResponse, bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_Attestations")
+	if p.attestationsError != nil {
+		return client.AttestationsResponse{}, false, p.attestationsError
+	}
+	return p.attestationsResponse, p.attestationsFound, nil
+}
+
+// ====================
+// === Beacon_Block ===
+// ====================
+
+// Set the response for Beacon_Block
+func (p *MockBeaconApiProvider) SetBlockResponse(response client.BeaconBlockResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.blockResponse = response
+	p.blockFound = true
+}
+
+// Set the error for Beacon_Block to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetBlockError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.blockError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_Block(ctx context.Context, blockId string) (client.BeaconBlockResponse, bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_Block")
+	if p.blockError != nil {
+		return client.BeaconBlockResponse{}, false, p.blockError
+	}
+	return p.blockResponse, p.blockFound, nil
+}
+
+// =========================================
+// === Beacon_BlsToExecutionChanges_Post ===
+// =========================================
+
+// Set the error for Beacon_BlsToExecutionChanges_Post to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetBlsToExecutionChangesError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.blsToExecutionChangesError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_BlsToExecutionChanges_Post(ctx context.Context, request client.BLSToExecutionChangeRequest) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_BlsToExecutionChanges_Post")
+	return p.blsToExecutionChangesError
+}
+
+// =========================
+// === Beacon_Committees ===
+// =========================
+
+// Set the response for Beacon_Committees
+func (p *MockBeaconApiProvider) SetCommitteesResponse(response client.CommitteesResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.committeesResponse = response
+}
+
+// Set the error for Beacon_Committees to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetCommitteesError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.committeesError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_Committees(ctx context.Context, stateId string, epoch *uint64) (client.CommitteesResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_Committees")
+	if p.committeesError != nil {
+		return client.CommitteesResponse{}, p.committeesError
+	}
+	return p.committeesResponse, nil
+}
+
+// ==================================
+// === Beacon_FinalityCheckpoints ===
+// ==================================
+
+// Set the response for Beacon_FinalityCheckpoints
+func (p *MockBeaconApiProvider) SetFinalityCheckpointsResponse(response client.FinalityCheckpointsResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.finalityCheckpointsResponse = response
+}
+
+// Set the error for Beacon_FinalityCheckpoints to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetFinalityCheckpointsError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.finalityCheckpointsError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_FinalityCheckpoints(ctx context.Context, stateId string) (client.FinalityCheckpointsResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_FinalityCheckpoints")
+	if p.finalityCheckpointsError != nil {
+		return client.FinalityCheckpointsResponse{}, p.finalityCheckpointsError
+	}
+	return p.finalityCheckpointsResponse, nil
+}
+
+// ===================
+// === Beacon_Fork ===
+// ===================
+
+// Set the response for Beacon_Fork
+func (p *MockBeaconApiProvider) SetForkResponse(response client.ForkResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.forkResponse = response
+	p.forkFound = true
+}
+
+// Set the error for Beacon_Fork to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetForkError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.forkError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_Fork(ctx context.Context, stateId string) (client.ForkResponse, bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_Fork")
+	if p.forkError != nil {
+		return client.ForkResponse{}, false, p.forkError
+	}
+	return p.forkResponse, p.forkFound, nil
+}
+
+// ======================
+// === Beacon_Genesis ===
+// ======================
+
+// Set the response for Beacon_Genesis
+func (p *MockBeaconApiProvider) SetGenesisResponse(response client.GenesisResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.genesisResponse = response
+}
+
+// Set the error for Beacon_Genesis to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetGenesisError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.genesisError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_Genesis(ctx context.Context) (client.GenesisResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_Genesis")
+	if p.genesisError != nil {
+		return client.GenesisResponse{}, p.genesisError
+	}
+	return p.genesisResponse, nil
+}
+
+// =====================
+// === Beacon_Header ===
+// =====================
+
+// Set the response for Beacon_Header
+func (p *MockBeaconApiProvider) SetHeaderResponse(response client.BeaconBlockHeaderResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.headerResponse = response
+	p.headerFound = true
+}
+
+// Set the error for Beacon_Header to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetHeaderError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.headerError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_Header(ctx context.Context, blockId string) (client.BeaconBlockHeaderResponse, bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_Header")
+	if p.headerError != nil {
+		return client.BeaconBlockHeaderResponse{}, false, p.headerError
+	}
+	return p.headerResponse, p.headerFound, nil
+}
+
+// =============================
+// === Beacon_SyncCommittees ===
+// =============================
+
+// Set the response for Beacon_SyncCommittees
+func (p *MockBeaconApiProvider) SetSyncCommitteesResponse(response client.SyncCommitteesResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.syncCommitteesResponse = response
+}
+
+// Set the error for Beacon_SyncCommittees to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetSyncCommitteesError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.syncCommitteesError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_SyncCommittees(ctx context.Context, stateId string, epoch *uint64) (client.SyncCommitteesResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_SyncCommittees")
+	if p.syncCommitteesError != nil {
+		return client.SyncCommitteesResponse{}, p.syncCommitteesError
+	}
+	return p.syncCommitteesResponse, nil
+}
+
+// =========================
+// === Beacon_Validators ===
+// =========================
+
+// Set the response for Beacon_Validators
+func (p *MockBeaconApiProvider) SetValidatorsResponse(response client.ValidatorsResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.validatorsResponse = response
+}
+
+// Set the error for Beacon_Validators to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetValidatorsError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.validatorsError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_Validators(ctx context.Context, stateId string, ids []string) (client.ValidatorsResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_Validators")
+	if p.validatorsError != nil {
+		return client.ValidatorsResponse{}, p.validatorsError
+	}
+	if len(ids) == 0 {
+		return p.validatorsResponse, nil
+	}
+
+	// Only return the requested validators
+	requested := map[string]bool{}
+	for _, id := range ids {
+		requested[strings.ToLower(strings.TrimPrefix(id, "0x"))] = true
+	}
+	response := client.ValidatorsResponse{
+		Data: []client.Validator{},
+	}
+	for _, validator := range p.validatorsResponse.Data {
+		pubkey := hex.EncodeToString(validator.Validator.Pubkey)
+		if requested[validator.Index] || requested[pubkey] {
+			response.Data = append(response.Data, validator)
+		}
+	}
+	return response, nil
+}
+
+// ==================================
+// === Beacon_VoluntaryExits_Post ===
+// ==================================
+
+// Set the error for Beacon_VoluntaryExits_Post to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetVoluntaryExitsError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.voluntaryExitsError = err
+}
+
+func (p *MockBeaconApiProvider) Beacon_VoluntaryExits_Post(ctx context.Context, request client.VoluntaryExitRequest) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Beacon_VoluntaryExits_Post")
+	return p.voluntaryExitsError
+}
+
+// ========================
+// === Events_Subscribe ===
+// ========================
+
+// Set the raw event stream for Events_Subscribe to return, in server-sent event format
+func (p *MockBeaconApiProvider) SetEventsResponse(stream string) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.eventsResponse = stream
+}
+
+// Set the error for Events_Subscribe to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetEventsError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.eventsError = err
+}
+
+func (p *MockBeaconApiProvider) Events_Subscribe(ctx context.Context, topics []string) (io.ReadCloser, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Events_Subscribe")
+	if p.eventsError != nil {
+		return nil, p.eventsError
+	}
+	return io.NopCloser(strings.NewReader(p.eventsResponse)), nil
+}
+
+// ==============================
+// === Config_DepositContract ===
+// ==============================
+
+// Set the response for Config_DepositContract
+func (p *MockBeaconApiProvider) SetDepositContractResponse(response client.Eth2DepositContractResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.depositContractResponse = response
+}
+
+// Set the error for Config_DepositContract to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetDepositContractError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.depositContractError = err
+}
+
+func (p *MockBeaconApiProvider) Config_DepositContract(ctx context.Context) (client.Eth2DepositContractResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Config_DepositContract")
+	if p.depositContractError != nil {
+		return client.Eth2DepositContractResponse{}, p.depositContractError
+	}
+	return p.depositContractResponse, nil
+}
+
+// ===========================
+// === Config_ForkSchedule ===
+// ===========================
+
+// Set the response for Config_ForkSchedule
+func (p *MockBeaconApiProvider) SetForkScheduleResponse(response client.ForkScheduleResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.forkScheduleResponse = response
+}
+
+// Set the error for Config_ForkSchedule to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetForkScheduleError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.forkScheduleError = err
+}
+
+func (p *MockBeaconApiProvider) Config_ForkSchedule(ctx context.Context) (client.ForkScheduleResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Config_ForkSchedule")
+	if p.forkScheduleError != nil {
+		return client.ForkScheduleResponse{}, p.forkScheduleError
+	}
+	return p.forkScheduleResponse, nil
+}
+
+// ===================
+// === Config_Spec ===
+// ===================
+
+// Set the response for Config_Spec
+func (p *MockBeaconApiProvider) SetSpecResponse(response client.Eth2ConfigResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.specResponse = response
+}
+
+// Set the error for Config_Spec to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetSpecError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.specError = err
+}
+
+func (p *MockBeaconApiProvider) Config_Spec(ctx context.Context) (client.Eth2ConfigResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Config_Spec")
+	if p.specError != nil {
+		return client.Eth2ConfigResponse{}, p.specError
+	}
+	return p.specResponse, nil
+}
+
+// ====================
+// === Node_Syncing ===
+// ====================
+
+// Set the response for Node_Syncing
+func (p *MockBeaconApiProvider) SetSyncingResponse(response client.SyncStatusResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.syncingResponse = response
+}
+
+// Set the error for Node_Syncing to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetSyncingError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.syncingError = err
+}
+
+func (p *MockBeaconApiProvider) Node_Syncing(ctx context.Context) (client.SyncStatusResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Node_Syncing")
+	if p.syncingError != nil {
+		return client.SyncStatusResponse{}, p.syncingError
+	}
+	return p.syncingResponse, nil
+}
+
+// ================================
+// === Validator_DutiesProposer ===
+// ================================
+
+// Set the response for Validator_DutiesProposer
+func (p *MockBeaconApiProvider) SetProposerDutiesResponse(response client.ProposerDutiesResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.proposerDutiesResponse = response
+}
+
+// Set the error for Validator_DutiesProposer to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetProposerDutiesError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.proposerDutiesError = err
+}
+
+func (p *MockBeaconApiProvider) Validator_DutiesProposer(ctx context.Context, indices []string, epoch uint64) (client.ProposerDutiesResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Validator_DutiesProposer")
+	if p.proposerDutiesError != nil {
+		return client.ProposerDutiesResponse{}, p.proposerDutiesError
+	}
+	return p.proposerDutiesResponse, nil
+}
+
+// =================================
+// === Validator_DutiesSync_Post ===
+// =================================
+
+// Set the response for Validator_DutiesSync_Post
+func (p *MockBeaconApiProvider) SetSyncDutiesResponse(response client.SyncDutiesResponse) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.syncDutiesResponse = response
+}
+
+// Set the error for Validator_DutiesSync_Post to return; use nil to clear it
+func (p *MockBeaconApiProvider) SetSyncDutiesError(err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.syncDutiesError = err
+}
+
+func (p *MockBeaconApiProvider) Validator_DutiesSync_Post(ctx context.Context, indices []string, epoch uint64) (client.SyncDutiesResponse, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recordCall("Validator_DutiesSync_Post")
+	if p.syncDutiesError != nil {
+		return client.SyncDutiesResponse{}, p.syncDutiesError
+	}
+	return p.syncDutiesResponse, nil
+}
+
+// Make sure the mock implements the provider interface
+var _ client.IBeaconApiProvider = (*MockBeaconApiProvider)(nil)
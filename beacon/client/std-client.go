@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"runtime"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -17,18 +18,41 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+const (
+	// How long the eth2 config is cached before it's requested from the Beacon Node again
+	DefaultEth2ConfigCacheDuration time.Duration = 10 * time.Minute
+)
+
 // Beacon client using the standard Beacon HTTP REST API (https://ethereum.github.io/beacon-APIs/)
 type StandardClient struct {
 	provider IBeaconApiProvider
+
+	// The spec and genesis are cached after the first successful request. Genesis and the slot timing never change,
+	// but the fork schedule can when the Beacon Node is upgraded for a new fork, so the cache expires periodically.
+	// The cache belongs to this client's provider, so clients for different Beacon Nodes never share it.
+	eth2Config              *beacon.Eth2Config
+	eth2ConfigTime          time.Time
+	eth2ConfigCacheDuration time.Duration
+	eth2ConfigLock          *sync.Mutex
 }
 
 // Create a new client instance
 func NewStandardClient(provider IBeaconApiProvider) *StandardClient {
 	return &StandardClient{
-		provider: provider,
+		provider:                provider,
+		eth2ConfigCacheDuration: DefaultEth2ConfigCacheDuration,
+		eth2ConfigLock:          &sync.Mutex{},
 	}
 }
 
+// Set how long the eth2 config is cached before it's requested from the Beacon Node again.
+// Defaults to DefaultEth2ConfigCacheDuration.
+func (c *StandardClient) SetEth2ConfigCacheDuration(duration time.Duration) {
+	c.eth2ConfigLock.Lock()
+	defer c.eth2ConfigLock.Unlock()
+	c.eth2ConfigCacheDuration = duration
+}
+
 // Close the client connection
 func (c *StandardClient) Close(ctx context.Context) error {
 	return nil
@@ -52,8 +76,35 @@ func (c *StandardClient) GetSyncStatus(ctx context.Context) (beacon.SyncStatus,
 	}, nil
 }
 
-// Get the eth2 config
+// Get the eth2 config.
+// This is requested from the Beacon Node on the first call and whenever the cached copy has expired, so fork schedule
+// changes are picked up without a restart; other calls return the cached config.
 func (c *StandardClient) GetEth2Config(ctx context.Context) (beacon.Eth2Config, error) {
+	c.eth2ConfigLock.Lock()
+	defer c.eth2ConfigLock.Unlock()
+
+	if c.eth2Config != nil && time.Since(c.eth2ConfigTime) < c.eth2ConfigCacheDuration {
+		return *c.eth2Config, nil
+	}
+	config, err := c.getEth2ConfigImpl(ctx)
+	if err != nil {
+		return beacon.Eth2Config{}, err
+	}
+	c.eth2Config = &config
+	c.eth2ConfigTime = time.Now()
+	return config, nil
+}
+
+// Clear the cached eth2 config and request it from the Beacon Node again, such as after the node's network changes
+func (c *StandardClient) RefreshEth2Config(ctx context.Context) (beacon.Eth2Config, error) {
+	c.eth2ConfigLock.Lock()
+	c.eth2Config = nil
+	c.eth2ConfigLock.Unlock()
+	return c.GetEth2Config(ctx)
+}
+
+// Request the eth2 config from the Beacon Node
+func (c *StandardClient) getEth2ConfigImpl(ctx context.Context) (beacon.Eth2Config, error) {
 	// Data
 	var wg errgroup.Group
 	var eth2Config Eth2ConfigResponse
@@ -78,8 +129,8 @@ func (c *StandardClient) GetEth2Config(ctx context.Context) (beacon.Eth2Config,
 		return beacon.Eth2Config{}, err
 	}
 
-	// Return response
-	return beacon.Eth2Config{
+	// Build the config
+	config := beacon.Eth2Config{
 		GenesisForkVersion:           genesis.Data.GenesisForkVersion,
 		GenesisValidatorsRoot:        genesis.Data.GenesisValidatorsRoot,
 		GenesisEpoch:                 0,
@@ -88,7 +139,59 @@ func (c *StandardClient) GetEth2Config(ctx context.Context) (beacon.Eth2Config,
 		SlotsPerEpoch:                uint64(eth2Config.Data.SlotsPerEpoch),
 		SecondsPerEpoch:              uint64(eth2Config.Data.SecondsPerSlot * eth2Config.Data.SlotsPerEpoch),
 		EpochsPerSyncCommitteePeriod: uint64(eth2Config.Data.EpochsPerSyncCommitteePeriod),
-	}, nil
+	}
+
+	// Get the fork schedule from the spec
+	spec := eth2Config.Data
+	forks := []struct {
+		specVersion ByteArray
+		specEpoch   *Uinteger
+		version     *[]byte
+		epoch       *uint64
+	}{
+		{spec.AltairForkVersion, spec.AltairForkEpoch, &config.AltairForkVersion, &config.AltairForkEpoch},
+		{spec.BellatrixForkVersion, spec.BellatrixForkEpoch, &config.BellatrixForkVersion, &config.BellatrixForkEpoch},
+		{spec.CapellaForkVersion, spec.CapellaForkEpoch, &config.CapellaForkVersion, &config.CapellaForkEpoch},
+		{spec.DenebForkVersion, spec.DenebForkEpoch, &config.DenebForkVersion, &config.DenebForkEpoch},
+		{spec.ElectraForkVersion, spec.ElectraForkEpoch, &config.ElectraForkVersion, &config.ElectraForkEpoch},
+	}
+	missingForks := false
+	for _, fork := range forks {
+		*fork.version = fork.specVersion
+		*fork.epoch = beacon.FarFutureEpoch
+		if fork.specEpoch != nil {
+			*fork.epoch = uint64(*fork.specEpoch)
+		}
+		if len(fork.specVersion) == 0 || fork.specEpoch == nil {
+			missingForks = true
+		}
+	}
+
+	// Fill in any forks that are missing from the spec with the fork schedule, which lists every fork after genesis in
+	// order
+	if missingForks {
+		forkSchedule, err := c.provider.Config_ForkSchedule(ctx)
+		if err != nil {
+			return beacon.Eth2Config{}, fmt.Errorf("the Beacon Node's spec is missing forks, and getting its fork schedule failed: %w", err)
+		}
+		scheduledForks := forkSchedule.Data
+		if len(scheduledForks) > 0 && bytes.Equal(scheduledForks[0].CurrentVersion, genesis.Data.GenesisForkVersion) {
+			scheduledForks = scheduledForks[1:]
+		}
+		for i, fork := range forks {
+			if i >= len(scheduledForks) {
+				break
+			}
+			if len(*fork.version) == 0 {
+				*fork.version = scheduledForks[i].CurrentVersion
+			}
+			if fork.specEpoch == nil {
+				*fork.epoch = uint64(scheduledForks[i].Epoch)
+			}
+		}
+	}
+
+	return config, nil
 }
 
 // Get the eth2 deposit contract info
@@ -111,6 +214,7 @@ func (c *StandardClient) GetBeaconHead(ctx context.Context) (beacon.BeaconHead,
 	// Data
 	var wg errgroup.Group
 	var eth2Config beacon.Eth2Config
+	var syncStatus SyncStatusResponse
 	var finalityCheckpoints FinalityCheckpointsResponse
 
 	// Get eth2 config
@@ -120,6 +224,13 @@ func (c *StandardClient) GetBeaconHead(ctx context.Context) (beacon.BeaconHead,
 		return err
 	})
 
+	// Get the node's head slot
+	wg.Go(func() error {
+		var err error
+		syncStatus, err = c.provider.Node_Syncing(ctx)
+		return err
+	})
+
 	// Get finality checkpoints
 	wg.Go(func() error {
 		var err error
@@ -132,9 +243,20 @@ func (c *StandardClient) GetBeaconHead(ctx context.Context) (beacon.BeaconHead,
 		return beacon.BeaconHead{}, err
 	}
 
+	// Compare the head to the wall clock
+	headSlot := uint64(syncStatus.Data.HeadSlot)
+	wallClockSlot := eth2Config.SlotAt(time.Now())
+	slotDelay := uint64(0)
+	if wallClockSlot > headSlot {
+		slotDelay = wallClockSlot - headSlot
+	}
+
 	// Return response
 	return beacon.BeaconHead{
-		Epoch:                  epochAt(eth2Config, uint64(time.Now().Unix())),
+		Epoch:                  eth2Config.EpochOfSlot(headSlot),
+		HeadSlot:               headSlot,
+		WallClockEpoch:         eth2Config.EpochOfSlot(wallClockSlot),
+		SlotDelay:              slotDelay,
 		FinalizedEpoch:         uint64(finalityCheckpoints.Data.Finalized.Epoch),
 		JustifiedEpoch:         uint64(finalityCheckpoints.Data.CurrentJustified.Epoch),
 		PreviousJustifiedEpoch: uint64(finalityCheckpoints.Data.PreviousJustified.Epoch),
@@ -192,7 +314,7 @@ func (c *StandardClient) GetValidatorStatuses(ctx context.Context, pubkeys []bea
 	// Filter out null, invalid and duplicate pubkeys
 	realPubkeys := []beacon.ValidatorPubkey{}
 	for _, pubkey := range pubkeys {
-		if bytes.Equal(pubkey[:], nullPubkey[:]) {
+		if pubkey.IsZero() {
 			continue
 		}
 		isDuplicate := false
@@ -264,6 +386,23 @@ func (c *StandardClient) GetValidatorStatuses(ctx context.Context, pubkeys []bea
 
 // Get whether validators have sync duties to perform at given epoch
 func (c *StandardClient) GetValidatorSyncDuties(ctx context.Context, indices []string, epoch uint64) (map[string]bool, error) {
+	// Get the committee indices
+	indexMap, err := c.GetValidatorSyncCommitteeIndices(ctx, indices, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the results
+	validatorMap := make(map[string]bool, len(indexMap))
+	for index, committeeIndices := range indexMap {
+		validatorMap[index] = len(committeeIndices) > 0
+	}
+	return validatorMap, nil
+}
+
+// Get the positions that each of the provided validators holds in the sync committee for the period containing the
+// given epoch. Validators that aren't in the sync committee are included with an empty slice.
+func (c *StandardClient) GetValidatorSyncCommitteeIndices(ctx context.Context, indices []string, epoch uint64) (map[string][]uint64, error) {
 	// Perform the post request
 	response, err := c.provider.Validator_DutiesSync_Post(ctx, indices, epoch)
 	if err != nil {
@@ -271,23 +410,55 @@ func (c *StandardClient) GetValidatorSyncDuties(ctx context.Context, indices []s
 	}
 
 	// Map the results
-	validatorMap := make(map[string]bool)
-
+	indexMap := make(map[string][]uint64, len(indices))
 	for _, index := range indices {
-		validatorMap[index] = false
-		for _, duty := range response.Data {
-			if duty.ValidatorIndex == index {
-				validatorMap[index] = true
-				break
-			}
+		indexMap[index] = []uint64{}
+	}
+	for _, duty := range response.Data {
+		committeeIndices, exists := indexMap[duty.ValidatorIndex]
+		if !exists {
+			continue
+		}
+		for _, committeeIndex := range duty.SyncCommitteeIndices {
+			committeeIndices = append(committeeIndices, uint64(committeeIndex))
 		}
+		indexMap[duty.ValidatorIndex] = committeeIndices
 	}
+	return indexMap, nil
+}
 
-	return validatorMap, nil
+// Get the sync committee for the provided state. If epoch is set, this gets the committee for the sync committee period
+// containing that epoch instead of the state's own period.
+func (c *StandardClient) GetSyncCommittee(ctx context.Context, stateId string, epoch *uint64) (beacon.SyncCommittee, error) {
+	response, err := c.provider.Beacon_SyncCommittees(ctx, stateId, epoch)
+	if err != nil {
+		return beacon.SyncCommittee{}, err
+	}
+	return beacon.SyncCommittee{
+		Validators:    response.Data.Validators,
+		Subcommittees: response.Data.ValidatorAggregates,
+	}, nil
 }
 
 // Sums proposer duties per validators for a given epoch
 func (c *StandardClient) GetValidatorProposerDuties(ctx context.Context, indices []string, epoch uint64) (map[string]uint64, error) {
+	// Get the proposal slots
+	slotMap, err := c.GetValidatorProposerSlots(ctx, indices, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Count them
+	proposerMap := make(map[string]uint64, len(slotMap))
+	for index, slots := range slotMap {
+		proposerMap[index] = uint64(len(slots))
+	}
+	return proposerMap, nil
+}
+
+// Get the slots that each of the provided validators is scheduled to propose in during the given epoch.
+// Validators without any proposals are included with an empty slice.
+func (c *StandardClient) GetValidatorProposerSlots(ctx context.Context, indices []string, epoch uint64) (map[string][]uint64, error) {
 	// Perform the post request
 	response, err := c.provider.Validator_DutiesProposer(ctx, indices, epoch)
 	if err != nil {
@@ -295,19 +466,18 @@ func (c *StandardClient) GetValidatorProposerDuties(ctx context.Context, indices
 	}
 
 	// Map the results
-	proposerMap := make(map[string]uint64)
-
+	slotMap := make(map[string][]uint64, len(indices))
 	for _, index := range indices {
-		proposerMap[index] = 0
-		for _, duty := range response.Data {
-			if duty.ValidatorIndex == index {
-				proposerMap[index]++
-				break
-			}
+		slotMap[index] = []uint64{}
+	}
+	for _, duty := range response.Data {
+		slots, exists := slotMap[duty.ValidatorIndex]
+		if !exists {
+			continue
 		}
+		slotMap[duty.ValidatorIndex] = append(slots, uint64(duty.Slot))
 	}
-
-	return proposerMap, nil
+	return slotMap, nil
 }
 
 // Get a validator's index
@@ -329,44 +499,79 @@ func (c *StandardClient) GetValidatorIndex(ctx context.Context, pubkey beacon.Va
 
 // Get domain data for a domain type at a given epoch
 func (c *StandardClient) GetDomainData(ctx context.Context, domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error) {
-	// Data
-	var wg errgroup.Group
-	var genesis GenesisResponse
-	var eth2Config Eth2ConfigResponse
+	// Get the config, which includes genesis and the fork schedule
+	eth2Config, err := c.GetEth2Config(ctx)
+	if err != nil {
+		return []byte{}, err
+	}
 
-	// Get genesis
-	wg.Go(func() error {
-		var err error
-		genesis, err = c.provider.Beacon_Genesis(ctx)
-		return err
-	})
+	// Get fork version
+	var forkVersion []byte
+	if useGenesisFork {
+		// Used to compute the domain for credential changes
+		forkVersion = eth2Config.GenesisForkVersion
+	} else if bytes.Equal(domainType, eth2types.DomainVoluntaryExit[:]) && eth2Config.IsDenebActive(epoch) {
+		// According to EIP-7044 (https://eips.ethereum.org/EIPS/eip-7044) the CAPELLA_FORK_VERSION should always be used to compute the domain for voluntary exits signatures after Deneb.
+		forkVersion = eth2Config.CapellaForkVersion
+	} else {
+		forkVersion = eth2Config.ForkVersionAtEpoch(epoch)
+	}
 
-	// Get the BN spec as we need the CAPELLA_FORK_VERSION
-	wg.Go(func() error {
-		var err error
-		eth2Config, err = c.provider.Config_Spec(ctx)
-		return err
-	})
+	// Compute & return domain
+	var dt [4]byte
+	copy(dt[:], domainType[:])
+	return eth2types.ComputeDomain(dt, forkVersion, eth2Config.GenesisValidatorsRoot)
+}
 
-	// Wait for data
-	if err := wg.Wait(); err != nil {
+// Get the signing domain for the given epoch, using the fork of the provided state instead of the local fork schedule.
+// This is useful for historical epochs on chains whose fork schedule isn't fully described by the config spec.
+func (c *StandardClient) GetDomainDataForState(ctx context.Context, domainType []byte, epoch uint64, stateId string) ([]byte, error) {
+	// Get the config for the genesis validators root
+	eth2Config, err := c.GetEth2Config(ctx)
+	if err != nil {
 		return []byte{}, err
 	}
 
+	// Get the fork of the state
+	fork, exists, err := c.GetFork(ctx, stateId)
+	if err != nil {
+		return []byte{}, err
+	}
+	if !exists {
+		return []byte{}, fmt.Errorf("state %s not found", stateId)
+	}
+
 	// Get fork version
 	var forkVersion []byte
-	if useGenesisFork {
-		// Used to compute the domain for credential changes
-		forkVersion = genesis.Data.GenesisForkVersion
+	if bytes.Equal(domainType, eth2types.DomainVoluntaryExit[:]) && eth2Config.IsDenebActive(epoch) {
+		// Voluntary exits always use the Capella fork version after Deneb, per EIP-7044
+		forkVersion = eth2Config.CapellaForkVersion
+	} else if epoch < fork.Epoch {
+		forkVersion = fork.PreviousVersion
 	} else {
-		// According to EIP-7044 (https://eips.ethereum.org/EIPS/eip-7044) the CAPELLA_FORK_VERSION should always be used to compute the domain for voluntary exits signatures.
-		forkVersion = eth2Config.Data.CapellaForkVersion
+		forkVersion = fork.CurrentVersion
 	}
 
 	// Compute & return domain
 	var dt [4]byte
 	copy(dt[:], domainType[:])
-	return eth2types.ComputeDomain(dt, forkVersion, genesis.Data.GenesisValidatorsRoot)
+	return eth2types.ComputeDomain(dt, forkVersion, eth2Config.GenesisValidatorsRoot)
+}
+
+// Get the fork of a Beacon chain state
+func (c *StandardClient) GetFork(ctx context.Context, stateId string) (beacon.ForkInfo, bool, error) {
+	response, exists, err := c.provider.Beacon_Fork(ctx, stateId)
+	if err != nil {
+		return beacon.ForkInfo{}, false, err
+	}
+	if !exists {
+		return beacon.ForkInfo{}, false, nil
+	}
+	return beacon.ForkInfo{
+		PreviousVersion: response.Data.PreviousVersion,
+		CurrentVersion:  response.Data.CurrentVersion,
+		Epoch:           uint64(response.Data.Epoch),
+	}, true, nil
 }
 
 // Perform a voluntary exit on a validator
@@ -437,6 +642,7 @@ func (c *StandardClient) GetBeaconBlock(ctx context.Context, blockId string) (be
 			Slot:          uint64(block.Data.Message.Slot),
 			ProposerIndex: block.Data.Message.ProposerIndex,
 		},
+		Withdrawals: []beacon.WithdrawalInfo{},
 	}
 
 	// Execution payload only exists after the merge, so check for its existence
@@ -446,6 +652,48 @@ func (c *StandardClient) GetBeaconBlock(ctx context.Context, blockId string) (be
 		beaconBlock.HasExecutionPayload = true
 		beaconBlock.FeeRecipient = common.BytesToAddress(block.Data.Message.Body.ExecutionPayload.FeeRecipient)
 		beaconBlock.ExecutionBlockNumber = uint64(block.Data.Message.Body.ExecutionPayload.BlockNumber)
+
+		// Withdrawals only exist after Capella
+		for _, withdrawal := range block.Data.Message.Body.ExecutionPayload.Withdrawals {
+			beaconBlock.Withdrawals = append(beaconBlock.Withdrawals, beacon.WithdrawalInfo{
+				Index:          uint64(withdrawal.Index),
+				ValidatorIndex: withdrawal.ValidatorIndex,
+				Address:        withdrawal.Address,
+				Amount:         uint64(withdrawal.Amount),
+			})
+		}
+	}
+
+	// Add slashings, exits, and withdrawal credential changes
+	body := block.Data.Message.Body
+	for _, slashing := range body.ProposerSlashings {
+		beaconBlock.ProposerSlashings = append(beaconBlock.ProposerSlashings, beacon.ProposerSlashingInfo{
+			ProposerIndex: slashing.SignedHeader1.Message.ProposerIndex,
+			Slot:          uint64(slashing.SignedHeader1.Message.Slot),
+		})
+	}
+	for _, slashing := range body.AttesterSlashings {
+		beaconBlock.AttesterSlashings = append(beaconBlock.AttesterSlashings, beacon.AttesterSlashingInfo{
+			SlashedIndices: getSlashedIndices(slashing),
+			Slot1:          uint64(slashing.Attestation1.Data.Slot),
+			Slot2:          uint64(slashing.Attestation2.Data.Slot),
+		})
+	}
+	for _, exit := range body.VoluntaryExits {
+		beaconBlock.VoluntaryExits = append(beaconBlock.VoluntaryExits, beacon.VoluntaryExitInfo{
+			ValidatorIndex: exit.Message.ValidatorIndex,
+			Epoch:          uint64(exit.Message.Epoch),
+		})
+	}
+	for i, change := range body.BlsToExecutionChanges {
+		if len(change.Message.FromBLSPubkey) != beacon.ValidatorPubkeyLength {
+			return beacon.BeaconBlock{}, false, fmt.Errorf("BLS to execution change %d of block %s has a pubkey with invalid length %d", i, blockId, len(change.Message.FromBLSPubkey))
+		}
+		beaconBlock.BlsToExecutionChanges = append(beaconBlock.BlsToExecutionChanges, beacon.BlsToExecutionChangeInfo{
+			ValidatorIndex:     change.Message.ValidatorIndex,
+			FromBlsPubkey:      beacon.ValidatorPubkey(change.Message.FromBLSPubkey),
+			ToExecutionAddress: common.BytesToAddress(change.Message.ToExecutionAddress),
+		})
 	}
 
 	// Add attestation info
@@ -503,24 +751,6 @@ func (c *StandardClient) ChangeWithdrawalCredentials(ctx context.Context, valida
 	})
 }
 
-// Get fork
-/*
-func (c *StandardClient) getFork(ctx context.Context, stateId string) (ForkResponse, error) {
-	responseBody, status, err := c.getRequest(ctx, fmt.Sprintf(RequestForkPath, stateId))
-	if err != nil {
-		return ForkResponse{}, fmt.Errorf("error getting fork data: %w", err)
-	}
-	if status != http.StatusOK {
-		return ForkResponse{}, fmt.Errorf("error getting fork data: HTTP status %d; response body: '%s'", status, string(responseBody))
-	}
-	var fork ForkResponse
-	if err := json.Unmarshal(responseBody, &fork); err != nil {
-		return ForkResponse{}, fmt.Errorf("error decoding fork data: %w", err)
-	}
-	return fork, nil
-}
-*/
-
 // Get validators by pubkeys and status options
 func (c *StandardClient) getValidatorsByOpts(ctx context.Context, pubkeysOrIndices []string, opts *beacon.ValidatorStatusOptions) (ValidatorsResponse, error) {
 	// Get state ID
@@ -532,13 +762,13 @@ func (c *StandardClient) getValidatorsByOpts(ctx context.Context, pubkeysOrIndic
 	} else if opts.Epoch != nil {
 
 		// Get eth2 config
-		eth2Config, err := c.provider.Config_Spec(ctx)
+		eth2Config, err := c.GetEth2Config(ctx)
 		if err != nil {
 			return ValidatorsResponse{}, err
 		}
 
 		// Get slot nuimber
-		slot := *opts.Epoch * uint64(eth2Config.Data.SlotsPerEpoch)
+		slot := eth2Config.FirstSlotOfEpoch(*opts.Epoch)
 		stateId = strconv.FormatInt(int64(slot), 10)
 
 	} else {
@@ -586,3 +816,18 @@ func (c *StandardClient) getValidatorsByOpts(ctx context.Context, pubkeysOrIndic
 
 	return ValidatorsResponse{Data: trueData}, nil
 }
+
+// Get the indices of the validators that attested to both attestations in an attester slashing
+func getSlashedIndices(slashing AttesterSlashing) []string {
+	attesters := map[string]bool{}
+	for _, index := range slashing.Attestation1.AttestingIndices {
+		attesters[index] = true
+	}
+	slashedIndices := []string{}
+	for _, index := range slashing.Attestation2.AttestingIndices {
+		if attesters[index] {
+			slashedIndices = append(slashedIndices, index)
+		}
+	}
+	return slashedIndices
+}
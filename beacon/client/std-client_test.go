@@ -0,0 +1,587 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+	"github.com/rocket-pool/node-manager-core/beacon/client/mock"
+)
+
+// Create a mock provider with a spec that has every fork scheduled, so the fork schedule endpoint isn't needed
+func newSpecProvider(secondsPerSlot uint64, electraForkEpoch uint64) *mock.MockBeaconApiProvider {
+	provider := mock.NewMockBeaconApiProvider()
+	var spec client.Eth2ConfigResponse
+	spec.Data.SecondsPerSlot = client.Uinteger(secondsPerSlot)
+	spec.Data.SlotsPerEpoch = 32
+	forks := []struct {
+		version *client.ByteArray
+		epoch   **client.Uinteger
+		value   uint64
+	}{
+		{&spec.Data.AltairForkVersion, &spec.Data.AltairForkEpoch, 1},
+		{&spec.Data.BellatrixForkVersion, &spec.Data.BellatrixForkEpoch, 2},
+		{&spec.Data.CapellaForkVersion, &spec.Data.CapellaForkEpoch, 3},
+		{&spec.Data.DenebForkVersion, &spec.Data.DenebForkEpoch, 4},
+		{&spec.Data.ElectraForkVersion, &spec.Data.ElectraForkEpoch, electraForkEpoch},
+	}
+	for i, fork := range forks {
+		*fork.version = client.ByteArray{byte(i + 1), 0, 0, 0}
+		epoch := client.Uinteger(fork.value)
+		*fork.epoch = &epoch
+	}
+	provider.SetSpecResponse(spec)
+
+	var genesis client.GenesisResponse
+	genesis.Data.GenesisTime = 1606824023
+	provider.SetGenesisResponse(genesis)
+	return provider
+}
+
+func TestGetEth2ConfigCaching(t *testing.T) {
+	ctx := context.Background()
+	provider := newSpecProvider(12, beacon.FarFutureEpoch-1)
+	bc := client.NewStandardClient(provider)
+
+	// The first call requests the config, the second is served from the cache
+	for i := 0; i < 2; i++ {
+		if _, err := bc.GetEth2Config(ctx); err != nil {
+			t.Fatalf("error getting eth2 config: %v", err)
+		}
+	}
+	if count := provider.GetCallCount("Config_Spec"); count != 1 {
+		t.Errorf("expected 1 Config_Spec call after two GetEth2Config calls, got %d", count)
+	}
+	if count := provider.GetCallCount("Beacon_Genesis"); count != 1 {
+		t.Errorf("expected 1 Beacon_Genesis call after two GetEth2Config calls, got %d", count)
+	}
+	if count := provider.GetCallCount("Config_ForkSchedule"); count != 0 {
+		t.Errorf("expected no Config_ForkSchedule calls when the spec has every fork, got %d", count)
+	}
+
+	// Refreshing always requests the config again
+	if _, err := bc.RefreshEth2Config(ctx); err != nil {
+		t.Fatalf("error refreshing eth2 config: %v", err)
+	}
+	if count := provider.GetCallCount("Config_Spec"); count != 2 {
+		t.Errorf("expected 2 Config_Spec calls after a refresh, got %d", count)
+	}
+
+	// Once the cache expires, a newly scheduled fork is picked up
+	updated := newSpecProvider(12, 100)
+	spec, _ := updated.Config_Spec(ctx)
+	provider.SetSpecResponse(spec)
+	bc.SetEth2ConfigCacheDuration(time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	config, err := bc.GetEth2Config(ctx)
+	if err != nil {
+		t.Fatalf("error getting eth2 config after the cache expired: %v", err)
+	}
+	if count := provider.GetCallCount("Config_Spec"); count != 3 {
+		t.Errorf("expected 3 Config_Spec calls after the cache expired, got %d", count)
+	}
+	if config.ElectraForkEpoch != 100 {
+		t.Errorf("expected the updated Electra fork epoch 100, got %d", config.ElectraForkEpoch)
+	}
+}
+
+func TestGetEth2ConfigCacheNotShared(t *testing.T) {
+	ctx := context.Background()
+	primaryProvider := newSpecProvider(12, 10)
+	fallbackProvider := newSpecProvider(6, 20)
+	primary := client.NewStandardClient(primaryProvider)
+	fallback := client.NewStandardClient(fallbackProvider)
+
+	primaryConfig, err := primary.GetEth2Config(ctx)
+	if err != nil {
+		t.Fatalf("error getting primary eth2 config: %v", err)
+	}
+	fallbackConfig, err := fallback.GetEth2Config(ctx)
+	if err != nil {
+		t.Fatalf("error getting fallback eth2 config: %v", err)
+	}
+
+	// Each client must request its own config rather than reuse the other's
+	if count := fallbackProvider.GetCallCount("Config_Spec"); count != 1 {
+		t.Errorf("expected the fallback to request its own spec, got %d calls", count)
+	}
+	if primaryConfig.SecondsPerSlot != 12 || primaryConfig.ElectraForkEpoch != 10 {
+		t.Errorf("unexpected primary config: %d seconds per slot, Electra at %d", primaryConfig.SecondsPerSlot, primaryConfig.ElectraForkEpoch)
+	}
+	if fallbackConfig.SecondsPerSlot != 6 || fallbackConfig.ElectraForkEpoch != 20 {
+		t.Errorf("unexpected fallback config: %d seconds per slot, Electra at %d", fallbackConfig.SecondsPerSlot, fallbackConfig.ElectraForkEpoch)
+	}
+}
+
+func TestGetEth2ConfigErrorNotCached(t *testing.T) {
+	ctx := context.Background()
+	provider := newSpecProvider(12, 10)
+	provider.SetSpecError(context.DeadlineExceeded)
+	bc := client.NewStandardClient(provider)
+
+	if _, err := bc.GetEth2Config(ctx); err == nil {
+		t.Fatal("expected an error while the spec request fails")
+	}
+	provider.SetSpecError(nil)
+	if _, err := bc.GetEth2Config(ctx); err != nil {
+		t.Fatalf("error getting eth2 config after the spec request recovered: %v", err)
+	}
+	if count := provider.GetCallCount("Config_Spec"); count != 2 {
+		t.Errorf("expected a failed request not to be cached, got %d Config_Spec calls", count)
+	}
+}
+
+// Create a proposer duties response with a proposer for each slot of the epoch starting at the first slot
+func newProposerDuties(firstSlot uint64, proposers ...string) client.ProposerDutiesResponse {
+	var response client.ProposerDutiesResponse
+	for i, proposer := range proposers {
+		response.Data = append(response.Data, client.ProposerDuty{
+			ValidatorIndex: proposer,
+			Slot:           client.Uinteger(firstSlot + uint64(i)),
+		})
+	}
+	return response
+}
+
+func TestGetValidatorProposerSlots(t *testing.T) {
+	tests := []struct {
+		name          string
+		indices       []string
+		proposers     []string
+		expectedSlots map[string][]uint64
+	}{
+		{
+			name:      "one proposal each",
+			indices:   []string{"1", "2"},
+			proposers: []string{"1", "9", "2", "9"},
+			expectedSlots: map[string][]uint64{
+				"1": {3200},
+				"2": {3202},
+			},
+		}, {
+			name:      "double proposal",
+			indices:   []string{"1", "2"},
+			proposers: []string{"1", "9", "1", "2", "9", "1"},
+			expectedSlots: map[string][]uint64{
+				"1": {3200, 3202, 3205},
+				"2": {3203},
+			},
+		}, {
+			name:      "validator with no duties",
+			indices:   []string{"1", "3"},
+			proposers: []string{"9", "1", "9", "9"},
+			expectedSlots: map[string][]uint64{
+				"1": {3201},
+				"3": {},
+			},
+		}, {
+			name:      "no duties for any validator",
+			indices:   []string{"1", "2"},
+			proposers: []string{"9", "8", "7"},
+			expectedSlots: map[string][]uint64{
+				"1": {},
+				"2": {},
+			},
+		}, {
+			name:          "no validators",
+			indices:       []string{},
+			proposers:     []string{"9", "8"},
+			expectedSlots: map[string][]uint64{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			provider := mock.NewMockBeaconApiProvider()
+			provider.SetProposerDutiesResponse(newProposerDuties(3200, test.proposers...))
+			bc := client.NewStandardClient(provider)
+
+			slots, err := bc.GetValidatorProposerSlots(context.Background(), test.indices, 100)
+			if err != nil {
+				t.Fatalf("error getting proposer slots: %v", err)
+			}
+			if len(slots) != len(test.expectedSlots) {
+				t.Fatalf("expected slots for %d validators, got %v", len(test.expectedSlots), slots)
+			}
+			for index, expected := range test.expectedSlots {
+				actual, exists := slots[index]
+				if !exists || actual == nil {
+					t.Errorf("expected validator %s to be included with a non-nil slice", index)
+					continue
+				}
+				if !slices.Equal(actual, expected) {
+					t.Errorf("expected validator %s to propose in slots %v, got %v", index, expected, actual)
+				}
+			}
+
+			// The counts match the slots
+			counts, err := bc.GetValidatorProposerDuties(context.Background(), test.indices, 100)
+			if err != nil {
+				t.Fatalf("error getting proposer duties: %v", err)
+			}
+			if len(counts) != len(test.expectedSlots) {
+				t.Fatalf("expected counts for %d validators, got %v", len(test.expectedSlots), counts)
+			}
+			for index, expected := range test.expectedSlots {
+				if counts[index] != uint64(len(expected)) {
+					t.Errorf("expected validator %s to have %d proposals, got %d", index, len(expected), counts[index])
+				}
+			}
+		})
+	}
+}
+
+func TestGetValidatorProposerDutiesError(t *testing.T) {
+	provider := mock.NewMockBeaconApiProvider()
+	provider.SetProposerDutiesError(errors.New("duties unavailable"))
+	bc := client.NewStandardClient(provider)
+
+	if _, err := bc.GetValidatorProposerDuties(context.Background(), []string{"1"}, 100); err == nil {
+		t.Error("expected the provider's error to be returned")
+	}
+}
+
+// Load a beacon block fixture from the testdata directory
+func loadBlockFixture(t *testing.T, fixture string) client.BeaconBlockResponse {
+	t.Helper()
+	bytes, err := os.ReadFile(filepath.Join("testdata", fixture))
+	if err != nil {
+		t.Fatalf("error reading block fixture %s: %v", fixture, err)
+	}
+	var block client.BeaconBlockResponse
+	if err := json.Unmarshal(bytes, &block); err != nil {
+		t.Fatalf("error decoding block fixture %s: %v", fixture, err)
+	}
+	return block
+}
+
+// Load a beacon block fixture from the testdata directory into a mock provider
+func newBlockProvider(t *testing.T, fixture string) *mock.MockBeaconApiProvider {
+	t.Helper()
+	provider := mock.NewMockBeaconApiProvider()
+	provider.SetBlockResponse(loadBlockFixture(t, fixture))
+	return provider
+}
+
+func TestGetBeaconBlockCapella(t *testing.T) {
+	bc := client.NewStandardClient(newBlockProvider(t, "capella-block.json"))
+	block, exists, err := bc.GetBeaconBlock(context.Background(), "6209538")
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected the block to exist")
+	}
+
+	if block.Header.Slot != 6209538 || block.Header.ProposerIndex != "552061" {
+		t.Errorf("unexpected header %+v", block.Header)
+	}
+	if !block.HasExecutionPayload {
+		t.Error("expected the block to have an execution payload")
+	}
+	if block.FeeRecipient != common.HexToAddress("0x388c818ca8b9251b393131c08a736a67ccb19297") {
+		t.Errorf("unexpected fee recipient %s", block.FeeRecipient.Hex())
+	}
+	if block.ExecutionBlockNumber != 17034871 {
+		t.Errorf("expected execution block 17034871, got %d", block.ExecutionBlockNumber)
+	}
+
+	// Addresses decode regardless of case and amounts stay in gwei
+	address := common.HexToAddress("0x8627c6f9b2cdd2b5c9a3d2e0c1f6b3d5a7e9f1c2")
+	expected := []beacon.WithdrawalInfo{
+		{Index: 0, ValidatorIndex: "0", Address: address, Amount: 3841314},
+		{Index: 1, ValidatorIndex: "1", Address: address, Amount: 32002790454},
+	}
+	if !slices.Equal(block.Withdrawals, expected) {
+		t.Errorf("expected withdrawals %+v, got %+v", expected, block.Withdrawals)
+	}
+
+	if len(block.Attestations) != 1 {
+		t.Fatalf("expected 1 attestation, got %d", len(block.Attestations))
+	}
+	if block.Attestations[0].SlotIndex != 6209537 || block.Attestations[0].CommitteeIndex != 12 {
+		t.Errorf("unexpected attestation %+v", block.Attestations[0])
+	}
+}
+
+func TestGetBeaconBlockPreMerge(t *testing.T) {
+	bc := client.NewStandardClient(newBlockProvider(t, "pre-merge-block.json"))
+	block, exists, err := bc.GetBeaconBlock(context.Background(), "4700013")
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected the block to exist")
+	}
+
+	if block.Header.Slot != 4700013 || block.Header.ProposerIndex != "251373" {
+		t.Errorf("unexpected header %+v", block.Header)
+	}
+	if block.HasExecutionPayload {
+		t.Error("expected a pre-merge block not to have an execution payload")
+	}
+	if block.FeeRecipient != (common.Address{}) || block.ExecutionBlockNumber != 0 {
+		t.Errorf("expected no execution data, got fee recipient %s and block %d", block.FeeRecipient.Hex(), block.ExecutionBlockNumber)
+	}
+	if block.Withdrawals == nil || len(block.Withdrawals) != 0 {
+		t.Errorf("expected an empty withdrawal slice, got %#v", block.Withdrawals)
+	}
+	if len(block.Attestations) != 1 {
+		t.Errorf("expected 1 attestation, got %d", len(block.Attestations))
+	}
+}
+
+func TestGetBeaconBlockMissing(t *testing.T) {
+	provider := mock.NewMockBeaconApiProvider()
+	bc := client.NewStandardClient(provider)
+	_, exists, err := bc.GetBeaconBlock(context.Background(), "head")
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if exists {
+		t.Error("expected a missing block not to exist")
+	}
+}
+
+func TestGetBeaconBlockOperations(t *testing.T) {
+	bc := client.NewStandardClient(newBlockProvider(t, "capella-block-with-operations.json"))
+	block, exists, err := bc.GetBeaconBlock(context.Background(), "6215040")
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected the block to exist")
+	}
+
+	expectedProposerSlashings := []beacon.ProposerSlashingInfo{
+		{ProposerIndex: "87310", Slot: 6214990},
+	}
+	if !slices.Equal(block.ProposerSlashings, expectedProposerSlashings) {
+		t.Errorf("expected proposer slashings %+v, got %+v", expectedProposerSlashings, block.ProposerSlashings)
+	}
+
+	// Only the validators in both attestations are slashed
+	if len(block.AttesterSlashings) != 1 {
+		t.Fatalf("expected 1 attester slashing, got %d", len(block.AttesterSlashings))
+	}
+	attesterSlashing := block.AttesterSlashings[0]
+	if !slices.Equal(attesterSlashing.SlashedIndices, []string{"120340", "120361"}) {
+		t.Errorf("expected slashed indices [120340 120361], got %v", attesterSlashing.SlashedIndices)
+	}
+	if attesterSlashing.Slot1 != 6214951 || attesterSlashing.Slot2 != 6214958 {
+		t.Errorf("expected attestation slots 6214951 and 6214958, got %d and %d", attesterSlashing.Slot1, attesterSlashing.Slot2)
+	}
+
+	expectedExits := []beacon.VoluntaryExitInfo{
+		{ValidatorIndex: "48213", Epoch: 194220},
+	}
+	if !slices.Equal(block.VoluntaryExits, expectedExits) {
+		t.Errorf("expected voluntary exits %+v, got %+v", expectedExits, block.VoluntaryExits)
+	}
+
+	pubkey, err := beacon.HexToValidatorPubkey("b89bebc699769726a318c8e9971bd3171297c61aea4a6578a7a4f94b547dcba5bac16a89108b6b6a1fe3695d1a874a0b")
+	if err != nil {
+		t.Fatalf("error decoding pubkey: %v", err)
+	}
+	expectedChanges := []beacon.BlsToExecutionChangeInfo{
+		{
+			ValidatorIndex:     "161225",
+			FromBlsPubkey:      pubkey,
+			ToExecutionAddress: common.HexToAddress("0xe0e2a3fe0ac0ee46f6e27f3ad4c8d1c4a9bf8d8d"),
+		},
+	}
+	if !slices.Equal(block.BlsToExecutionChanges, expectedChanges) {
+		t.Errorf("expected BLS to execution changes %+v, got %+v", expectedChanges, block.BlsToExecutionChanges)
+	}
+
+	// The existing fields are still populated
+	if !block.HasExecutionPayload || block.ExecutionBlockNumber != 17040155 {
+		t.Errorf("expected execution block 17040155, got %d", block.ExecutionBlockNumber)
+	}
+	if len(block.Withdrawals) != 1 || len(block.Attestations) != 1 {
+		t.Errorf("expected 1 withdrawal and 1 attestation, got %d and %d", len(block.Withdrawals), len(block.Attestations))
+	}
+}
+
+func TestGetBeaconBlockNoOperations(t *testing.T) {
+	bc := client.NewStandardClient(newBlockProvider(t, "capella-block.json"))
+	block, _, err := bc.GetBeaconBlock(context.Background(), "6209538")
+	if err != nil {
+		t.Fatalf("error getting block: %v", err)
+	}
+	if len(block.ProposerSlashings) != 0 || len(block.AttesterSlashings) != 0 || len(block.VoluntaryExits) != 0 || len(block.BlsToExecutionChanges) != 0 {
+		t.Errorf("expected no operations, got %+v", block)
+	}
+}
+
+func TestGetBeaconBlockInvalidBlsPubkey(t *testing.T) {
+	response := loadBlockFixture(t, "capella-block-with-operations.json")
+	change := &response.Data.Message.Body.BlsToExecutionChanges[0]
+	change.Message.FromBLSPubkey = change.Message.FromBLSPubkey[:47]
+	provider := mock.NewMockBeaconApiProvider()
+	provider.SetBlockResponse(response)
+	bc := client.NewStandardClient(provider)
+
+	if _, _, err := bc.GetBeaconBlock(context.Background(), "6215040"); err == nil {
+		t.Error("expected an error for a BLS to execution change with a truncated pubkey")
+	}
+}
+
+// Create a mock provider with the spec's fork schedule and a state whose fork differs from it
+func newForkProvider(genesisValidatorsRoot []byte) *mock.MockBeaconApiProvider {
+	provider := newSpecProvider(12, beacon.FarFutureEpoch-1)
+	var genesis client.GenesisResponse
+	genesis.Data.GenesisTime = 1606824023
+	genesis.Data.GenesisValidatorsRoot = genesisValidatorsRoot
+	provider.SetGenesisResponse(genesis)
+
+	var fork client.ForkResponse
+	fork.Data.PreviousVersion = client.ByteArray{0x0a, 0, 0, 0}
+	fork.Data.CurrentVersion = client.ByteArray{0x0b, 0, 0, 0}
+	fork.Data.Epoch = 6
+	provider.SetForkResponse(fork)
+	return provider
+}
+
+// Compute a signing domain by hand: the domain type followed by the first 28 bytes of the fork data root
+func computeExpectedDomain(domainType []byte, forkVersion []byte, genesisValidatorsRoot []byte) []byte {
+	var forkData [64]byte
+	copy(forkData[:4], forkVersion)
+	copy(forkData[32:], genesisValidatorsRoot)
+	root := sha256.Sum256(forkData[:])
+	return append(slices.Clone(domainType), root[:28]...)
+}
+
+func TestGetFork(t *testing.T) {
+	bc := client.NewStandardClient(newForkProvider(nil))
+	fork, exists, err := bc.GetFork(context.Background(), "head")
+	if err != nil {
+		t.Fatalf("error getting fork: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected the state to exist")
+	}
+	if !bytes.Equal(fork.PreviousVersion, []byte{0x0a, 0, 0, 0}) || !bytes.Equal(fork.CurrentVersion, []byte{0x0b, 0, 0, 0}) || fork.Epoch != 6 {
+		t.Errorf("unexpected fork %+v", fork)
+	}
+}
+
+func TestGetForkMissingState(t *testing.T) {
+	bc := client.NewStandardClient(mock.NewMockBeaconApiProvider())
+	_, exists, err := bc.GetFork(context.Background(), "12345")
+	if err != nil {
+		t.Fatalf("error getting fork: %v", err)
+	}
+	if exists {
+		t.Error("expected a missing state not to exist")
+	}
+}
+
+func TestGetForkError(t *testing.T) {
+	provider := mock.NewMockBeaconApiProvider()
+	provider.SetForkError(errors.New("fork unavailable"))
+	bc := client.NewStandardClient(provider)
+	if _, _, err := bc.GetFork(context.Background(), "head"); err == nil {
+		t.Error("expected the provider's error to be returned")
+	}
+}
+
+func TestGetDomainDataForState(t *testing.T) {
+	genesisValidatorsRoot := bytes.Repeat([]byte{0x4b}, 32)
+	proposerDomain := []byte{0x00, 0x00, 0x00, 0x00}
+	exitDomain := []byte{0x04, 0x00, 0x00, 0x00}
+	tests := []struct {
+		name                string
+		domainType          []byte
+		epoch               uint64
+		expectedForkVersion []byte
+	}{
+		{
+			name:                "epoch before the fork uses the previous version",
+			domainType:          proposerDomain,
+			epoch:               5,
+			expectedForkVersion: []byte{0x0a, 0, 0, 0},
+		}, {
+			name:                "fork epoch uses the current version",
+			domainType:          proposerDomain,
+			epoch:               6,
+			expectedForkVersion: []byte{0x0b, 0, 0, 0},
+		}, {
+			name:                "epoch after the fork uses the current version",
+			domainType:          proposerDomain,
+			epoch:               100,
+			expectedForkVersion: []byte{0x0b, 0, 0, 0},
+		}, {
+			name:                "voluntary exit before Deneb uses the state's fork",
+			domainType:          exitDomain,
+			epoch:               3,
+			expectedForkVersion: []byte{0x0a, 0, 0, 0},
+		}, {
+			name:                "voluntary exit after Deneb uses the Capella version",
+			domainType:          exitDomain,
+			epoch:               100,
+			expectedForkVersion: []byte{0x03, 0, 0, 0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bc := client.NewStandardClient(newForkProvider(genesisValidatorsRoot))
+			domain, err := bc.GetDomainDataForState(context.Background(), test.domainType, test.epoch, "head")
+			if err != nil {
+				t.Fatalf("error getting domain data: %v", err)
+			}
+			expected := computeExpectedDomain(test.domainType, test.expectedForkVersion, genesisValidatorsRoot)
+			if !bytes.Equal(domain, expected) {
+				t.Errorf("expected domain %x, got %x", expected, domain)
+			}
+		})
+	}
+}
+
+func TestGetDomainDataForStateErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		makeProvider func() *mock.MockBeaconApiProvider
+	}{
+		{
+			name: "state not found",
+			makeProvider: func() *mock.MockBeaconApiProvider {
+				return newSpecProvider(12, beacon.FarFutureEpoch-1)
+			},
+		}, {
+			name: "fork error",
+			makeProvider: func() *mock.MockBeaconApiProvider {
+				provider := newForkProvider(nil)
+				provider.SetForkError(errors.New("fork unavailable"))
+				return provider
+			},
+		}, {
+			name: "config error",
+			makeProvider: func() *mock.MockBeaconApiProvider {
+				provider := newForkProvider(nil)
+				provider.SetSpecError(errors.New("spec unavailable"))
+				return provider
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bc := client.NewStandardClient(test.makeProvider())
+			if _, err := bc.GetDomainDataForState(context.Background(), []byte{0, 0, 0, 0}, 10, "head"); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -7,8 +7,8 @@ type StandardHttpClient struct {
 }
 
 // Create a new client instance
-func NewStandardHttpClient(providerAddress string, timeout time.Duration) *StandardHttpClient {
-	provider := NewBeaconHttpProvider(providerAddress, timeout)
+func NewStandardHttpClient(providerAddress string, timeout time.Duration, opts ...BeaconHttpProviderOption) *StandardHttpClient {
+	provider := NewBeaconHttpProvider(providerAddress, timeout, opts...)
 	return &StandardHttpClient{
 		StandardClient: NewStandardClient(provider),
 	}
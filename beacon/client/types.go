@@ -40,9 +40,26 @@ type Eth2ConfigResponse struct {
 		SecondsPerSlot               Uinteger  `json:"SECONDS_PER_SLOT"`
 		SlotsPerEpoch                Uinteger  `json:"SLOTS_PER_EPOCH"`
 		EpochsPerSyncCommitteePeriod Uinteger  `json:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+		AltairForkVersion            ByteArray `json:"ALTAIR_FORK_VERSION"`
+		AltairForkEpoch              *Uinteger `json:"ALTAIR_FORK_EPOCH"`
+		BellatrixForkVersion         ByteArray `json:"BELLATRIX_FORK_VERSION"`
+		BellatrixForkEpoch           *Uinteger `json:"BELLATRIX_FORK_EPOCH"`
 		CapellaForkVersion           ByteArray `json:"CAPELLA_FORK_VERSION"`
+		CapellaForkEpoch             *Uinteger `json:"CAPELLA_FORK_EPOCH"`
+		DenebForkVersion             ByteArray `json:"DENEB_FORK_VERSION"`
+		DenebForkEpoch               *Uinteger `json:"DENEB_FORK_EPOCH"`
+		ElectraForkVersion           ByteArray `json:"ELECTRA_FORK_VERSION"`
+		ElectraForkEpoch             *Uinteger `json:"ELECTRA_FORK_EPOCH"`
 	} `json:"data"`
 }
+type ForkScheduleResponse struct {
+	Data []Fork `json:"data"`
+}
+type Fork struct {
+	PreviousVersion ByteArray `json:"previous_version"`
+	CurrentVersion  ByteArray `json:"current_version"`
+	Epoch           Uinteger  `json:"epoch"`
+}
 type Eth2DepositContractResponse struct {
 	Data struct {
 		ChainID Uinteger       `json:"chain_id"`
@@ -90,15 +107,75 @@ type BeaconBlockResponse struct {
 					DepositCount Uinteger  `json:"deposit_count"`
 					BlockHash    ByteArray `json:"block_hash"`
 				} `json:"eth1_data"`
-				Attestations     []Attestation `json:"attestations"`
-				ExecutionPayload *struct {
-					FeeRecipient ByteArray `json:"fee_recipient"`
-					BlockNumber  Uinteger  `json:"block_number"`
+				Attestations          []Attestation                 `json:"attestations"`
+				ProposerSlashings     []ProposerSlashing            `json:"proposer_slashings"`
+				AttesterSlashings     []AttesterSlashing            `json:"attester_slashings"`
+				VoluntaryExits        []VoluntaryExitRequest        `json:"voluntary_exits"`
+				BlsToExecutionChanges []BLSToExecutionChangeRequest `json:"bls_to_execution_changes"`
+				ExecutionPayload      *struct {
+					FeeRecipient ByteArray    `json:"fee_recipient"`
+					BlockNumber  Uinteger     `json:"block_number"`
+					Withdrawals  []Withdrawal `json:"withdrawals"`
 				} `json:"execution_payload"`
 			} `json:"body"`
 		} `json:"message"`
 	} `json:"data"`
 }
+type ProposerSlashing struct {
+	SignedHeader1 SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+type SignedBeaconBlockHeader struct {
+	Message struct {
+		Slot          Uinteger `json:"slot"`
+		ProposerIndex string   `json:"proposer_index"`
+	} `json:"message"`
+}
+type AttesterSlashing struct {
+	Attestation1 IndexedAttestation `json:"attestation_1"`
+	Attestation2 IndexedAttestation `json:"attestation_2"`
+}
+type IndexedAttestation struct {
+	AttestingIndices []string `json:"attesting_indices"`
+	Data             struct {
+		Slot  Uinteger `json:"slot"`
+		Index Uinteger `json:"index"`
+	} `json:"data"`
+}
+type HeadEvent struct {
+	Slot                Uinteger  `json:"slot"`
+	Block               ByteArray `json:"block"`
+	State               ByteArray `json:"state"`
+	EpochTransition     bool      `json:"epoch_transition"`
+	ExecutionOptimistic bool      `json:"execution_optimistic"`
+}
+type BlockEvent struct {
+	Slot                Uinteger  `json:"slot"`
+	Block               ByteArray `json:"block"`
+	ExecutionOptimistic bool      `json:"execution_optimistic"`
+}
+type FinalizedCheckpointEvent struct {
+	Block               ByteArray `json:"block"`
+	State               ByteArray `json:"state"`
+	Epoch               Uinteger  `json:"epoch"`
+	ExecutionOptimistic bool      `json:"execution_optimistic"`
+}
+type ChainReorgEvent struct {
+	Slot                Uinteger  `json:"slot"`
+	Depth               Uinteger  `json:"depth"`
+	OldHeadBlock        ByteArray `json:"old_head_block"`
+	NewHeadBlock        ByteArray `json:"new_head_block"`
+	OldHeadState        ByteArray `json:"old_head_state"`
+	NewHeadState        ByteArray `json:"new_head_state"`
+	Epoch               Uinteger  `json:"epoch"`
+	ExecutionOptimistic bool      `json:"execution_optimistic"`
+}
+type Withdrawal struct {
+	Index          Uinteger       `json:"index"`
+	ValidatorIndex string         `json:"validator_index"`
+	Address        common.Address `json:"address"`
+	Amount         Uinteger       `json:"amount"`
+}
 type BeaconBlockHeaderResponse struct {
 	Finalized bool `json:"finalized"`
 	Data      struct {
@@ -138,11 +215,18 @@ type SyncDuty struct {
 	ValidatorIndex       string     `json:"validator_index"`
 	SyncCommitteeIndices []Uinteger `json:"validator_sync_committee_indices"`
 }
+type SyncCommitteesResponse struct {
+	Data struct {
+		Validators          []string   `json:"validators"`
+		ValidatorAggregates [][]string `json:"validator_aggregates"`
+	} `json:"data"`
+}
 type ProposerDutiesResponse struct {
 	Data []ProposerDuty `json:"data"`
 }
 type ProposerDuty struct {
-	ValidatorIndex string `json:"validator_index"`
+	ValidatorIndex string   `json:"validator_index"`
+	Slot           Uinteger `json:"slot"`
 }
 
 type CommitteesResponse struct {
@@ -165,10 +249,14 @@ func (i Uinteger) MarshalJSON() ([]byte, error) {
 }
 func (i *Uinteger) UnmarshalJSON(data []byte) error {
 
-	// Unmarshal string
+	// Unmarshal string, falling back to a bare number for clients that don't quote integers
 	var dataStr string
 	if err := json.Unmarshal(data, &dataStr); err != nil {
-		return err
+		var dataNum json.Number
+		if numErr := json.Unmarshal(data, &dataNum); numErr != nil {
+			return err
+		}
+		dataStr = dataNum.String()
 	}
 
 	// Parse integer value
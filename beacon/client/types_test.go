@@ -0,0 +1,169 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+)
+
+func TestUintegerUnmarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected uint64
+		isValid  bool
+	}{
+		{
+			name:     "quoted",
+			data:     `"12345"`,
+			expected: 12345,
+			isValid:  true,
+		}, {
+			name:     "bare",
+			data:     `12345`,
+			expected: 12345,
+			isValid:  true,
+		}, {
+			name:     "quoted zero",
+			data:     `"0"`,
+			expected: 0,
+			isValid:  true,
+		}, {
+			name:     "bare zero",
+			data:     `0`,
+			expected: 0,
+			isValid:  true,
+		}, {
+			name:     "quoted max uint64",
+			data:     `"18446744073709551615"`,
+			expected: 18446744073709551615,
+			isValid:  true,
+		}, {
+			name:     "bare max uint64",
+			data:     `18446744073709551615`,
+			expected: 18446744073709551615,
+			isValid:  true,
+		}, {
+			name:    "quoted overflow",
+			data:    `"18446744073709551616"`,
+			isValid: false,
+		}, {
+			name:    "bare overflow",
+			data:    `18446744073709551616`,
+			isValid: false,
+		}, {
+			name:    "quoted negative",
+			data:    `"-1"`,
+			isValid: false,
+		}, {
+			name:    "bare negative",
+			data:    `-1`,
+			isValid: false,
+		}, {
+			name:    "bare fraction",
+			data:    `1.5`,
+			isValid: false,
+		}, {
+			name:    "quoted hex",
+			data:    `"0x10"`,
+			isValid: false,
+		}, {
+			name:    "empty string",
+			data:    `""`,
+			isValid: false,
+		}, {
+			name:    "bool",
+			data:    `true`,
+			isValid: false,
+		}, {
+			name:    "object",
+			data:    `{"slot":"1"}`,
+			isValid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var value client.Uinteger
+			err := json.Unmarshal([]byte(test.data), &value)
+			if !test.isValid {
+				if err == nil {
+					t.Errorf("expected an error for %s, got %d", test.data, value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for %s: %v", test.data, err)
+			}
+			if uint64(value) != test.expected {
+				t.Errorf("expected %d, got %d", test.expected, value)
+			}
+		})
+	}
+}
+
+func TestUintegerMarshal(t *testing.T) {
+	// Values are always written as quoted strings, per the Beacon API spec
+	bytes, err := json.Marshal(client.Uinteger(18446744073709551615))
+	if err != nil {
+		t.Fatalf("error serializing value: %v", err)
+	}
+	if string(bytes) != `"18446744073709551615"` {
+		t.Errorf("expected a quoted string, got %s", bytes)
+	}
+}
+
+func TestUintegerResponses(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "spec-compliant strings",
+			data: `{"data":{"is_syncing":true,"head_slot":"8000000","sync_distance":"32"}}`,
+		}, {
+			name: "bare numbers",
+			data: `{"data":{"is_syncing":true,"head_slot":8000000,"sync_distance":32}}`,
+		}, {
+			name: "mixed",
+			data: `{"data":{"is_syncing":true,"head_slot":8000000,"sync_distance":"32"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var response client.SyncStatusResponse
+			if err := json.Unmarshal([]byte(test.data), &response); err != nil {
+				t.Fatalf("error deserializing response: %v", err)
+			}
+			if response.Data.HeadSlot != 8000000 || response.Data.SyncDistance != 32 {
+				t.Errorf("unexpected sync status: head slot %d, sync distance %d", response.Data.HeadSlot, response.Data.SyncDistance)
+			}
+		})
+	}
+}
+
+func TestUintegerPointerFields(t *testing.T) {
+	// Fork epochs can be missing or null for forks that aren't scheduled
+	data := `{"data":{"SECONDS_PER_SLOT":12,"SLOTS_PER_EPOCH":"32","CAPELLA_FORK_EPOCH":194048,"DENEB_FORK_EPOCH":"269568","ELECTRA_FORK_EPOCH":null}}`
+	var response client.Eth2ConfigResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		t.Fatalf("error deserializing response: %v", err)
+	}
+	if response.Data.SecondsPerSlot != 12 || response.Data.SlotsPerEpoch != 32 {
+		t.Errorf("unexpected slot timing: %d seconds per slot, %d slots per epoch", response.Data.SecondsPerSlot, response.Data.SlotsPerEpoch)
+	}
+	if response.Data.CapellaForkEpoch == nil || *response.Data.CapellaForkEpoch != 194048 {
+		t.Errorf("expected the bare Capella fork epoch to be parsed, got %v", response.Data.CapellaForkEpoch)
+	}
+	if response.Data.DenebForkEpoch == nil || *response.Data.DenebForkEpoch != 269568 {
+		t.Errorf("expected the quoted Deneb fork epoch to be parsed, got %v", response.Data.DenebForkEpoch)
+	}
+	if response.Data.ElectraForkEpoch != nil {
+		t.Errorf("expected a null Electra fork epoch to stay nil, got %d", *response.Data.ElectraForkEpoch)
+	}
+	if response.Data.AltairForkEpoch != nil {
+		t.Errorf("expected a missing Altair fork epoch to stay nil, got %d", *response.Data.AltairForkEpoch)
+	}
+}
@@ -0,0 +1,131 @@
+package beacon
+
+import "time"
+
+// Get the slot that is active at the provided time.
+// Times before genesis are treated as the first slot of the genesis epoch.
+func (c Eth2Config) SlotAt(t time.Time) uint64 {
+	genesisSlot := c.FirstSlotOfEpoch(c.GenesisEpoch)
+	secondsSinceGenesis, ok := c.secondsSinceGenesis(t)
+	if !ok || c.SecondsPerSlot == 0 {
+		return genesisSlot
+	}
+	return genesisSlot + secondsSinceGenesis/c.SecondsPerSlot
+}
+
+// Get the epoch that is active at the provided time.
+// Times before genesis are treated as the genesis epoch.
+func (c Eth2Config) EpochAt(t time.Time) uint64 {
+	if c.SlotsPerEpoch == 0 {
+		return c.GenesisEpoch
+	}
+	return c.EpochOfSlot(c.SlotAt(t))
+}
+
+// Get the time at which the provided slot starts.
+// Slots before genesis are treated as the first slot of the genesis epoch.
+func (c Eth2Config) TimeOfSlot(slot uint64) time.Time {
+	genesisSlot := c.FirstSlotOfEpoch(c.GenesisEpoch)
+	if slot < genesisSlot {
+		slot = genesisSlot
+	}
+	return time.Unix(int64(c.GenesisTime+(slot-genesisSlot)*c.SecondsPerSlot), 0)
+}
+
+// Get the first slot of the provided epoch
+func (c Eth2Config) FirstSlotOfEpoch(epoch uint64) uint64 {
+	return epoch * c.SlotsPerEpoch
+}
+
+// Get the epoch that the provided slot belongs to
+func (c Eth2Config) EpochOfSlot(slot uint64) uint64 {
+	if c.SlotsPerEpoch == 0 {
+		return 0
+	}
+	return slot / c.SlotsPerEpoch
+}
+
+// Get the sync committee period that the provided epoch belongs to
+func (c Eth2Config) SyncCommitteePeriodOfEpoch(epoch uint64) uint64 {
+	if c.EpochsPerSyncCommitteePeriod == 0 {
+		return 0
+	}
+	return epoch / c.EpochsPerSyncCommitteePeriod
+}
+
+// Get the time at which the provided epoch starts.
+// Epochs before the genesis epoch are treated as the genesis epoch.
+func (c Eth2Config) TimeOfEpoch(epoch uint64) time.Time {
+	if epoch < c.GenesisEpoch {
+		epoch = c.GenesisEpoch
+	}
+	return c.TimeOfSlot(c.FirstSlotOfEpoch(epoch))
+}
+
+// Get the time at which the provided slot starts.
+//
+// Deprecated: use TimeOfSlot instead.
+func (c Eth2Config) SlotToTime(slot uint64) time.Time {
+	return c.TimeOfSlot(slot)
+}
+
+// Get the slot that is active at the provided time.
+//
+// Deprecated: use SlotAt instead.
+func (c Eth2Config) TimeToSlot(t time.Time) uint64 {
+	return c.SlotAt(t)
+}
+
+// Get the epoch that is active at the provided time.
+//
+// Deprecated: use EpochAt instead.
+func (c Eth2Config) TimeToEpoch(t time.Time) uint64 {
+	return c.EpochAt(t)
+}
+
+// Get the time at which the provided epoch starts.
+//
+// Deprecated: use TimeOfEpoch instead.
+func (c Eth2Config) EpochToTime(epoch uint64) time.Time {
+	return c.TimeOfEpoch(epoch)
+}
+
+// Get the number of seconds between genesis and the provided time, or false if the time is before genesis
+func (c Eth2Config) secondsSinceGenesis(t time.Time) (uint64, bool) {
+	unixTime := t.Unix()
+	if unixTime < 0 || uint64(unixTime) < c.GenesisTime {
+		return 0, false
+	}
+	return uint64(unixTime) - c.GenesisTime, true
+}
+
+// Get the fork version that is active at the provided epoch, according to the fork schedule
+func (c Eth2Config) ForkVersionAtEpoch(epoch uint64) []byte {
+	forkVersion := c.GenesisForkVersion
+	forks := []struct {
+		version []byte
+		epoch   uint64
+	}{
+		{c.AltairForkVersion, c.AltairForkEpoch},
+		{c.BellatrixForkVersion, c.BellatrixForkEpoch},
+		{c.CapellaForkVersion, c.CapellaForkEpoch},
+		{c.DenebForkVersion, c.DenebForkEpoch},
+		{c.ElectraForkVersion, c.ElectraForkEpoch},
+	}
+	for _, fork := range forks {
+		if len(fork.version) > 0 && fork.epoch != FarFutureEpoch && fork.epoch <= epoch {
+			forkVersion = fork.version
+		}
+	}
+	return forkVersion
+}
+
+// True if the Deneb fork is active at the provided epoch
+func (c Eth2Config) IsDenebActive(epoch uint64) bool {
+	return c.DenebForkEpoch != FarFutureEpoch && epoch >= c.DenebForkEpoch
+}
+
+// True if the Electra fork is active at the provided epoch
+func (c Eth2Config) IsElectraActive(epoch uint64) bool {
+	return c.ElectraForkEpoch != FarFutureEpoch && epoch >= c.ElectraForkEpoch
+}
@@ -0,0 +1,228 @@
+package beacon
+
+import (
+	"testing"
+	"time"
+)
+
+// Mainnet's timing parameters
+var testConfig = Eth2Config{
+	GenesisTime:                  1606824023,
+	GenesisEpoch:                 0,
+	SecondsPerSlot:               12,
+	SlotsPerEpoch:                32,
+	EpochsPerSyncCommitteePeriod: 256,
+}
+
+// A chain that starts at a later epoch
+var testOffsetConfig = Eth2Config{
+	GenesisTime:    1000,
+	GenesisEpoch:   10,
+	SecondsPerSlot: 12,
+	SlotsPerEpoch:  32,
+}
+
+func TestSlotAndEpochAt(t *testing.T) {
+	genesis := time.Unix(int64(testConfig.GenesisTime), 0)
+	epochDuration := time.Duration(testConfig.SecondsPerSlot*testConfig.SlotsPerEpoch) * time.Second
+	tests := []struct {
+		name          string
+		config        Eth2Config
+		time          time.Time
+		expectedSlot  uint64
+		expectedEpoch uint64
+	}{
+		{
+			name:          "long before genesis",
+			config:        testConfig,
+			time:          time.Unix(0, 0),
+			expectedSlot:  0,
+			expectedEpoch: 0,
+		}, {
+			name:          "just before genesis",
+			config:        testConfig,
+			time:          genesis.Add(-time.Second),
+			expectedSlot:  0,
+			expectedEpoch: 0,
+		}, {
+			name:          "genesis",
+			config:        testConfig,
+			time:          genesis,
+			expectedSlot:  0,
+			expectedEpoch: 0,
+		}, {
+			name:          "last second of slot 0",
+			config:        testConfig,
+			time:          genesis.Add(11 * time.Second),
+			expectedSlot:  0,
+			expectedEpoch: 0,
+		}, {
+			name:          "start of slot 1",
+			config:        testConfig,
+			time:          genesis.Add(12 * time.Second),
+			expectedSlot:  1,
+			expectedEpoch: 0,
+		}, {
+			name:          "last second of epoch 0",
+			config:        testConfig,
+			time:          genesis.Add(epochDuration - time.Second),
+			expectedSlot:  31,
+			expectedEpoch: 0,
+		}, {
+			name:          "start of epoch 1",
+			config:        testConfig,
+			time:          genesis.Add(epochDuration),
+			expectedSlot:  32,
+			expectedEpoch: 1,
+		}, {
+			name:          "start of epoch 1000",
+			config:        testConfig,
+			time:          genesis.Add(1000 * epochDuration),
+			expectedSlot:  32000,
+			expectedEpoch: 1000,
+		}, {
+			name:          "offset genesis before genesis",
+			config:        testOffsetConfig,
+			time:          time.Unix(999, 0),
+			expectedSlot:  320,
+			expectedEpoch: 10,
+		}, {
+			name:          "offset genesis",
+			config:        testOffsetConfig,
+			time:          time.Unix(1000, 0),
+			expectedSlot:  320,
+			expectedEpoch: 10,
+		}, {
+			name:          "offset genesis start of next epoch",
+			config:        testOffsetConfig,
+			time:          time.Unix(1000+12*32, 0),
+			expectedSlot:  352,
+			expectedEpoch: 11,
+		}, {
+			name:          "zero seconds per slot",
+			config:        Eth2Config{GenesisTime: 1000, SlotsPerEpoch: 32},
+			time:          time.Unix(5000, 0),
+			expectedSlot:  0,
+			expectedEpoch: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if slot := test.config.SlotAt(test.time); slot != test.expectedSlot {
+				t.Errorf("expected slot %d, got %d", test.expectedSlot, slot)
+			}
+			if epoch := test.config.EpochAt(test.time); epoch != test.expectedEpoch {
+				t.Errorf("expected epoch %d, got %d", test.expectedEpoch, epoch)
+			}
+		})
+	}
+}
+
+func TestTimeOfSlotAndEpoch(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Eth2Config
+		slot     uint64
+		epoch    uint64
+		expected int64
+	}{
+		{
+			name:     "genesis",
+			config:   testConfig,
+			slot:     0,
+			epoch:    0,
+			expected: 1606824023,
+		}, {
+			name:     "first slot of epoch 1",
+			config:   testConfig,
+			slot:     32,
+			epoch:    1,
+			expected: 1606824023 + 384,
+		}, {
+			name:     "offset genesis",
+			config:   testOffsetConfig,
+			slot:     320,
+			epoch:    10,
+			expected: 1000,
+		}, {
+			name:     "offset genesis before genesis",
+			config:   testOffsetConfig,
+			slot:     0,
+			epoch:    0,
+			expected: 1000,
+		}, {
+			name:     "offset genesis start of next epoch",
+			config:   testOffsetConfig,
+			slot:     352,
+			epoch:    11,
+			expected: 1000 + 384,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if slotTime := test.config.TimeOfSlot(test.slot).Unix(); slotTime != test.expected {
+				t.Errorf("expected slot %d to start at %d, got %d", test.slot, test.expected, slotTime)
+			}
+			if epochTime := test.config.TimeOfEpoch(test.epoch).Unix(); epochTime != test.expected {
+				t.Errorf("expected epoch %d to start at %d, got %d", test.epoch, test.expected, epochTime)
+			}
+		})
+	}
+}
+
+func TestSlotEpochAndPeriodBoundaries(t *testing.T) {
+	tests := []struct {
+		name           string
+		slot           uint64
+		expectedEpoch  uint64
+		expectedPeriod uint64
+	}{
+		{name: "slot 0", slot: 0, expectedEpoch: 0, expectedPeriod: 0},
+		{name: "last slot of epoch 0", slot: 31, expectedEpoch: 0, expectedPeriod: 0},
+		{name: "first slot of epoch 1", slot: 32, expectedEpoch: 1, expectedPeriod: 0},
+		{name: "last slot of period 0", slot: 256*32 - 1, expectedEpoch: 255, expectedPeriod: 0},
+		{name: "first slot of period 1", slot: 256 * 32, expectedEpoch: 256, expectedPeriod: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			epoch := testConfig.EpochOfSlot(test.slot)
+			if epoch != test.expectedEpoch {
+				t.Errorf("expected epoch %d, got %d", test.expectedEpoch, epoch)
+			}
+			if period := testConfig.SyncCommitteePeriodOfEpoch(epoch); period != test.expectedPeriod {
+				t.Errorf("expected sync committee period %d, got %d", test.expectedPeriod, period)
+			}
+			if firstSlot := testConfig.FirstSlotOfEpoch(epoch); firstSlot > test.slot || test.slot-firstSlot >= testConfig.SlotsPerEpoch {
+				t.Errorf("expected the first slot of epoch %d to be within an epoch of slot %d, got %d", epoch, test.slot, firstSlot)
+			}
+		})
+	}
+
+	// Configs without timing parameters shouldn't divide by zero
+	var empty Eth2Config
+	if epoch := empty.EpochOfSlot(100); epoch != 0 {
+		t.Errorf("expected epoch 0 without slots per epoch, got %d", epoch)
+	}
+	if period := empty.SyncCommitteePeriodOfEpoch(100); period != 0 {
+		t.Errorf("expected period 0 without epochs per period, got %d", period)
+	}
+}
+
+func TestDeprecatedTimeHelpers(t *testing.T) {
+	now := time.Unix(int64(testConfig.GenesisTime)+1_000_000, 0)
+	if slot := testConfig.TimeToSlot(now); slot != testConfig.SlotAt(now) {
+		t.Errorf("expected TimeToSlot to match SlotAt, got %d", slot)
+	}
+	if epoch := testConfig.TimeToEpoch(now); epoch != testConfig.EpochAt(now) {
+		t.Errorf("expected TimeToEpoch to match EpochAt, got %d", epoch)
+	}
+	if slotTime := testConfig.SlotToTime(100); !slotTime.Equal(testConfig.TimeOfSlot(100)) {
+		t.Errorf("expected SlotToTime to match TimeOfSlot, got %s", slotTime)
+	}
+	if epochTime := testOffsetConfig.EpochToTime(5); !epochTime.Equal(testOffsetConfig.TimeOfEpoch(5)) {
+		t.Errorf("expected EpochToTime to match TimeOfEpoch, got %s", epochTime)
+	}
+}
@@ -0,0 +1,61 @@
+package beacon
+
+import "github.com/ethereum/go-ethereum/common"
+
+// A topic that can be subscribed to on the Beacon Node's event stream
+type EventTopic string
+
+const (
+	// A new head block was selected by fork choice
+	EventTopic_Head EventTopic = "head"
+
+	// A new block was imported
+	EventTopic_Block EventTopic = "block"
+
+	// A new checkpoint was finalized
+	EventTopic_FinalizedCheckpoint EventTopic = "finalized_checkpoint"
+
+	// The head of the chain was reorganized
+	EventTopic_ChainReorg EventTopic = "chain_reorg"
+)
+
+// An event from the Beacon Node's event stream. Only the field matching the topic is set.
+type BeaconEvent struct {
+	Topic               EventTopic
+	Head                *HeadEvent
+	Block               *BlockEvent
+	FinalizedCheckpoint *FinalizedCheckpointEvent
+	ChainReorg          *ChainReorgEvent
+}
+
+type HeadEvent struct {
+	Slot                uint64
+	Block               common.Hash
+	State               common.Hash
+	EpochTransition     bool
+	ExecutionOptimistic bool
+}
+
+type BlockEvent struct {
+	Slot                uint64
+	Block               common.Hash
+	ExecutionOptimistic bool
+}
+
+type FinalizedCheckpointEvent struct {
+	Block               common.Hash
+	State               common.Hash
+	Epoch               uint64
+	ExecutionOptimistic bool
+}
+
+type ChainReorgEvent struct {
+	Slot                uint64
+	Depth               uint64
+	OldHeadBlock        common.Hash
+	NewHeadBlock        common.Hash
+	OldHeadState        common.Hash
+	NewHeadState        common.Hash
+	Epoch               uint64
+	ExecutionOptimistic bool
+}
@@ -1,6 +1,7 @@
 package beacon
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
@@ -31,6 +32,17 @@ func (v ValidatorPubkey) String() string {
 	return v.Hex()
 }
 
+// Returns true if every byte of the pubkey is zero.
+func (v ValidatorPubkey) IsZero() bool {
+	return v == ValidatorPubkey{}
+}
+
+// Compares the pubkey to another one byte-by-byte, returning -1 if this pubkey is less than the other one, 0 if they
+// are equal, or 1 if it is greater. This can be used to sort pubkeys with slices.SortFunc.
+func (v ValidatorPubkey) Compare(other ValidatorPubkey) int {
+	return bytes.Compare(v[:], other[:])
+}
+
 // Converts a hex-encoded validator pubkey (with an optional 0x prefix) to a validator pubkey.
 func HexToValidatorPubkey(value string) (ValidatorPubkey, error) {
 	// Decode the value
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/goccy/go-json"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/rocket-pool/node-manager-core/utils"
 	"gopkg.in/yaml.v3"
 )
@@ -31,6 +32,21 @@ func (v ValidatorSignature) String() string {
 	return v.Hex()
 }
 
+// Verifies that the signature is a valid BLS signature of the message by the private key for the given pubkey.
+// Returns false if the signature is well-formed but doesn't match, or an error if the signature or pubkey can't be
+// decoded.
+func (v ValidatorSignature) Verify(pubkey ValidatorPubkey, message []byte) (bool, error) {
+	blsPubkey, err := bls.PublicKeyFromBytes(pubkey[:])
+	if err != nil {
+		return false, fmt.Errorf("error decoding pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	blsSignature, err := bls.SignatureFromBytes(v[:])
+	if err != nil {
+		return false, fmt.Errorf("error decoding signature %s: %w", v.HexWithPrefix(), err)
+	}
+	return blsSignature.Verify(blsPubkey, message), nil
+}
+
 // Converts a hex-encoded validator signature (with an optional 0x prefix) to a validator signature.
 func HexToValidatorSignature(value string) (ValidatorSignature, error) {
 	// Decode the value
@@ -25,13 +25,36 @@ type Eth2Config struct {
 	SlotsPerEpoch                uint64
 	SecondsPerEpoch              uint64
 	EpochsPerSyncCommitteePeriod uint64
+
+	// The fork schedule; forks that aren't scheduled have an epoch of FarFutureEpoch and no version
+	AltairForkVersion    []byte
+	AltairForkEpoch      uint64
+	BellatrixForkVersion []byte
+	BellatrixForkEpoch   uint64
+	CapellaForkVersion   []byte
+	CapellaForkEpoch     uint64
+	DenebForkVersion     []byte
+	DenebForkEpoch       uint64
+	ElectraForkVersion   []byte
+	ElectraForkEpoch     uint64
 }
 type Eth2DepositContract struct {
 	ChainID uint64
 	Address common.Address
 }
 type BeaconHead struct {
-	Epoch                  uint64
+	// The epoch of the node's head slot
+	Epoch uint64
+
+	// The slot of the node's head block
+	HeadSlot uint64
+
+	// The epoch according to the wall clock, which is ahead of Epoch if the node is behind
+	WallClockEpoch uint64
+
+	// How many slots the node's head is behind the wall clock
+	SlotDelay uint64
+
 	FinalizedEpoch         uint64
 	JustifiedEpoch         uint64
 	PreviousJustifiedEpoch uint64
@@ -56,17 +79,68 @@ type Eth1Data struct {
 	BlockHash    common.Hash
 }
 type BeaconBlock struct {
-	Header               BeaconBlockHeader
-	HasExecutionPayload  bool
-	Attestations         []AttestationInfo
-	FeeRecipient         common.Address
-	ExecutionBlockNumber uint64
+	Header                BeaconBlockHeader
+	HasExecutionPayload   bool
+	Attestations          []AttestationInfo
+	FeeRecipient          common.Address
+	ExecutionBlockNumber  uint64
+	Withdrawals           []WithdrawalInfo
+	ProposerSlashings     []ProposerSlashingInfo
+	AttesterSlashings     []AttesterSlashingInfo
+	VoluntaryExits        []VoluntaryExitInfo
+	BlsToExecutionChanges []BlsToExecutionChangeInfo
+}
+type ProposerSlashingInfo struct {
+	ProposerIndex string
+	Slot          uint64
+}
+type AttesterSlashingInfo struct {
+	// The indices of the validators that attested to both conflicting attestations, which are the ones being slashed
+	SlashedIndices []string
+	Slot1          uint64
+	Slot2          uint64
+}
+type VoluntaryExitInfo struct {
+	ValidatorIndex string
+	Epoch          uint64
+}
+type BlsToExecutionChangeInfo struct {
+	ValidatorIndex     string
+	FromBlsPubkey      ValidatorPubkey
+	ToExecutionAddress common.Address
+}
+type WithdrawalInfo struct {
+	Index          uint64
+	ValidatorIndex string
+	Address        common.Address
+	Amount         uint64 // In gwei
 }
 type BeaconBlockHeader struct {
 	Slot          uint64
 	ProposerIndex string
 }
 
+// The members of a sync committee for a sync committee period
+type SyncCommittee struct {
+	// The indices of the validators in the committee, in committee order
+	Validators []string
+
+	// The indices of the validators in each subcommittee
+	Subcommittees [][]string
+}
+
+// The fork of a Beacon chain state
+type ForkInfo struct {
+	// The fork version before the state's most recent fork
+	PreviousVersion []byte
+
+	// The fork version of the state's most recent fork
+	CurrentVersion []byte
+
+	// The epoch of the state's most recent fork
+	Epoch uint64
+}
+
 // Committees is an interface as an optimization- since committees responses
 // are quite large, there's a decent cpu/memory improvement to removing the
 // translation to an intermediate storage class.
@@ -91,6 +165,12 @@ type Committees interface {
 	// further reuse, and must be called when the user is done with this
 	// committees instance
 	Release()
+
+	// GetCommitteeForValidatorIndex returns the slot and index of the
+	// committee the validator with the provided index belongs to, and its
+	// position within that committee. found is false if the validator
+	// isn't in any of the committees.
+	GetCommitteeForValidatorIndex(index string) (slot uint64, committeeIndex uint64, positionInCommittee int, found bool)
 }
 
 type AttestationInfo struct {
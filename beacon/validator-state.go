@@ -0,0 +1,129 @@
+package beacon
+
+import "math"
+
+const (
+	// The epoch used by the Beacon chain to signify an event that hasn't been scheduled yet
+	FarFutureEpoch uint64 = math.MaxUint64
+)
+
+// True if the state is one of the standard states defined in the Beacon API spec
+func (s ValidatorState) IsKnown() bool {
+	switch s {
+	case ValidatorState_PendingInitialized,
+		ValidatorState_PendingQueued,
+		ValidatorState_ActiveOngoing,
+		ValidatorState_ActiveExiting,
+		ValidatorState_ActiveSlashed,
+		ValidatorState_ExitedUnslashed,
+		ValidatorState_ExitedSlashed,
+		ValidatorState_WithdrawalPossible,
+		ValidatorState_WithdrawalDone:
+		return true
+	}
+	return false
+}
+
+// True if the validator hasn't been activated yet
+func (s ValidatorState) IsPending() bool {
+	return s == ValidatorState_PendingInitialized ||
+		s == ValidatorState_PendingQueued
+}
+
+// True if the validator is currently active on the Beacon chain, including if it's exiting or has been slashed
+func (s ValidatorState) IsActive() bool {
+	return s == ValidatorState_ActiveOngoing ||
+		s == ValidatorState_ActiveExiting ||
+		s == ValidatorState_ActiveSlashed
+}
+
+// True if the validator has exited the Beacon chain, including if it's withdrawable or has been withdrawn
+func (s ValidatorState) IsExited() bool {
+	return s == ValidatorState_ExitedUnslashed ||
+		s == ValidatorState_ExitedSlashed ||
+		s == ValidatorState_WithdrawalPossible ||
+		s == ValidatorState_WithdrawalDone
+}
+
+// True if the validator still has a balance on the Beacon chain (i.e., it hasn't been fully withdrawn)
+func (s ValidatorState) HasBalance() bool {
+	return s.IsKnown() && s != ValidatorState_WithdrawalDone
+}
+
+// True if the validator is currently active on the Beacon chain, including if it's exiting or has been slashed
+func (s ValidatorStatus) IsActive() bool {
+	return s.Status.IsActive()
+}
+
+// True if the validator is still active but has been scheduled to exit, either voluntarily or because it was slashed
+func (s ValidatorStatus) IsExiting() bool {
+	return s.Status == ValidatorState_ActiveExiting ||
+		s.Status == ValidatorState_ActiveSlashed
+}
+
+// True if the validator can still be slashed: it hasn't been slashed already, and it has been activated but hasn't
+// reached its withdrawable epoch yet
+func (s ValidatorStatus) IsSlashable() bool {
+	if s.Slashed {
+		return false
+	}
+	return s.Status == ValidatorState_ActiveOngoing ||
+		s.Status == ValidatorState_ActiveExiting ||
+		s.Status == ValidatorState_ExitedUnslashed
+}
+
+// True if the validator is waiting to be activated
+func (s ValidatorStatus) IsPendingActivation() bool {
+	return s.Status.IsPending()
+}
+
+// True if the validator has reached its withdrawable epoch and still has a balance to withdraw
+func (s ValidatorStatus) IsWithdrawable() bool {
+	return s.Status == ValidatorState_WithdrawalPossible
+}
+
+// Get the canonical state of a validator at the provided epoch.
+// If the status reported by the Beacon Node is one of the standard states, it will be used directly; otherwise the
+// state will be derived from the validator's epochs according to the Beacon API spec.
+func GetValidatorState(status ValidatorStatus, epoch uint64) ValidatorState {
+	if status.Status.IsKnown() {
+		return status.Status
+	}
+	return DeriveValidatorState(status, epoch)
+}
+
+// Derive the state of a validator at the provided epoch from its epochs, balance, and slashing status
+func DeriveValidatorState(status ValidatorStatus, epoch uint64) ValidatorState {
+	// Pending
+	if status.ActivationEligibilityEpoch == FarFutureEpoch {
+		return ValidatorState_PendingInitialized
+	}
+	if epoch < status.ActivationEpoch {
+		return ValidatorState_PendingQueued
+	}
+
+	// Active
+	if epoch < status.ExitEpoch {
+		if status.ExitEpoch == FarFutureEpoch {
+			return ValidatorState_ActiveOngoing
+		}
+		if status.Slashed {
+			return ValidatorState_ActiveSlashed
+		}
+		return ValidatorState_ActiveExiting
+	}
+
+	// Exited
+	if epoch < status.WithdrawableEpoch {
+		if status.Slashed {
+			return ValidatorState_ExitedSlashed
+		}
+		return ValidatorState_ExitedUnslashed
+	}
+
+	// Withdrawal
+	if status.Balance != 0 {
+		return ValidatorState_WithdrawalPossible
+	}
+	return ValidatorState_WithdrawalDone
+}
@@ -0,0 +1,162 @@
+package beacon
+
+import "testing"
+
+// Create a validator status with the provided epochs, starting from one that's eligible for activation
+func newTestValidatorStatus(activation uint64, exit uint64, withdrawable uint64, slashed bool, balance uint64) ValidatorStatus {
+	return ValidatorStatus{
+		ActivationEligibilityEpoch: 0,
+		ActivationEpoch:            activation,
+		ExitEpoch:                  exit,
+		WithdrawableEpoch:          withdrawable,
+		Slashed:                    slashed,
+		Balance:                    balance,
+	}
+}
+
+// Check the derived states against the validator status taxonomy in the Beacon API spec
+func TestDeriveValidatorState(t *testing.T) {
+	const balance uint64 = 32e9
+	tests := []struct {
+		name     string
+		status   ValidatorStatus
+		epoch    uint64
+		expected ValidatorState
+	}{
+		{
+			name: "not eligible for activation",
+			status: ValidatorStatus{
+				ActivationEligibilityEpoch: FarFutureEpoch,
+				ActivationEpoch:            FarFutureEpoch,
+				ExitEpoch:                  FarFutureEpoch,
+				WithdrawableEpoch:          FarFutureEpoch,
+				Balance:                    balance,
+			},
+			epoch:    100,
+			expected: ValidatorState_PendingInitialized,
+		}, {
+			name:     "eligible but activation not scheduled",
+			status:   newTestValidatorStatus(FarFutureEpoch, FarFutureEpoch, FarFutureEpoch, false, balance),
+			epoch:    100,
+			expected: ValidatorState_PendingQueued,
+		}, {
+			name:     "activation scheduled",
+			status:   newTestValidatorStatus(101, FarFutureEpoch, FarFutureEpoch, false, balance),
+			epoch:    100,
+			expected: ValidatorState_PendingQueued,
+		}, {
+			name:     "activation epoch",
+			status:   newTestValidatorStatus(100, FarFutureEpoch, FarFutureEpoch, false, balance),
+			epoch:    100,
+			expected: ValidatorState_ActiveOngoing,
+		}, {
+			name:     "active without an exit",
+			status:   newTestValidatorStatus(10, FarFutureEpoch, FarFutureEpoch, false, balance),
+			epoch:    100,
+			expected: ValidatorState_ActiveOngoing,
+		}, {
+			name:     "voluntary exit scheduled",
+			status:   newTestValidatorStatus(10, 200, 456, false, balance),
+			epoch:    100,
+			expected: ValidatorState_ActiveExiting,
+		}, {
+			name:     "slashed before exit",
+			status:   newTestValidatorStatus(10, 200, 8392, true, balance),
+			epoch:    100,
+			expected: ValidatorState_ActiveSlashed,
+		}, {
+			name:     "exit epoch",
+			status:   newTestValidatorStatus(10, 100, 356, false, balance),
+			epoch:    100,
+			expected: ValidatorState_ExitedUnslashed,
+		}, {
+			name:     "exited after slashing",
+			status:   newTestValidatorStatus(10, 50, 8242, true, balance),
+			epoch:    100,
+			expected: ValidatorState_ExitedSlashed,
+		}, {
+			name:     "withdrawable epoch",
+			status:   newTestValidatorStatus(10, 50, 100, false, balance),
+			epoch:    100,
+			expected: ValidatorState_WithdrawalPossible,
+		}, {
+			name:     "withdrawable after slashing",
+			status:   newTestValidatorStatus(10, 50, 80, true, 1),
+			epoch:    100,
+			expected: ValidatorState_WithdrawalPossible,
+		}, {
+			name:     "fully withdrawn",
+			status:   newTestValidatorStatus(10, 50, 80, false, 0),
+			epoch:    100,
+			expected: ValidatorState_WithdrawalDone,
+		}, {
+			name:     "fully withdrawn after slashing",
+			status:   newTestValidatorStatus(10, 50, 80, true, 0),
+			epoch:    100,
+			expected: ValidatorState_WithdrawalDone,
+		}, {
+			name:     "active with a zero balance",
+			status:   newTestValidatorStatus(10, FarFutureEpoch, FarFutureEpoch, false, 0),
+			epoch:    100,
+			expected: ValidatorState_ActiveOngoing,
+		}, {
+			name:     "far future epoch",
+			status:   newTestValidatorStatus(10, FarFutureEpoch, FarFutureEpoch, false, balance),
+			epoch:    FarFutureEpoch,
+			expected: ValidatorState_WithdrawalPossible,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state := DeriveValidatorState(test.status, test.epoch)
+			if state != test.expected {
+				t.Errorf("expected state %s, got %s", test.expected, state)
+			}
+
+			// Every derived state should belong to exactly one category
+			categories := 0
+			for _, inCategory := range []bool{state.IsPending(), state.IsActive(), state.IsExited()} {
+				if inCategory {
+					categories++
+				}
+			}
+			if !state.IsKnown() || categories != 1 {
+				t.Errorf("expected state %s to be a known state in one category, got %d categories", state, categories)
+			}
+		})
+	}
+}
+
+func TestGetValidatorState(t *testing.T) {
+	exiting := newTestValidatorStatus(10, 200, 456, false, 32e9)
+	tests := []struct {
+		name     string
+		reported ValidatorState
+		expected ValidatorState
+	}{
+		{
+			name:     "standard state is used directly",
+			reported: ValidatorState_ActiveOngoing,
+			expected: ValidatorState_ActiveOngoing,
+		}, {
+			name:     "missing state is derived",
+			reported: "",
+			expected: ValidatorState_ActiveExiting,
+		}, {
+			name:     "nonstandard state is derived",
+			reported: "active",
+			expected: ValidatorState_ActiveExiting,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := exiting
+			status.Status = test.reported
+			if state := GetValidatorState(status, 100); state != test.expected {
+				t.Errorf("expected state %s, got %s", test.expected, state)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ type ExtendedDepositData struct {
 	DepositDataRoot       ByteArray `json:"deposit_data_root"`
 	ForkVersion           ByteArray `json:"fork_version"`
 	NetworkName           string    `json:"network_name"`
+	DepositCliVersion     string    `json:"deposit_cli_version"`
 }
 
 // Byte array type
@@ -0,0 +1,101 @@
+package beacon
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/node-manager-core/utils"
+)
+
+// The type of a validator's withdrawal credentials, indicated by the first byte of the credentials
+type CredentialType byte
+
+const (
+	// Credentials derived from a BLS withdrawal key
+	CredentialType_Bls CredentialType = 0x00
+
+	// Credentials pointing to an Execution layer address
+	CredentialType_Execution CredentialType = 0x01
+
+	// Credentials pointing to an Execution layer address for a compounding validator
+	CredentialType_Compounding CredentialType = 0x02
+)
+
+const (
+	// The number of zero bytes between the prefix and the address in Execution layer withdrawal credentials
+	withdrawalAddressPaddingLength int = common.HashLength - common.AddressLength - 1
+)
+
+// True if the credential type is one of the known types
+func (t CredentialType) IsKnown() bool {
+	switch t {
+	case CredentialType_Bls, CredentialType_Execution, CredentialType_Compounding:
+		return true
+	}
+	return false
+}
+
+// True if the credential type points to an Execution layer address
+func (t CredentialType) IsExecution() bool {
+	return t == CredentialType_Execution || t == CredentialType_Compounding
+}
+
+// Converts hex-encoded withdrawal credentials (with an optional 0x prefix) to withdrawal credentials.
+// Returns an error if the value isn't exactly 32 bytes.
+func HexToWithdrawalCredentials(value string) (common.Hash, error) {
+	// Decode the value
+	bytes, err := utils.DecodeHex(value)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("error decoding withdrawal credentials: %w", err)
+	}
+
+	// Sanity check the length
+	if len(bytes) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid withdrawal credentials hex string %s: invalid length %d", value, len(bytes))
+	}
+	return common.Hash(bytes), nil
+}
+
+// Get the type of the provided withdrawal credentials
+func GetWithdrawalCredentialType(creds common.Hash) CredentialType {
+	return CredentialType(creds[0])
+}
+
+// Get the Execution layer withdrawal address from the provided withdrawal credentials.
+// Returns an error if the credentials don't point to an Execution layer address or are malformed.
+func GetWithdrawalAddressFromCredentials(creds common.Hash) (common.Address, error) {
+	credType := GetWithdrawalCredentialType(creds)
+	if credType == CredentialType_Bls {
+		return common.Address{}, fmt.Errorf("withdrawal credentials [%s] are BLS credentials and don't have a withdrawal address", creds.Hex())
+	}
+	if !credType.IsExecution() {
+		return common.Address{}, fmt.Errorf("withdrawal credentials [%s] have unknown type 0x%02x", creds.Hex(), byte(credType))
+	}
+
+	// Make sure the padding is empty
+	for _, b := range creds[1 : 1+withdrawalAddressPaddingLength] {
+		if b != 0 {
+			return common.Address{}, fmt.Errorf("withdrawal credentials [%s] are malformed: padding between the prefix and address must be zero", creds.Hex())
+		}
+	}
+	return common.BytesToAddress(creds[1+withdrawalAddressPaddingLength:]), nil
+}
+
+// Get the withdrawal credentials of the provided type that point to an Execution layer address
+func GetCredentialsForAddress(addr common.Address, credType CredentialType) common.Hash {
+	var creds common.Hash
+	creds[0] = byte(credType)
+	copy(creds[1+withdrawalAddressPaddingLength:], addr.Bytes())
+	return creds
+}
+
+// Get the type of the validator's withdrawal credentials
+func (s ValidatorStatus) GetWithdrawalCredentialType() CredentialType {
+	return GetWithdrawalCredentialType(s.WithdrawalCredentials)
+}
+
+// Get the Execution layer withdrawal address from the validator's withdrawal credentials.
+// Returns an error if the credentials don't point to an Execution layer address or are malformed.
+func (s ValidatorStatus) GetWithdrawalAddress() (common.Address, error) {
+	return GetWithdrawalAddressFromCredentials(s.WithdrawalCredentials)
+}
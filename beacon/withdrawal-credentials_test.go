@@ -0,0 +1,166 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	testWithdrawalAddress string = "0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045"
+)
+
+func TestHexToWithdrawalCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		isValid bool
+	}{
+		{
+			name:    "execution credentials",
+			value:   "0x010000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045",
+			isValid: true,
+		}, {
+			name:    "no prefix",
+			value:   "010000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045",
+			isValid: true,
+		}, {
+			name:  "too short",
+			value: "0x010000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa960",
+		}, {
+			name:  "too long",
+			value: "0x010000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa9604500",
+		}, {
+			name:  "address only",
+			value: testWithdrawalAddress,
+		}, {
+			name:  "empty",
+			value: "",
+		}, {
+			name:  "invalid hex",
+			value: "0x01zz000000000000000000000d8da6bf26964af9d7eed9e03e53415d37aa96045",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			creds, err := HexToWithdrawalCredentials(test.value)
+			if !test.isValid {
+				if err == nil {
+					t.Errorf("expected an error, got credentials %s", creds.Hex())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if creds != GetCredentialsForAddress(common.HexToAddress(testWithdrawalAddress), CredentialType_Execution) {
+				t.Errorf("unexpected credentials %s", creds.Hex())
+			}
+		})
+	}
+}
+
+func TestGetWithdrawalAddressFromCredentials(t *testing.T) {
+	address := common.HexToAddress(testWithdrawalAddress)
+	withPadding := func(index int) common.Hash {
+		creds := GetCredentialsForAddress(address, CredentialType_Execution)
+		creds[index] = 0x01
+		return creds
+	}
+	withPrefix := func(prefix byte) common.Hash {
+		creds := GetCredentialsForAddress(address, CredentialType_Execution)
+		creds[0] = prefix
+		return creds
+	}
+
+	tests := []struct {
+		name         string
+		creds        common.Hash
+		expectedType CredentialType
+		isValid      bool
+	}{
+		{
+			name:         "execution credentials",
+			creds:        GetCredentialsForAddress(address, CredentialType_Execution),
+			expectedType: CredentialType_Execution,
+			isValid:      true,
+		}, {
+			name:         "compounding credentials",
+			creds:        GetCredentialsForAddress(address, CredentialType_Compounding),
+			expectedType: CredentialType_Compounding,
+			isValid:      true,
+		}, {
+			name:         "BLS credentials",
+			creds:        common.HexToHash("0x00f50428677c60f997aadeab24aabf7fceaef491c96a52b463ae91f95611cf71"),
+			expectedType: CredentialType_Bls,
+		}, {
+			name:         "unknown prefix",
+			creds:        withPrefix(0x03),
+			expectedType: CredentialType(0x03),
+		}, {
+			name:         "unknown high prefix",
+			creds:        withPrefix(0xff),
+			expectedType: CredentialType(0xff),
+		}, {
+			name:         "non-zero first padding byte",
+			creds:        withPadding(1),
+			expectedType: CredentialType_Execution,
+		}, {
+			name:         "non-zero last padding byte",
+			creds:        withPadding(withdrawalAddressPaddingLength),
+			expectedType: CredentialType_Execution,
+		}, {
+			name:         "all zero",
+			creds:        common.Hash{},
+			expectedType: CredentialType_Bls,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if credType := GetWithdrawalCredentialType(test.creds); credType != test.expectedType {
+				t.Errorf("expected type 0x%02x, got 0x%02x", byte(test.expectedType), byte(credType))
+			}
+			status := ValidatorStatus{WithdrawalCredentials: test.creds}
+			if credType := status.GetWithdrawalCredentialType(); credType != test.expectedType {
+				t.Errorf("expected status type 0x%02x, got 0x%02x", byte(test.expectedType), byte(credType))
+			}
+
+			withdrawalAddress, err := status.GetWithdrawalAddress()
+			if !test.isValid {
+				if err == nil {
+					t.Errorf("expected an error, got address %s", withdrawalAddress.Hex())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if withdrawalAddress != address {
+				t.Errorf("expected address %s, got %s", address.Hex(), withdrawalAddress.Hex())
+			}
+		})
+	}
+}
+
+func TestCredentialType(t *testing.T) {
+	tests := []struct {
+		credType    CredentialType
+		isKnown     bool
+		isExecution bool
+	}{
+		{CredentialType_Bls, true, false},
+		{CredentialType_Execution, true, true},
+		{CredentialType_Compounding, true, true},
+		{CredentialType(0x03), false, false},
+	}
+	for _, test := range tests {
+		if isKnown := test.credType.IsKnown(); isKnown != test.isKnown {
+			t.Errorf("expected IsKnown for 0x%02x to be %t", byte(test.credType), test.isKnown)
+		}
+		if isExecution := test.credType.IsExecution(); isExecution != test.isExecution {
+			t.Errorf("expected IsExecution for 0x%02x to be %t", byte(test.credType), test.isExecution)
+		}
+	}
+}
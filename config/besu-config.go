@@ -1,6 +1,9 @@
 package config
 
 import (
+	"runtime"
+
+	"github.com/pbnjay/memory"
 	"github.com/rocket-pool/node-manager-core/config/ids"
 )
 
@@ -13,7 +16,7 @@ const (
 
 // Configuration for Besu
 type BesuConfig struct {
-	// Max number of P2P peers to connect to
+	// The max amount of RAM, in MB, for Besu's JVM heap
 	JvmHeapSize Parameter[uint64]
 
 	// Max number of P2P peers to connect to
@@ -39,13 +42,13 @@ func NewBesuConfig() *BesuConfig {
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.BesuJvmHeapSizeID,
 				Name:               "JVM Heap Size",
-				Description:        "The max amount of RAM, in MB, that Besu's JVM should limit itself to. Setting this lower will cause Besu to use less RAM, though it will always use more than this limit.\n\nUse 0 for automatic allocation.",
+				Description:        "The max amount of RAM, in MB, that Besu's JVM should limit itself to. Setting this lower will cause Besu to use less RAM, though it will always use more than this limit.\n\nThe default value for this will be calculated dynamically based on your system's available RAM, but you can adjust it manually. Use 0 for automatic allocation.",
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]uint64{
-				Network_All: uint64(0),
+				Network_All: calculateBesuHeapSize(),
 			},
 		},
 
@@ -53,13 +56,13 @@ func NewBesuConfig() *BesuConfig {
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.MaxPeersID,
 				Name:               "Max Peers",
-				Description:        "The maximum number of peers Besu should connect to. This can be lowered to improve performance on low-power systems or constrained networks. We recommend keeping it at 12 or higher.",
+				Description:        getPeerCountDescription("The maximum number of peers Besu should connect to. This can be lowered to improve performance on low-power systems or constrained networks. We recommend keeping it at 12 or higher."),
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
 			Default: map[Network]uint16{
-				Network_All: 25,
+				Network_All: calculateBesuPeers(),
 			},
 		},
 
@@ -82,7 +85,7 @@ func NewBesuConfig() *BesuConfig {
 				ID:                 ids.BesuArchiveModeID,
 				Name:               "Enable Archive Mode",
 				Description:        "When enabled, Besu will run in \"archive\" mode which means it can recreate the state of the chain for a previous block. This is required for accessing the state of blocks that are more than about half-an-hour old, which may be a part of things like reward systems.",
-				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
+				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
@@ -100,9 +103,11 @@ func NewBesuConfig() *BesuConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: besuTagProd,
 				Network_Holesky: besuTagTest,
+				Network_All:     besuTagTest,
 			},
 		},
 
@@ -143,3 +148,32 @@ func (cfg *BesuConfig) GetParameters() []IParameter {
 func (cfg *BesuConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{}
 }
+
+// Calculate the default JVM heap size for Besu based on the amount of system RAM
+func calculateBesuHeapSize() uint64 {
+	return getBesuHeapSizeForMemory(memory.TotalMemory() / 1024 / 1024 / 1024)
+}
+
+// Get the JVM heap size for Besu, in MB, on a system with the given amount of RAM in GB.
+// Returns 0 (automatic allocation) if the amount of RAM couldn't be determined.
+func getBesuHeapSizeForMemory(totalMemoryGB uint64) uint64 {
+	if totalMemoryGB == 0 {
+		return 0
+	} else if totalMemoryGB < 9 {
+		return 2048
+	} else if totalMemoryGB < 17 {
+		return 4096
+	} else {
+		return 8192
+	}
+}
+
+// Calculate the default number of Besu peers
+func calculateBesuPeers() uint16 {
+	switch getPeerCountTier(runtime.GOARCH) {
+	case peerCountTier_Standard:
+		return 25
+	default:
+		return 12
+	}
+}
@@ -0,0 +1,109 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+func TestBesuHeapSizeForMemory(t *testing.T) {
+	tests := []struct {
+		name             string
+		totalMemoryGB    uint64
+		expectedHeapSize uint64
+	}{
+		{name: "unknown RAM", totalMemoryGB: 0, expectedHeapSize: 0},
+		{name: "1 GB", totalMemoryGB: 1, expectedHeapSize: 2048},
+		{name: "8 GB", totalMemoryGB: 8, expectedHeapSize: 2048},
+		{name: "9 GB", totalMemoryGB: 9, expectedHeapSize: 4096},
+		{name: "16 GB", totalMemoryGB: 16, expectedHeapSize: 4096},
+		{name: "17 GB", totalMemoryGB: 17, expectedHeapSize: 8192},
+		{name: "32 GB", totalMemoryGB: 32, expectedHeapSize: 8192},
+		{name: "128 GB", totalMemoryGB: 128, expectedHeapSize: 8192},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if heapSize := getBesuHeapSizeForMemory(test.totalMemoryGB); heapSize != test.expectedHeapSize {
+				t.Errorf("expected a heap size of %d MB, got %d MB", test.expectedHeapSize, heapSize)
+			}
+		})
+	}
+}
+
+func TestBesuDefaults(t *testing.T) {
+	tests := []struct {
+		network     Network
+		expectedTag string
+	}{
+		{network: Network_Mainnet, expectedTag: besuTagProd},
+		{network: Network_Holesky, expectedTag: besuTagTest},
+		{network: Network_Hoodi, expectedTag: besuTagTest},
+		{network: Network_Sepolia, expectedTag: besuTagTest},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.network), func(t *testing.T) {
+			cfg := NewBesuConfig()
+			if tag := cfg.ContainerTag.GetDefault(test.network); tag != test.expectedTag {
+				t.Errorf("expected tag %s, got %s", test.expectedTag, tag)
+			}
+			if heapSize := cfg.JvmHeapSize.GetDefault(test.network); heapSize != calculateBesuHeapSize() {
+				t.Errorf("expected a heap size of %d, got %d", calculateBesuHeapSize(), heapSize)
+			}
+			if peers := cfg.MaxPeers.GetDefault(test.network); peers != calculateBesuPeers() {
+				t.Errorf("expected %d peers, got %d", calculateBesuPeers(), peers)
+			}
+			if layers := cfg.MaxBackLayers.GetDefault(test.network); layers != 512 {
+				t.Errorf("expected 512 back layers, got %d", layers)
+			}
+			if cfg.ArchiveMode.GetDefault(test.network) {
+				t.Error("expected archive mode to be disabled by default")
+			}
+			if flags := cfg.AdditionalFlags.GetDefault(test.network); flags != "" {
+				t.Errorf("expected no additional flags, got [%s]", flags)
+			}
+		})
+	}
+}
+
+func TestBesuParameters(t *testing.T) {
+	cfg := NewBesuConfig()
+	expected := []string{
+		ids.BesuJvmHeapSizeID,
+		ids.MaxPeersID,
+		ids.BesuMaxBackLayersID,
+		ids.BesuArchiveModeID,
+		ids.ContainerTagID,
+		ids.AdditionalFlagsID,
+	}
+	params := cfg.GetParameters()
+	if len(params) != len(expected) {
+		t.Fatalf("expected %d parameters, got %d", len(expected), len(params))
+	}
+	for i, param := range params {
+		if id := param.GetCommon().ID; id != expected[i] {
+			t.Errorf("expected parameter %d to be %s, got %s", i, expected[i], id)
+		}
+	}
+	if len(cfg.GetSubconfigs()) != 0 {
+		t.Errorf("expected no subconfigs, got %d", len(cfg.GetSubconfigs()))
+	}
+
+	// Only the container tag is reset on upgrades
+	for _, param := range params {
+		common := param.GetCommon()
+		if common.OverwriteOnUpgrade != (common.ID == ids.ContainerTagID) {
+			t.Errorf("unexpected OverwriteOnUpgrade setting for parameter %s: %t", common.ID, common.OverwriteOnUpgrade)
+		}
+	}
+}
+
+func TestBesuPeersByArchitecture(t *testing.T) {
+	// The default depends on the system's architecture, but is always one of the tiers
+	switch peers := calculateBesuPeers(); peers {
+	case 25, 12:
+	default:
+		t.Errorf("unexpected peer count %d", peers)
+	}
+}
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strconv"
 )
 
 // Interface for describing config sections
@@ -17,6 +20,13 @@ type IConfigSection interface {
 	GetSubconfigs() map[string]IConfigSection
 }
 
+// Optional interface for config sections that need to check combinations of their parameters during validation, beyond
+// what each parameter's own validator can check
+type ISectionValidator interface {
+	// Validate the section as a whole, returning all of the errors that were found
+	ValidateSection() []error
+}
+
 // Serialize a config section into a map
 func Serialize(cfg IConfigSection) map[string]any {
 	masterMap := map[string]any{}
@@ -37,7 +47,10 @@ func Serialize(cfg IConfigSection) map[string]any {
 	return masterMap
 }
 
-// Deserialize a config section
+// Deserialize a config section.
+// Parameter values can be strings (as produced by Serialize) or the native scalar types produced by YAML / TOML / JSON
+// decoders, such as bools and numbers. Parameters and subsections that aren't present are set to their defaults for
+// the provided network.
 func Deserialize(cfg IConfigSection, serializedParams map[string]any, network Network) error {
 	// Handle the parameters
 	params := cfg.GetParameters()
@@ -47,11 +60,11 @@ func Deserialize(cfg IConfigSection, serializedParams map[string]any, network Ne
 		if !exists {
 			param.SetToDefault(network)
 		} else {
-			valString, isString := val.(string)
-			if !isString {
-				return fmt.Errorf("parameter [%s] is not a string but has a parameter ID name", id)
+			valString, err := serializedValueToString(val)
+			if err != nil {
+				return fmt.Errorf("parameter [%s] has an invalid value: %w", id, err)
 			}
-			err := param.Deserialize(valString, network)
+			err = param.Deserialize(valString, network)
 			if err != nil {
 				return fmt.Errorf("error deserializing parameter [%s]: %w", id, err)
 			}
@@ -62,7 +75,9 @@ func Deserialize(cfg IConfigSection, serializedParams map[string]any, network Ne
 	subconfigs := cfg.GetSubconfigs()
 	for name, subconfig := range subconfigs {
 		subParams, exists := serializedParams[name]
-		if exists {
+		if !exists {
+			ApplyDefaults(subconfig, network)
+		} else {
 			submap, isMap := subParams.(map[string]any)
 			if !isMap {
 				return fmt.Errorf("subsection [%s] is not a map, it is %s", name, reflect.TypeOf(subParams))
@@ -77,6 +92,36 @@ func Deserialize(cfg IConfigSection, serializedParams map[string]any, network Ne
 	return nil
 }
 
+// Convert a serialized parameter value into the string format used by IParameter.Deserialize
+func serializedValueToString(val any) (string, error) {
+	switch typedVal := val.(type) {
+	case string:
+		return typedVal, nil
+	case bool:
+		return strconv.FormatBool(typedVal), nil
+	case int:
+		return strconv.FormatInt(int64(typedVal), 10), nil
+	case int64:
+		return strconv.FormatInt(typedVal, 10), nil
+	case int32:
+		return strconv.FormatInt(int64(typedVal), 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(typedVal), 10), nil
+	case uint64:
+		return strconv.FormatUint(typedVal, 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(typedVal), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(typedVal), 10), nil
+	case float64:
+		return strconv.FormatFloat(typedVal, 'f', -1, 64), nil
+	case fmt.Stringer:
+		return typedVal.String(), nil
+	default:
+		return "", fmt.Errorf("values of type %s are not supported", reflect.TypeOf(val))
+	}
+}
+
 // Copy a section's settings into the corresponding section of a new config
 func Clone(source IConfigSection, target IConfigSection, network Network) {
 	// Handle the parameters
@@ -135,3 +180,77 @@ func ApplyDefaults(cfg IConfigSection, network Network) {
 		ApplyDefaults(subconfig, network)
 	}
 }
+
+// Get each parameter and subparameter that has been changed from its default value for the provided network
+func GetChangedParameters(cfg IConfigSection, network Network) []IParameter {
+	changedParams := []IParameter{}
+
+	// Check the parameters
+	for _, param := range cfg.GetParameters() {
+		if !param.IsDefault(network) {
+			changedParams = append(changedParams, param)
+		}
+	}
+
+	// Check the subconfigs
+	for _, subconfig := range cfg.GetSubconfigs() {
+		changedParams = append(changedParams, GetChangedParameters(subconfig, network)...)
+	}
+
+	return changedParams
+}
+
+// Validate each parameter and subparameter, returning all of the validation errors that were found
+func Validate(cfg IConfigSection) error {
+	return errors.Join(ValidateAll(cfg)...)
+}
+
+// Validate each parameter and subparameter with their validators, and check that the port parameters in each section
+// are legal and don't collide. Returns all of the validation errors that were found, prefixed with the path of the
+// section they came from.
+func ValidateAll(cfg IConfigSection) []error {
+	return validateSection(cfg, "")
+}
+
+// Validate the parameters in a section and its subsections
+func validateSection(cfg IConfigSection, path string) []error {
+	errs := []error{}
+	wrap := func(err error) error {
+		if path == "" {
+			return err
+		}
+		return fmt.Errorf("[%s] %w", path, err)
+	}
+
+	// Validate the parameters
+	for _, param := range cfg.GetParameters() {
+		err := param.Validate()
+		if err != nil {
+			errs = append(errs, wrap(err))
+		}
+	}
+	for _, err := range validatePorts(cfg) {
+		errs = append(errs, wrap(err))
+	}
+	if sectionValidator, ok := cfg.(ISectionValidator); ok {
+		for _, err := range sectionValidator.ValidateSection() {
+			errs = append(errs, wrap(err))
+		}
+	}
+
+	// Validate the subconfigs in a stable order
+	subconfigs := cfg.GetSubconfigs()
+	names := make([]string, 0, len(subconfigs))
+	for name := range subconfigs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		subpath := name
+		if path != "" {
+			subpath = path + "." + name
+		}
+		errs = append(errs, validateSection(subconfigs[name], subpath)...)
+	}
+	return errs
+}
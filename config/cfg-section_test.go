@@ -0,0 +1,257 @@
+package config
+
+import (
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/node-manager-core/config/ids"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// A config with every client section, like the top-level config of a project built on this package
+type compositeConfig struct {
+	LocalExecution    *LocalExecutionConfig
+	ExternalExecution *ExternalExecutionConfig
+	LocalBeacon       *LocalBeaconConfig
+	ExternalBeacon    *ExternalBeaconConfig
+	Fallback          *FallbackConfig
+	ValidatorClient   *ValidatorClientCommonConfig
+	Lighthouse        *LighthouseVcConfig
+	Lodestar          *LodestarVcConfig
+	Nimbus            *NimbusVcConfig
+	Prysm             *PrysmVcConfig
+	Teku              *TekuVcConfig
+	Metrics           *MetricsConfig
+	MevBoost          *MevBoostConfig
+	Logging           *LoggerConfig
+}
+
+func newCompositeConfig(network Network) *compositeConfig {
+	cfg := &compositeConfig{
+		LocalExecution:    NewLocalExecutionConfig(),
+		ExternalExecution: NewExternalExecutionConfig(),
+		LocalBeacon:       NewLocalBeaconConfig(),
+		ExternalBeacon:    NewExternalBeaconConfig(),
+		Fallback:          NewFallbackConfig(),
+		ValidatorClient:   NewValidatorClientCommonConfig(),
+		Lighthouse:        NewLighthouseVcConfig(),
+		Lodestar:          NewLodestarVcConfig(),
+		Nimbus:            NewNimbusVcConfig(),
+		Prysm:             NewPrysmVcConfig(),
+		Teku:              NewTekuVcConfig(),
+		Metrics:           NewMetricsConfig(),
+		MevBoost:          NewMevBoostConfig(),
+		Logging:           NewLoggerConfig(),
+	}
+	ApplyDefaults(cfg, network)
+	return cfg
+}
+
+func (cfg *compositeConfig) GetTitle() string {
+	return "Composite"
+}
+
+func (cfg *compositeConfig) GetParameters() []IParameter {
+	return []IParameter{}
+}
+
+func (cfg *compositeConfig) GetSubconfigs() map[string]IConfigSection {
+	return map[string]IConfigSection{
+		"localExecution":    cfg.LocalExecution,
+		"externalExecution": cfg.ExternalExecution,
+		"localBeacon":       cfg.LocalBeacon,
+		"externalBeacon":    cfg.ExternalBeacon,
+		"fallback":          cfg.Fallback,
+		"validatorClient":   cfg.ValidatorClient,
+		"lighthouse":        cfg.Lighthouse,
+		"lodestar":          cfg.Lodestar,
+		"nimbus":            cfg.Nimbus,
+		"prysm":             cfg.Prysm,
+		"teku":              cfg.Teku,
+		"metrics":           cfg.Metrics,
+		"mevBoost":          cfg.MevBoost,
+		"logging":           cfg.Logging,
+	}
+}
+
+// Get a subsection of a serialized config
+func getSubmap(t *testing.T, data map[string]any, path ...string) map[string]any {
+	for _, name := range path {
+		submap, ok := data[name].(map[string]any)
+		if !ok {
+			t.Fatalf("expected [%s] to be a map, got %v", name, data[name])
+		}
+		data = submap
+	}
+	return data
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	// Change a parameter of each type away from its default
+	cfg := newCompositeConfig(Network_Mainnet)
+	cfg.LocalExecution.ExecutionClient.Value = ExecutionClient_Nethermind
+	cfg.LocalExecution.HttpPort.Value = 18545
+	cfg.LocalExecution.Geth.EvmTimeout.Value = 12
+	cfg.LocalExecution.Geth.ArchiveMode.Value = true
+	cfg.LocalExecution.Geth.AdditionalFlags.Value = "--verbosity 4"
+	cfg.LocalBeacon.BeaconNode.Value = BeaconNode_Teku
+	cfg.LocalBeacon.Lighthouse.MaxPeers.Value = 42
+	cfg.LocalBeacon.CheckpointSyncProvider.Value = "https://checkpoint.example.com"
+	cfg.Logging.Level.Value = slog.LevelWarn
+	cfg.Logging.Format.Value = log.LogFormat_Json
+	cfg.Logging.MaxBackups.Value = 9
+
+	serialized := Serialize(cfg)
+	if len(serialized) != len(cfg.GetSubconfigs()) {
+		t.Errorf("expected %d sections, got %d", len(cfg.GetSubconfigs()), len(serialized))
+	}
+
+	// Values are written as strings, keyed by parameter ID
+	geth := getSubmap(t, serialized, "localExecution", ids.LocalEcGethID)
+	if geth[ids.GethEvmTimeoutID] != "12" || geth[ids.GethArchiveModeID] != "true" {
+		t.Errorf("unexpected serialized Geth section: %v", geth)
+	}
+	logging := getSubmap(t, serialized, "logging")
+	if logging[ids.LoggerLevelID] != slog.LevelWarn.String() {
+		t.Errorf("expected the log level to be serialized as %s, got %v", slog.LevelWarn.String(), logging[ids.LoggerLevelID])
+	}
+
+	// Deserializing into a fresh config restores every value
+	loaded := newCompositeConfig(Network_Mainnet)
+	err := Deserialize(loaded, serialized, Network_Mainnet)
+	if err != nil {
+		t.Fatalf("error deserializing config: %v", err)
+	}
+	if !reflect.DeepEqual(Serialize(loaded), serialized) {
+		t.Errorf("expected the config to survive a round trip")
+	}
+	if loaded.LocalExecution.ExecutionClient.Value != ExecutionClient_Nethermind ||
+		loaded.LocalExecution.HttpPort.Value != 18545 ||
+		loaded.LocalExecution.Geth.EvmTimeout.Value != 12 ||
+		!loaded.LocalExecution.Geth.ArchiveMode.Value ||
+		loaded.LocalExecution.Geth.AdditionalFlags.Value != "--verbosity 4" ||
+		loaded.LocalBeacon.BeaconNode.Value != BeaconNode_Teku ||
+		loaded.LocalBeacon.Lighthouse.MaxPeers.Value != 42 ||
+		loaded.LocalBeacon.CheckpointSyncProvider.Value != "https://checkpoint.example.com" ||
+		loaded.Logging.Level.Value != slog.LevelWarn ||
+		loaded.Logging.Format.Value != log.LogFormat_Json ||
+		loaded.Logging.MaxBackups.Value != 9 {
+		t.Error("expected the modified values to be restored")
+	}
+	if changed := GetChangedParameters(loaded, Network_Mainnet); len(changed) != 11 {
+		t.Errorf("expected 11 changed parameters, got %d", len(changed))
+	}
+}
+
+func TestDeserializeMissingValues(t *testing.T) {
+	// Missing parameters and sections get the defaults for the selected network
+	serialized := Serialize(newCompositeConfig(Network_Mainnet))
+	delete(serialized, "localBeacon")
+	delete(getSubmap(t, serialized, "localExecution", ids.LocalEcGethID), ids.ContainerTagID)
+	getSubmap(t, serialized, "localExecution", ids.LocalEcErigonID)[ids.ContainerTagID] = "custom/erigon:latest"
+
+	loaded := newCompositeConfig(Network_Mainnet)
+	loaded.LocalBeacon.BeaconNode.Value = BeaconNode_Prysm
+	loaded.LocalExecution.Geth.ContainerTag.Value = "stale"
+	err := Deserialize(loaded, serialized, Network_Hoodi)
+	if err != nil {
+		t.Fatalf("error deserializing config: %v", err)
+	}
+	if loaded.LocalBeacon.BeaconNode.Value != loaded.LocalBeacon.BeaconNode.GetDefault(Network_Hoodi) {
+		t.Errorf("expected the missing Beacon section to get its defaults, got %s", loaded.LocalBeacon.BeaconNode.Value)
+	}
+	if loaded.LocalExecution.Geth.ContainerTag.Value != loaded.LocalExecution.Geth.ContainerTag.GetDefault(Network_Hoodi) {
+		t.Errorf("expected the missing Geth tag to get the Hoodi default, got %s", loaded.LocalExecution.Geth.ContainerTag.Value)
+	}
+	if loaded.LocalExecution.Erigon.ContainerTag.Value != "custom/erigon:latest" {
+		t.Errorf("expected the saved Erigon tag to be kept, got %s", loaded.LocalExecution.Erigon.ContainerTag.Value)
+	}
+}
+
+func TestDeserializeNativeTypes(t *testing.T) {
+	// YAML, TOML and JSON decoders produce native scalars instead of strings
+	serialized := Serialize(newCompositeConfig(Network_Mainnet))
+	geth := getSubmap(t, serialized, "localExecution", ids.LocalEcGethID)
+	geth[ids.GethEvmTimeoutID] = 30
+	geth[ids.GethArchiveModeID] = true
+	geth[ids.MaxPeersID] = float64(75)
+	getSubmap(t, serialized, "localExecution")[ids.HttpPortID] = uint64(28545)
+	getSubmap(t, serialized, "logging")[ids.LoggerMaxAgeID] = int64(14)
+
+	loaded := newCompositeConfig(Network_Mainnet)
+	err := Deserialize(loaded, serialized, Network_Mainnet)
+	if err != nil {
+		t.Fatalf("error deserializing config: %v", err)
+	}
+	if loaded.LocalExecution.Geth.EvmTimeout.Value != 30 {
+		t.Errorf("expected an EVM timeout of 30, got %d", loaded.LocalExecution.Geth.EvmTimeout.Value)
+	}
+	if !loaded.LocalExecution.Geth.ArchiveMode.Value {
+		t.Error("expected archive mode to be enabled")
+	}
+	if loaded.LocalExecution.Geth.MaxPeers.Value != 75 {
+		t.Errorf("expected 75 peers, got %d", loaded.LocalExecution.Geth.MaxPeers.Value)
+	}
+	if loaded.LocalExecution.HttpPort.Value != 28545 {
+		t.Errorf("expected HTTP port 28545, got %d", loaded.LocalExecution.HttpPort.Value)
+	}
+	if loaded.Logging.MaxAge.Value != 14 {
+		t.Errorf("expected a max log age of 14, got %d", loaded.Logging.MaxAge.Value)
+	}
+}
+
+func TestDeserializeErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(serialized map[string]any)
+		expectedErr string
+	}{
+		{
+			name: "invalid uint64",
+			modify: func(serialized map[string]any) {
+				serialized["localExecution"].(map[string]any)[ids.LocalEcGethID].(map[string]any)[ids.GethEvmTimeoutID] = "forever"
+			},
+			expectedErr: ids.GethEvmTimeoutID,
+		}, {
+			name: "uint16 out of range",
+			modify: func(serialized map[string]any) {
+				serialized["localExecution"].(map[string]any)[ids.HttpPortID] = "70000"
+			},
+			expectedErr: ids.HttpPortID,
+		}, {
+			name: "invalid bool",
+			modify: func(serialized map[string]any) {
+				serialized["localExecution"].(map[string]any)[ids.LocalEcGethID].(map[string]any)[ids.GethArchiveModeID] = "sometimes"
+			},
+			expectedErr: ids.GethArchiveModeID,
+		}, {
+			name: "unsupported value type",
+			modify: func(serialized map[string]any) {
+				serialized["logging"].(map[string]any)[ids.LoggerMaxSizeID] = []string{"20"}
+			},
+			expectedErr: ids.LoggerMaxSizeID,
+		}, {
+			name: "section that isn't a map",
+			modify: func(serialized map[string]any) {
+				serialized["localBeacon"] = "teku"
+			},
+			expectedErr: "localBeacon",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serialized := Serialize(newCompositeConfig(Network_Mainnet))
+			test.modify(serialized)
+			err := Deserialize(newCompositeConfig(Network_Mainnet), serialized, Network_Mainnet)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), test.expectedErr) {
+				t.Errorf("expected the error to name [%s], got [%v]", test.expectedErr, err)
+			}
+		})
+	}
+}
@@ -26,3 +26,18 @@ type ChangedSection struct {
 	// The list of subsections that may or may not have changes
 	Subsections []*ChangedSection
 }
+
+// A parameter whose value differs between two configs
+type ParameterDiff struct {
+	// The path of the section containing the parameter, made of the subconfig names separated by periods; blank for the root section
+	SectionPath string
+
+	// The ID of the parameter
+	ParameterID string
+
+	// The value of the parameter in the old config
+	OldValue any
+
+	// The value of the parameter in the new config
+	NewValue any
+}
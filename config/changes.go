@@ -1,5 +1,9 @@
 package config
 
+import (
+	"sort"
+)
+
 // Get all of the settings that have changed between the given config sections
 // Assumes the config sections represent the same element, just different instances
 func GetChangedSettings(old IConfigSection, new IConfigSection) (*ChangedSection, int) {
@@ -53,3 +57,53 @@ func GetAffectedContainers(section *ChangedSection, containers map[ContainerID]b
 		GetAffectedContainers(subsection, containers)
 	}
 }
+
+// Get the parameters that differ between two configs, sorted by section path and parameter ID.
+// Assumes the configs represent the same element, just different instances.
+func DiffConfigs(old IConfig, new IConfig) []ParameterDiff {
+	diffs := []ParameterDiff{}
+	diffSections(old, new, "", &diffs)
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].SectionPath != diffs[j].SectionPath {
+			return diffs[i].SectionPath < diffs[j].SectionPath
+		}
+		return diffs[i].ParameterID < diffs[j].ParameterID
+	})
+	return diffs
+}
+
+// Add the differences between two config sections to the provided list of diffs
+func diffSections(old IConfigSection, new IConfigSection, path string, diffs *[]ParameterDiff) {
+	// Go through the parameters
+	newParams := map[string]IParameter{}
+	for _, newParam := range new.GetParameters() {
+		newParams[newParam.GetCommon().ID] = newParam
+	}
+	for _, oldParam := range old.GetParameters() {
+		id := oldParam.GetCommon().ID
+		newParam, exists := newParams[id]
+		if !exists || oldParam.String() == newParam.String() {
+			continue
+		}
+		*diffs = append(*diffs, ParameterDiff{
+			SectionPath: path,
+			ParameterID: id,
+			OldValue:    oldParam.GetValueAsAny(),
+			NewValue:    newParam.GetValueAsAny(),
+		})
+	}
+
+	// Go through the subsections
+	newSubconfigs := new.GetSubconfigs()
+	for name, oldSubconfig := range old.GetSubconfigs() {
+		newSubconfig, exists := newSubconfigs[name]
+		if !exists {
+			continue
+		}
+		subpath := name
+		if path != "" {
+			subpath = path + "." + name
+		}
+		diffSections(oldSubconfig, newSubconfig, subpath, diffs)
+	}
+}
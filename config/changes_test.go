@@ -0,0 +1,87 @@
+package config
+
+import (
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+func TestDiffConfigsIdentical(t *testing.T) {
+	old := newTomlTestConfig(t, Network_Mainnet)
+	new := newTomlTestConfig(t, Network_Mainnet)
+	if diffs := DiffConfigs(old, new); len(diffs) != 0 {
+		t.Errorf("expected no differences between identical configs, got %v", diffs)
+	}
+
+	// A config compared to itself has no differences either
+	old.LocalExecution.HttpPort.Value = 18545
+	if diffs := DiffConfigs(old, old); len(diffs) != 0 {
+		t.Errorf("expected no differences between a config and itself, got %v", diffs)
+	}
+}
+
+func TestDiffConfigsDiverging(t *testing.T) {
+	old := newTomlTestConfig(t, Network_Mainnet)
+	new := newTomlTestConfig(t, Network_Mainnet)
+	new.LocalExecution.HttpPort.Value = 18545
+	new.LocalExecution.Geth.ArchiveMode.Value = true
+	new.Logging.Level.Value = slog.LevelDebug
+	new.MevBoost.Enable.Value = !old.MevBoost.Enable.Value
+
+	// Diffs are sorted by section path, then by parameter ID
+	expected := []ParameterDiff{
+		{
+			SectionPath: "localExecution",
+			ParameterID: old.LocalExecution.HttpPort.ID,
+			OldValue:    old.LocalExecution.HttpPort.Value,
+			NewValue:    uint16(18545),
+		}, {
+			SectionPath: "localExecution." + ids.LocalEcGethID,
+			ParameterID: old.LocalExecution.Geth.ArchiveMode.ID,
+			OldValue:    false,
+			NewValue:    true,
+		}, {
+			SectionPath: "logging",
+			ParameterID: old.Logging.Level.ID,
+			OldValue:    old.Logging.Level.Value,
+			NewValue:    slog.LevelDebug,
+		}, {
+			SectionPath: "mevBoost",
+			ParameterID: old.MevBoost.Enable.ID,
+			OldValue:    old.MevBoost.Enable.Value,
+			NewValue:    new.MevBoost.Enable.Value,
+		},
+	}
+	diffs := DiffConfigs(old, new)
+	if !reflect.DeepEqual(diffs, expected) {
+		t.Errorf("unexpected diffs:\nexpected %+v\ngot      %+v", expected, diffs)
+	}
+
+	// Swapping the configs swaps the old and new values
+	reversed := DiffConfigs(new, old)
+	if len(reversed) != len(expected) {
+		t.Fatalf("expected %d reversed diffs, got %d", len(expected), len(reversed))
+	}
+	for i, diff := range reversed {
+		if diff.OldValue != expected[i].NewValue || diff.NewValue != expected[i].OldValue {
+			t.Errorf("expected reversed diff %d to swap its values, got %+v", i, diff)
+		}
+	}
+}
+
+func TestDiffConfigsAcrossNetworks(t *testing.T) {
+	// Parameters with per-network defaults differ between networks even if nothing was changed
+	old := newTomlTestConfig(t, Network_Mainnet)
+	new := newTomlTestConfig(t, Network_Holesky)
+	diffs := DiffConfigs(old, new)
+	if len(diffs) == 0 {
+		t.Fatal("expected differences between the mainnet and Holesky defaults")
+	}
+	for _, diff := range diffs {
+		if diff.OldValue == diff.NewValue {
+			t.Errorf("expected %s.%s to have different values, got %v for both", diff.SectionPath, diff.ParameterID, diff.OldValue)
+		}
+	}
+}
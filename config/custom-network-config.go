@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+// Configuration for a custom network, such as an ephemeral devnet, whose resources are loaded from a file
+type CustomNetworkConfig struct {
+	// The path of the network resources file to load when the custom network is selected
+	ResourcesFile Parameter[string]
+}
+
+// Generates a new CustomNetworkConfig configuration
+func NewCustomNetworkConfig() *CustomNetworkConfig {
+	return &CustomNetworkConfig{
+		ResourcesFile: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.CustomNetworkResourcesFileID,
+				Name:               "Network Resources File",
+				Description:        "The path of a JSON or YAML file describing the custom network, including its chain ID, genesis fork version, and multicall address. Only used when the custom network is selected.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon, ContainerID_ExecutionClient, ContainerID_BeaconNode, ContainerID_ValidatorClient},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Validator: validateResourcesFile,
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+	}
+}
+
+// Create a parameter option for selecting the custom network, for configs that let the user choose their network
+func NewCustomNetworkOption() *ParameterOption[Network] {
+	return &ParameterOption[Network]{
+		ParameterOptionCommon: &ParameterOptionCommon{
+			Name:        "Custom",
+			Description: "Use a custom network, such as a devnet, described by a network resources file.",
+		},
+		Value: Network_Custom,
+	}
+}
+
+// The title for the config
+func (cfg *CustomNetworkConfig) GetTitle() string {
+	return "Custom Network"
+}
+
+// Get the Parameters for this config
+func (cfg *CustomNetworkConfig) GetParameters() []IParameter {
+	return []IParameter{
+		&cfg.ResourcesFile,
+	}
+}
+
+// Get the sections underneath this one
+func (cfg *CustomNetworkConfig) GetSubconfigs() map[string]IConfigSection {
+	return map[string]IConfigSection{}
+}
+
+// Load the resources file and register its resources with RegisterCustomResourcesFromFile. They're also registered as
+// Network_Custom, so NewResources works with either name. The returned resources are the ones for Network_Custom.
+func (cfg *CustomNetworkConfig) LoadResources() (*NetworkResources, error) {
+	path := cfg.ResourcesFile.Value
+	if path == "" {
+		return nil, fmt.Errorf("parameter [%s] must be set to use the custom network", ids.CustomNetworkResourcesFileID)
+	}
+	resources, err := RegisterCustomResourcesFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	err = RegisterCustomResources(Network_Custom, resources)
+	if err != nil {
+		return nil, fmt.Errorf("error registering network resources from [%s]: %w", path, err)
+	}
+	return NewResources(Network_Custom)
+}
+
+// Check that the resources file can be loaded, if one is set
+func validateResourcesFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	_, err := LoadResourcesFromFile(path)
+	return err
+}
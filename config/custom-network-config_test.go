@@ -0,0 +1,128 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+const testResourcesFile string = `network: kurtosis-devnet
+chainId: 3151908
+genesisForkVersion: "0x10000038"
+multicallAddress: "0xcA11bde05977b3631167028862bE2a173976CA11"
+`
+
+// Write a network resources file to a temporary directory and remove any resources registered by the test afterwards
+func writeResourcesFile(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "network.yml")
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("error writing resources file: %v", err)
+	}
+	t.Cleanup(func() {
+		customResourcesLock.Lock()
+		defer customResourcesLock.Unlock()
+		delete(customResources, Network("kurtosis-devnet"))
+		delete(customResources, Network_Custom)
+	})
+	return path
+}
+
+func TestCustomNetworkLoadResources(t *testing.T) {
+	cfg := NewCustomNetworkConfig()
+	cfg.ResourcesFile.Value = writeResourcesFile(t, testResourcesFile)
+
+	resources, err := cfg.LoadResources()
+	if err != nil {
+		t.Fatalf("error loading resources: %v", err)
+	}
+	if resources.Network != Network_Custom || resources.EthNetworkName != "kurtosis-devnet" || resources.ChainID != 3151908 {
+		t.Errorf("unexpected resources %+v", resources)
+	}
+	if resources.MulticallAddress != common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11") {
+		t.Errorf("unexpected multicall address %s", resources.MulticallAddress.Hex())
+	}
+
+	// The resources are registered under both names
+	for _, network := range []Network{Network_Custom, "kurtosis-devnet"} {
+		registered, err := NewResources(network)
+		if err != nil {
+			t.Errorf("expected resources for network %s to be registered: %v", network, err)
+			continue
+		}
+		if registered.ChainID != 3151908 {
+			t.Errorf("expected network %s to have chain ID 3151908, got %d", network, registered.ChainID)
+		}
+	}
+}
+
+func TestCustomNetworkLoadResourcesErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		contents      string
+		noFile        bool
+		expectedError string
+	}{
+		{
+			name:          "no file set",
+			noFile:        true,
+			expectedError: ids.CustomNetworkResourcesFileID,
+		}, {
+			name:          "missing field",
+			contents:      "network: kurtosis-devnet\nchainId: 3151908\nmulticallAddress: \"0xcA11bde05977b3631167028862bE2a173976CA11\"\n",
+			expectedError: "genesisForkVersion",
+		}, {
+			name:          "malformed field",
+			contents:      strings.Replace(testResourcesFile, "0xcA11bde05977b3631167028862bE2a173976CA11", "0x1234", 1),
+			expectedError: "multicallAddress",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := NewCustomNetworkConfig()
+			if !test.noFile {
+				cfg.ResourcesFile.Value = writeResourcesFile(t, test.contents)
+			}
+			_, err := cfg.LoadResources()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !strings.Contains(err.Error(), test.expectedError) {
+				t.Errorf("expected the error to name %s, got: %v", test.expectedError, err)
+			}
+			if _, err := NewResources(Network_Custom); err == nil {
+				t.Error("expected the custom network not to be registered")
+			}
+		})
+	}
+}
+
+func TestCustomNetworkValidate(t *testing.T) {
+	cfg := NewCustomNetworkConfig()
+	cfg.ResourcesFile.Value = ""
+	if err := Validate(cfg); err != nil {
+		t.Errorf("expected a blank resources file to be valid: %v", err)
+	}
+
+	cfg.ResourcesFile.Value = writeResourcesFile(t, testResourcesFile)
+	if err := Validate(cfg); err != nil {
+		t.Errorf("expected a valid resources file to be valid: %v", err)
+	}
+
+	cfg.ResourcesFile.Value = filepath.Join(t.TempDir(), "missing.yml")
+	err := Validate(cfg)
+	if err == nil || !strings.Contains(err.Error(), ids.CustomNetworkResourcesFileID) {
+		t.Errorf("expected an error naming the parameter for a missing file, got %v", err)
+	}
+}
+
+func TestCustomNetworkOption(t *testing.T) {
+	option := NewCustomNetworkOption()
+	if option.Value != Network_Custom || option.Name == "" {
+		t.Errorf("unexpected option %+v", option)
+	}
+}
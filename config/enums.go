@@ -2,7 +2,8 @@ package config
 
 import "fmt"
 
-// The network that this installation is configured to run on
+// The network that this installation is configured to run on.
+// This can be any string; networks other than the ones below are considered custom networks.
 type Network string
 
 // Enum to describe the various network values
@@ -16,8 +17,17 @@ const (
 	// The Holesky test network
 	Network_Holesky Network = "holesky"
 
+	// The Sepolia test network
+	Network_Sepolia Network = "sepolia"
+
+	// The Hoodi test network
+	Network_Hoodi Network = "hoodi"
+
 	// The Ethereum mainnet
 	Network_Mainnet Network = "mainnet"
+
+	// A custom network, such as an ephemeral devnet, whose resources are loaded from a network resources file
+	Network_Custom Network = "custom"
 )
 
 // A Docker container name
@@ -72,6 +82,9 @@ const (
 
 	// Reth
 	ExecutionClient_Reth ExecutionClient = "reth"
+
+	// Erigon
+	ExecutionClient_Erigon ExecutionClient = "erigon"
 )
 
 // A Beacon Node (Beacon Node)
@@ -82,6 +95,9 @@ const (
 	// Unknown
 	BeaconNode_Unknown BeaconNode = ""
 
+	// Grandine
+	BeaconNode_Grandine BeaconNode = "grandine"
+
 	// Lighthouse
 	BeaconNode_Lighthouse BeaconNode = "lighthouse"
 
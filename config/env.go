@@ -0,0 +1,95 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// The prefix for the environment variables generated by ApplyEnvOverrides
+	EnvVarPrefix string = "NMC"
+)
+
+// Override the config's parameters with any matching environment variables that have been set.
+// Each parameter's environment variable is named after its EnvVarName if provided, otherwise it's generated from the
+// section path and parameter ID (e.g., NMC_GETH_MAXPEERS). All of the parsing errors are returned together.
+func ApplyEnvOverrides(cfg IConfig) error {
+	network := Network_Unknown
+	resources := cfg.GetNetworkResources()
+	if resources != nil {
+		network = resources.Network
+	}
+	return applyEnvOverrides(cfg, EnvVarPrefix, network)
+}
+
+// Get the name of the environment variable that can override a parameter, based on its section's prefix
+func GetEnvVarName(param IParameter, sectionPrefix string) string {
+	common := param.GetCommon()
+	if common.EnvVarName != "" {
+		return common.EnvVarName
+	}
+	return sectionPrefix + "_" + sanitizeEnvVarName(common.ID)
+}
+
+// Apply the environment variable overrides for a config section and its subconfigs
+func applyEnvOverrides(cfg IConfigSection, prefix string, network Network) error {
+	errs := []error{}
+
+	// Handle the parameters
+	for _, param := range cfg.GetParameters() {
+		name := GetEnvVarName(param, prefix)
+		value, exists := os.LookupEnv(name)
+		if !exists {
+			continue
+		}
+		err := applyEnvValue(param, value, network)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error applying environment variable [%s]: %w", name, err))
+		}
+	}
+
+	// Handle the subconfigs
+	for name, subconfig := range cfg.GetSubconfigs() {
+		err := applyEnvOverrides(subconfig, prefix+"_"+sanitizeEnvVarName(name), network)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Set a parameter's value from an environment variable
+func applyEnvValue(param IParameter, value string, network Network) error {
+	// Choice parameters would silently revert to the default on an unknown value, so check it explicitly
+	options := param.GetOptions()
+	if len(options) > 0 {
+		isValid := false
+		for _, option := range options {
+			if option.String() == value {
+				isValid = true
+				break
+			}
+		}
+		if !isValid {
+			return fmt.Errorf("value [%s] is not one of the options for parameter [%s]", value, param.GetCommon().ID)
+		}
+	}
+	return param.Deserialize(value, network)
+}
+
+// Convert a parameter ID or section name into the format used by environment variables
+func sanitizeEnvVarName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
@@ -0,0 +1,189 @@
+package config
+
+import (
+	"runtime"
+
+	"github.com/pbnjay/memory"
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+// Constants
+const (
+	// Tags
+	erigonTagProd string = "erigontech/erigon:v3.0.0"
+	erigonTagTest string = "erigontech/erigon:v3.0.0"
+)
+
+// Erigon's prune mode
+type Erigon_PruneMode string
+
+const (
+	Erigon_PruneMode_Full    Erigon_PruneMode = "full"
+	Erigon_PruneMode_Archive Erigon_PruneMode = "archive"
+	Erigon_PruneMode_Minimal Erigon_PruneMode = "minimal"
+)
+
+// Configuration for Erigon
+type ErigonConfig struct {
+	// Max number of P2P peers to connect to
+	MaxPeers Parameter[uint16]
+
+	// The prune mode to use
+	PruneMode Parameter[Erigon_PruneMode]
+
+	// Size of Erigon's database page cache
+	DbPageCacheSize Parameter[uint64]
+
+	// The Docker Hub tag for Erigon
+	ContainerTag Parameter[string]
+
+	// Custom command line flags
+	AdditionalFlags Parameter[string]
+}
+
+// Generates a new Erigon configuration
+func NewErigonConfig() *ErigonConfig {
+	return &ErigonConfig{
+		MaxPeers: Parameter[uint16]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.MaxPeersID,
+				Name:               "Max Peers",
+				Description:        getPeerCountDescription("The maximum number of peers Erigon should connect to. This can be lowered to improve performance on low-power systems or constrained networks. We recommend keeping it at 12 or higher."),
+				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint16{
+				Network_All: calculateErigonPeers(),
+			},
+		},
+
+		PruneMode: Parameter[Erigon_PruneMode]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.ErigonPruneModeID,
+				Name:               "Prune Mode",
+				Description:        "Choose how much historical data Erigon will keep. Highlight each option to learn more about it.",
+				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Options: []*ParameterOption[Erigon_PruneMode]{
+				{
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Full",
+						Description: "Erigon will keep all of the blocks, but only the recent state. This is the standard mode for a full node.",
+					},
+					Value: Erigon_PruneMode_Full,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Archive",
+						Description: "Erigon will keep all of the blocks and the state of the chain for every block. This lets you access the state of any historical block, but uses significantly more disk space.",
+					},
+					Value: Erigon_PruneMode_Archive,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Minimal",
+						Description: "Erigon will only keep the most recent blocks and state. This uses the least disk space, but your node won't be able to serve historical data to other nodes or applications.",
+					},
+					Value: Erigon_PruneMode_Minimal,
+				},
+			},
+			Default: map[Network]Erigon_PruneMode{
+				Network_All: Erigon_PruneMode_Full,
+			},
+		},
+
+		DbPageCacheSize: Parameter[uint64]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.ErigonDbPageCacheSizeID,
+				Name:               "Database Page Cache Size",
+				Description:        "The amount of RAM (in MB) you want Erigon to use for its database page cache. Larger values will improve performance, but will use more RAM. The default is based on how much total RAM your system has but you can adjust it manually.",
+				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint64{
+				Network_All: calculateErigonDbPageCacheSize(),
+			},
+		},
+
+		ContainerTag: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.ContainerTagID,
+				Name:               "Container Tag",
+				Description:        "The tag name of the Erigon container you want to use on Docker Hub.",
+				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: true,
+			},
+			Validator: ValidateDockerTag,
+			Default: map[Network]string{
+				Network_Mainnet: erigonTagProd,
+				Network_Holesky: erigonTagTest,
+				Network_All:     erigonTagTest,
+			},
+		},
+
+		AdditionalFlags: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.AdditionalFlagsID,
+				Name:               "Additional Flags",
+				Description:        "Additional custom command line flags you want to pass to Erigon, to take advantage of other settings that aren't covered here.",
+				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+	}
+}
+
+// Get the title for the config
+func (cfg *ErigonConfig) GetTitle() string {
+	return "Erigon"
+}
+
+// Get the parameters for this config
+func (cfg *ErigonConfig) GetParameters() []IParameter {
+	return []IParameter{
+		&cfg.MaxPeers,
+		&cfg.PruneMode,
+		&cfg.DbPageCacheSize,
+		&cfg.ContainerTag,
+		&cfg.AdditionalFlags,
+	}
+}
+
+// Get the sections underneath this one
+func (cfg *ErigonConfig) GetSubconfigs() map[string]IConfigSection {
+	return map[string]IConfigSection{}
+}
+
+// Calculate the recommended size for Erigon's database page cache based on the amount of system RAM
+func calculateErigonDbPageCacheSize() uint64 {
+	totalMemoryGB := memory.TotalMemory() / 1024 / 1024 / 1024
+
+	if totalMemoryGB == 0 {
+		return 0
+	} else if totalMemoryGB < 9 {
+		return 512
+	} else if totalMemoryGB < 17 {
+		return 2048
+	} else if totalMemoryGB < 33 {
+		return 4096
+	} else {
+		return 8192
+	}
+}
+
+// Calculate the default number of Erigon peers
+func calculateErigonPeers() uint16 {
+	switch getPeerCountTier(runtime.GOARCH) {
+	case peerCountTier_Standard:
+		return 50
+	default:
+		return 25
+	}
+}
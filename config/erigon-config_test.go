@@ -0,0 +1,67 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestErigonDefaults(t *testing.T) {
+	tests := []struct {
+		network     Network
+		expectedTag string
+	}{
+		{network: Network_Mainnet, expectedTag: erigonTagProd},
+		{network: Network_Holesky, expectedTag: erigonTagTest},
+		{network: Network_Hoodi, expectedTag: erigonTagTest},
+		{network: Network_Sepolia, expectedTag: erigonTagTest},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.network), func(t *testing.T) {
+			cfg := NewErigonConfig()
+			if tag := cfg.ContainerTag.GetDefault(test.network); tag != test.expectedTag {
+				t.Errorf("expected tag %s, got %s", test.expectedTag, tag)
+			}
+			if mode := cfg.PruneMode.GetDefault(test.network); mode != Erigon_PruneMode_Full {
+				t.Errorf("expected prune mode %s, got %s", Erigon_PruneMode_Full, mode)
+			}
+			if peers := cfg.MaxPeers.GetDefault(test.network); peers != calculateErigonPeers() {
+				t.Errorf("expected %d peers, got %d", calculateErigonPeers(), peers)
+			}
+			if cacheSize := cfg.DbPageCacheSize.GetDefault(test.network); cacheSize != calculateErigonDbPageCacheSize() {
+				t.Errorf("expected a page cache size of %d, got %d", calculateErigonDbPageCacheSize(), cacheSize)
+			}
+			if flags := cfg.AdditionalFlags.GetDefault(test.network); flags != "" {
+				t.Errorf("expected no additional flags, got [%s]", flags)
+			}
+
+			// The prune modes are only valid for Erigon 3
+			if !strings.HasPrefix(cfg.ContainerTag.GetDefault(test.network), "erigontech/erigon:v3.") {
+				t.Errorf("expected an Erigon 3 tag for the full/archive/minimal prune modes, got %s", cfg.ContainerTag.GetDefault(test.network))
+			}
+		})
+	}
+}
+
+func TestErigonPruneModeOptions(t *testing.T) {
+	cfg := NewErigonConfig()
+	expected := []Erigon_PruneMode{Erigon_PruneMode_Full, Erigon_PruneMode_Archive, Erigon_PruneMode_Minimal}
+	if len(cfg.PruneMode.Options) != len(expected) {
+		t.Fatalf("expected %d prune mode options, got %d", len(expected), len(cfg.PruneMode.Options))
+	}
+	for i, option := range cfg.PruneMode.Options {
+		if option.Value != expected[i] {
+			t.Errorf("expected option %d to be %s, got %s", i, expected[i], option.Value)
+		}
+	}
+}
+
+func TestErigonPageCacheSizeTiers(t *testing.T) {
+	// The default depends on the system's RAM, but is always one of the tiers
+	size := calculateErigonDbPageCacheSize()
+	switch size {
+	case 0, 512, 2048, 4096, 8192:
+	default:
+		t.Errorf("unexpected page cache size %d", size)
+	}
+}
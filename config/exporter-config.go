@@ -47,6 +47,7 @@ func NewExporterConfig() *ExporterConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_All: exporterTag,
 			},
@@ -30,6 +30,12 @@ func NewExternalBeaconConfig() *ExternalBeaconConfig {
 			},
 			Options: []*ParameterOption[BeaconNode]{
 				{
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Grandine",
+						Description: "Select if your external client is Grandine.",
+					},
+					Value: BeaconNode_Grandine,
+				}, {
 					ParameterOptionCommon: &ParameterOptionCommon{
 						Name:        "Lighthouse",
 						Description: "Select if your external client is Lighthouse.",
@@ -74,6 +80,7 @@ func NewExternalBeaconConfig() *ExternalBeaconConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
+			Validator: ValidateUrl,
 			Default: map[Network]string{
 				Network_All: "",
 			},
@@ -88,6 +95,7 @@ func NewExternalBeaconConfig() *ExternalBeaconConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
+			Validator: ValidateGrpcAddress,
 			Default: map[Network]string{
 				Network_All: "",
 			},
@@ -113,3 +121,14 @@ func (cfg *ExternalBeaconConfig) GetParameters() []IParameter {
 func (cfg *ExternalBeaconConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{}
 }
+
+// Get the normalized HTTP URL of the external client and its Prysm gRPC URL, with surrounding whitespace and trailing
+// slashes removed. The Prysm URL is blank unless the selected client is Prysm. IConfig implementations can use the HTTP
+// URL for GetBeaconNodeUrls.
+func (cfg *ExternalBeaconConfig) GetUrls() (string, string) {
+	prysmRpcUrl := ""
+	if cfg.BeaconNode.Value == BeaconNode_Prysm {
+		prysmRpcUrl = normalizeUrl(cfg.PrysmRpcUrl.Value)
+	}
+	return normalizeUrl(cfg.HttpUrl.Value), prysmRpcUrl
+}
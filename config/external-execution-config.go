@@ -53,6 +53,12 @@ func NewExternalExecutionConfig() *ExternalExecutionConfig {
 						Description: "Select if your external client is Reth.",
 					},
 					Value: ExecutionClient_Reth,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Erigon",
+						Description: "Select if your external client is Erigon.",
+					},
+					Value: ExecutionClient_Erigon,
 				}},
 			Default: map[Network]ExecutionClient{
 				Network_All: ExecutionClient_Geth},
@@ -67,6 +73,7 @@ func NewExternalExecutionConfig() *ExternalExecutionConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
+			Validator: ValidateUrl,
 			Default: map[Network]string{
 				Network_All: "",
 			},
@@ -76,11 +83,12 @@ func NewExternalExecutionConfig() *ExternalExecutionConfig {
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.ExternalEcWebsocketUrlID,
 				Name:               "Websocket URL",
-				Description:        "The URL of the Websocket RPC endpoint for your external Execution client.\nNOTE: If you are running it on the same machine as this node, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead, for example 'http://192.168.1.100:8545'.",
-				AffectsContainers:  []ContainerID{},
+				Description:        "The URL of the Websocket RPC endpoint for your external Execution client.\nNOTE: If you are running it on the same machine as this node, addresses like `localhost` and `127.0.0.1` will not work due to Docker limitations. Enter your machine's LAN IP address instead, for example 'ws://192.168.1.100:8546'.",
+				AffectsContainers:  []ContainerID{ContainerID_Daemon},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
+			Validator: ValidateUrl,
 			Default: map[Network]string{
 				Network_All: "",
 			},
@@ -106,3 +114,9 @@ func (cfg *ExternalExecutionConfig) GetParameters() []IParameter {
 func (cfg *ExternalExecutionConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{}
 }
+
+// Get the normalized HTTP and Websocket URLs of the external client, with surrounding whitespace and trailing slashes
+// removed. IConfig implementations can use the HTTP URL for GetExecutionClientUrls.
+func (cfg *ExternalExecutionConfig) GetUrls() (string, string) {
+	return normalizeUrl(cfg.HttpUrl.Value), normalizeUrl(cfg.WebsocketUrl.Value)
+}
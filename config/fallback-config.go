@@ -43,6 +43,7 @@ func NewFallbackConfig() *FallbackConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
+			Validator: ValidateUrl,
 			Default: map[Network]string{
 				Network_All: "",
 			},
@@ -57,6 +58,7 @@ func NewFallbackConfig() *FallbackConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
+			Validator: ValidateUrl,
 			Default: map[Network]string{
 				Network_All: "",
 			},
@@ -71,6 +73,7 @@ func NewFallbackConfig() *FallbackConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
 			},
+			Validator: ValidateGrpcAddress,
 			Default: map[Network]string{
 				Network_All: "",
 			},
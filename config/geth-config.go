@@ -1,7 +1,6 @@
 package config
 
 import (
-	"fmt"
 	"runtime"
 
 	"github.com/rocket-pool/node-manager-core/config/ids"
@@ -39,7 +38,7 @@ func NewGethConfig() *GethConfig {
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.MaxPeersID,
 				Name:               "Max Peers",
-				Description:        "The maximum number of peers Geth should connect to. This can be lowered to improve performance on low-power systems or constrained Networks. We recommend keeping it at 12 or higher.",
+				Description:        getPeerCountDescription("The maximum number of peers Geth should connect to. This can be lowered to improve performance on low-power systems or constrained Networks. We recommend keeping it at 12 or higher."),
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -86,9 +85,10 @@ func NewGethConfig() *GethConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: gethTagProd,
-				Network_Holesky: gethTagTest,
+				Network_All:     gethTagTest,
 			},
 		},
 
@@ -131,12 +131,10 @@ func (cfg *GethConfig) GetSubconfigs() map[string]IConfigSection {
 
 // Calculate the default number of Geth peers
 func calculateGethPeers() uint16 {
-	switch runtime.GOARCH {
-	case "arm64":
-		return 25
-	case "amd64":
+	switch getPeerCountTier(runtime.GOARCH) {
+	case peerCountTier_Standard:
 		return 50
 	default:
-		panic(fmt.Sprintf("unsupported architecture %s", runtime.GOARCH))
+		return 25
 	}
 }
@@ -45,6 +45,7 @@ func NewGrafanaConfig() *GrafanaConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_All: grafanaTag,
 			},
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+const (
+	// Tags
+	grandineBnTagProd string = "sifrai/grandine:1.0.0"
+	grandineBnTagTest string = "sifrai/grandine:1.0.0"
+)
+
+// Configuration for the Grandine BN
+type GrandineBnConfig struct {
+	// The port to use for gossip traffic using the QUIC protocol
+	P2pQuicPort Parameter[uint16]
+
+	// The max number of P2P peers to connect to
+	MaxPeers Parameter[uint16]
+
+	// The Docker Hub tag for Grandine BN
+	ContainerTag Parameter[string]
+
+	// Custom command line flags for the BN
+	AdditionalFlags Parameter[string]
+}
+
+// Generates a new Grandine BN configuration
+func NewGrandineBnConfig() *GrandineBnConfig {
+	return &GrandineBnConfig{
+		P2pQuicPort: Parameter[uint16]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.GrandineQuicPortID,
+				Name:               "P2P QUIC Port",
+				Description:        "The port to use for P2P (blockchain) traffic using the QUIC protocol.",
+				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint16{
+				Network_All: 8001,
+			},
+		},
+
+		MaxPeers: Parameter[uint16]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.MaxPeersID,
+				Name:               "Max Peers",
+				Description:        "The maximum number of peers your client should try to maintain. You can try lowering this if you have a low-resource system or a constrained network.",
+				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint16{
+				Network_All: 100,
+			},
+		},
+
+		ContainerTag: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.ContainerTagID,
+				Name:               "Container Tag",
+				Description:        "The tag name of the Grandine container from Docker Hub you want to use for the Beacon Node.",
+				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: true,
+			},
+			Validator: ValidateDockerTag,
+			Default: map[Network]string{
+				Network_Mainnet: grandineBnTagProd,
+				Network_Holesky: grandineBnTagTest,
+				Network_All:     grandineBnTagTest,
+			},
+		},
+
+		AdditionalFlags: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.AdditionalFlagsID,
+				Name:               "Additional Flags",
+				Description:        "Additional custom command line flags you want to pass Grandine's Beacon Node, to take advantage of other settings that aren't covered here.",
+				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+	}
+}
+
+// The title for the config
+func (cfg *GrandineBnConfig) GetTitle() string {
+	return "Grandine Beacon Node"
+}
+
+// Get the parameters for this config
+func (cfg *GrandineBnConfig) GetParameters() []IParameter {
+	return []IParameter{
+		&cfg.MaxPeers,
+		&cfg.P2pQuicPort,
+		&cfg.ContainerTag,
+		&cfg.AdditionalFlags,
+	}
+}
+
+// Get the sections underneath this one
+func (cfg *GrandineBnConfig) GetSubconfigs() map[string]IConfigSection {
+	return map[string]IConfigSection{}
+}
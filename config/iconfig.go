@@ -21,7 +21,9 @@ type IConfig interface {
 	// The path to use for the wallet keystore's password file
 	GetPasswordFilePath() string
 
-	// The resources for the selected network
+	// The resources for the selected network.
+	// The network name can be any string, so implementations should use NewCustomResources for networks that aren't built in.
+	// If Network_Custom is selected, implementations can use CustomNetworkConfig.LoadResources to load them from a file.
 	GetNetworkResources() *NetworkResources
 
 	// The URLs for the Execution clients to use
@@ -14,6 +14,7 @@ const (
 	EcID                    string = "executionClient"
 	BnID                    string = "beaconNode"
 	GraffitiID              string = "graffiti"
+	FeeRecipientID          string = "feeRecipient"
 	DoppelgangerDetectionID string = "doppelgangerDetection"
 	MetricsPortID           string = "metricsPort"
 	CacheSizeID             string = "cacheSize"
@@ -21,6 +22,7 @@ const (
 	// Logger
 	LoggerLevelID      string = "level"
 	LoggerFormatID     string = "format"
+	LoggerOutputID     string = "output"
 	LoggerAddSourceID  string = "addSource"
 	LoggerMaxSizeID    string = "maxSize"
 	LoggerMaxBackupsID string = "maxBackups"
@@ -38,6 +40,13 @@ const (
 	BitflyEndpointID    string = "bitflyEndpoint"
 	BitflyMachineNameID string = "bitflyMachineName"
 
+	// Custom Network
+	CustomNetworkResourcesFileID string = "resourcesFile"
+
+	// Erigon
+	ErigonPruneModeID       string = "pruneMode"
+	ErigonDbPageCacheSizeID string = "dbPageCacheSize"
+
 	// Exporter
 	ExporterEnableRootFsID string = "enableRootFs"
 
@@ -53,11 +62,15 @@ const (
 	GethEvmTimeoutID  string = "evmTimeout"
 	GethArchiveModeID string = "archiveMode"
 
+	// Grandine
+	GrandineQuicPortID string = "p2pQuicPort"
+
 	// Lighthouse
 	LighthouseQuicPortID string = "p2pQuicPort"
 
 	// Local Beacon Node
 	LocalBnCheckpointSyncUrlID string = "checkpointSyncUrl"
+	LocalBnGrandineID          string = "grandine"
 	LocalBnLighthouseID        string = "lighthouse"
 	LocalBnLodestarID          string = "lodestar"
 	LocalBnNimbusID            string = "nimbus"
@@ -72,6 +85,7 @@ const (
 	LocalEcGethID          string = "geth"
 	LocalEcNethermindID    string = "nethermind"
 	LocalEcRethID          string = "reth"
+	LocalEcErigonID        string = "erigon"
 
 	// Metrics
 	MetricsEnableID       string = "enableMetrics"
@@ -85,6 +99,17 @@ const (
 	MetricsExporterID     string = "exporter"
 	MetricsBitflyID       string = "bitfly"
 
+	// MEV-Boost
+	MevBoostEnableID             string = "enableMevBoost"
+	MevBoostFlashbotsID          string = "flashbotsEnabled"
+	MevBoostBloxrouteMaxProfitID string = "bloxrouteMaxProfitEnabled"
+	MevBoostBloxrouteRegulatedID string = "bloxrouteRegulatedEnabled"
+	MevBoostUltrasoundID         string = "ultrasoundEnabled"
+	MevBoostAestusID             string = "aestusEnabled"
+	MevBoostTitanGlobalID        string = "titanGlobalEnabled"
+	MevBoostTitanRegionalID      string = "titanRegionalEnabled"
+	MevBoostCustomRelaysID       string = "customRelays"
+
 	// Nethermind
 	NethermindPruneMemSizeID           string = "pruneMemSize"
 	NethermindAdditionalModulesID      string = "additionalModules"
@@ -65,9 +65,10 @@ func NewLighthouseBnConfig() *LighthouseBnConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: lighthouseBnTagProd,
-				Network_Holesky: lighthouseBnTagTest,
+				Network_All:     lighthouseBnTagTest,
 			},
 		},
 
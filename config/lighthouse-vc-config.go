@@ -31,9 +31,10 @@ func NewLighthouseVcConfig() *LighthouseVcConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: lighthouseVcTagProd,
-				Network_Holesky: lighthouseVcTagTest,
+				Network_All:     lighthouseVcTagTest,
 			},
 		},
 
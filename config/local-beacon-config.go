@@ -24,6 +24,7 @@ type LocalBeaconConfig struct {
 	OpenHttpPort Parameter[RpcPortMode]
 
 	// Subconfigs
+	Grandine   *GrandineBnConfig
 	Lighthouse *LighthouseBnConfig
 	Lodestar   *LodestarBnConfig
 	Nimbus     *NimbusBnConfig
@@ -45,6 +46,12 @@ func NewLocalBeaconConfig() *LocalBeaconConfig {
 			},
 			Options: []*ParameterOption[BeaconNode]{
 				{
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Grandine",
+						Description: "Grandine is a high-performance Beacon Node that makes heavy use of parallelism to process the chain quickly while keeping its memory usage low. Grandine is built in Rust and offered under a GPL-3.0 license.",
+					},
+					Value: BeaconNode_Grandine,
+				}, {
 					ParameterOptionCommon: &ParameterOptionCommon{
 						Name:        "Lighthouse",
 						Description: "Lighthouse is a Beacon Node with a heavy focus on speed and security. The team behind it, Sigma Prime, is an information security and software engineering firm who have funded Lighthouse along with the Ethereum Foundation, Consensys, and private individuals. Lighthouse is built in Rust and offered under an Apache 2.0 License.",
@@ -140,6 +147,7 @@ func NewLocalBeaconConfig() *LocalBeaconConfig {
 		},
 	}
 
+	cfg.Grandine = NewGrandineBnConfig()
 	cfg.Lighthouse = NewLighthouseBnConfig()
 	cfg.Lodestar = NewLodestarBnConfig()
 	cfg.Nimbus = NewNimbusBnConfig()
@@ -168,6 +176,7 @@ func (cfg *LocalBeaconConfig) GetParameters() []IParameter {
 // Get the sections underneath this one
 func (cfg *LocalBeaconConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{
+		ids.LocalBnGrandineID:   cfg.Grandine,
 		ids.LocalBnLighthouseID: cfg.Lighthouse,
 		ids.LocalBnLodestarID:   cfg.Lodestar,
 		ids.LocalBnNimbusID:     cfg.Nimbus,
@@ -205,6 +214,8 @@ func (cfg *LocalBeaconConfig) GetOpenApiPortMapping() []string {
 // Gets the max peers of the selected EC
 func (cfg *LocalBeaconConfig) GetMaxPeers() uint16 {
 	switch cfg.BeaconNode.Value {
+	case BeaconNode_Grandine:
+		return cfg.Grandine.MaxPeers.Value
 	case BeaconNode_Lighthouse:
 		return cfg.Lighthouse.MaxPeers.Value
 	case BeaconNode_Lodestar:
@@ -223,6 +234,8 @@ func (cfg *LocalBeaconConfig) GetMaxPeers() uint16 {
 // Get the container tag of the selected BN
 func (cfg *LocalBeaconConfig) GetContainerTag() string {
 	switch cfg.BeaconNode.Value {
+	case BeaconNode_Grandine:
+		return cfg.Grandine.ContainerTag.Value
 	case BeaconNode_Lighthouse:
 		return cfg.Lighthouse.ContainerTag.Value
 	case BeaconNode_Lodestar:
@@ -241,6 +254,8 @@ func (cfg *LocalBeaconConfig) GetContainerTag() string {
 // Gets the additional flags of the selected BN
 func (cfg *LocalBeaconConfig) GetAdditionalFlags() string {
 	switch cfg.BeaconNode.Value {
+	case BeaconNode_Grandine:
+		return cfg.Grandine.AdditionalFlags.Value
 	case BeaconNode_Lighthouse:
 		return cfg.Lighthouse.AdditionalFlags.Value
 	case BeaconNode_Lodestar:
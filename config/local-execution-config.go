@@ -31,6 +31,7 @@ type LocalExecutionConfig struct {
 	Nethermind *NethermindConfig
 	Besu       *BesuConfig
 	Reth       *RethConfig
+	Erigon     *ErigonConfig
 }
 
 // Create a new LocalExecutionConfig struct
@@ -70,6 +71,12 @@ func NewLocalExecutionConfig() *LocalExecutionConfig {
 						Description: "Reth is a new Ethereum full node implementation that is focused on being user-friendly, highly modular, as well as being fast and efficient. Reth is fully open source and written in Rust.",
 					},
 					Value: ExecutionClient_Reth,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Erigon",
+						Description: "Erigon is an efficiency-focused Ethereum client that stores its chain data in a compact flat format, which keeps its disk usage low and lets it sync quickly. Erigon is fully open source and written in Go.",
+					},
+					Value: ExecutionClient_Erigon,
 				}},
 			Default: map[Network]ExecutionClient{
 				Network_All: ExecutionClient_Geth,
@@ -153,6 +160,7 @@ func NewLocalExecutionConfig() *LocalExecutionConfig {
 	cfg.Nethermind = NewNethermindConfig()
 	cfg.Besu = NewBesuConfig()
 	cfg.Reth = NewRethConfig()
+	cfg.Erigon = NewErigonConfig()
 
 	return cfg
 }
@@ -178,6 +186,7 @@ func (cfg *LocalExecutionConfig) GetParameters() []IParameter {
 func (cfg *LocalExecutionConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{
 		ids.LocalEcBesuID:       cfg.Besu,
+		ids.LocalEcErigonID:     cfg.Erigon,
 		ids.LocalEcGethID:       cfg.Geth,
 		ids.LocalEcNethermindID: cfg.Nethermind,
 		ids.LocalEcRethID:       cfg.Reth,
@@ -211,6 +220,8 @@ func (cfg *LocalExecutionConfig) GetMaxPeers() uint16 {
 		return cfg.Besu.MaxPeers.Value
 	case ExecutionClient_Reth:
 		return cfg.Reth.MaxInboundPeers.Value + cfg.Reth.MaxOutboundPeers.Value
+	case ExecutionClient_Erigon:
+		return cfg.Erigon.MaxPeers.Value
 	default:
 		panic(fmt.Sprintf("Unknown Execution Client %s", string(cfg.ExecutionClient.Value)))
 	}
@@ -227,6 +238,8 @@ func (cfg *LocalExecutionConfig) GetContainerTag() string {
 		return cfg.Besu.ContainerTag.Value
 	case ExecutionClient_Reth:
 		return cfg.Reth.ContainerTag.Value
+	case ExecutionClient_Erigon:
+		return cfg.Erigon.ContainerTag.Value
 	default:
 		panic(fmt.Sprintf("Unknown Execution Client %s", string(cfg.ExecutionClient.Value)))
 	}
@@ -243,6 +256,8 @@ func (cfg *LocalExecutionConfig) GetAdditionalFlags() string {
 		return cfg.Besu.AdditionalFlags.Value
 	case ExecutionClient_Reth:
 		return cfg.Reth.AdditionalFlags.Value
+	case ExecutionClient_Erigon:
+		return cfg.Erigon.AdditionalFlags.Value
 	default:
 		panic(fmt.Sprintf("Unknown Execution Client %s", string(cfg.ExecutionClient.Value)))
 	}
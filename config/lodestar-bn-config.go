@@ -47,9 +47,10 @@ func NewLodestarBnConfig() *LodestarBnConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: lodestarBnTagProd,
-				Network_Holesky: lodestarBnTagTest,
+				Network_All:     lodestarBnTagTest,
 			},
 		},
 
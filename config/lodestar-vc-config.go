@@ -30,9 +30,10 @@ func NewLodestarVcConfig() *LodestarVcConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: lodestarVcTagProd,
-				Network_Holesky: lodestarVcTagTest,
+				Network_All:     lodestarVcTagTest,
 			},
 		},
 
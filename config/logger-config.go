@@ -15,6 +15,9 @@ type LoggerConfig struct {
 	// The format to use when printing logs
 	Format Parameter[log.LogFormat]
 
+	// Where to write the logs
+	Output Parameter[log.LogOutput]
+
 	// True to include the source code position of the log statement in log messages
 	AddSource Parameter[bool]
 
@@ -103,6 +106,39 @@ func NewLoggerConfig() *LoggerConfig {
 			},
 		},
 
+		Output: Parameter[log.LogOutput]{
+			ParameterCommon: &ParameterCommon{
+				ID:                ids.LoggerOutputID,
+				Name:              "Output",
+				Description:       "Choose where log messages will be written.",
+				AffectsContainers: []ContainerID{ContainerID_Daemon},
+			},
+			Options: []*ParameterOption[log.LogOutput]{
+				{
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "File",
+						Description: "Write log messages to a log file, which will be rotated and archived according to the settings below.",
+					},
+					Value: log.LogOutput_File,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Stdout",
+						Description: "Write log messages to the daemon's standard output instead of a file. Useful when running under systemd or in a container, where the service manager collects the output. The log rotation settings don't apply to this mode.",
+					},
+					Value: log.LogOutput_Stdout,
+				}, {
+					ParameterOptionCommon: &ParameterOptionCommon{
+						Name:        "Both",
+						Description: "Write log messages to both a log file and the daemon's standard output.",
+					},
+					Value: log.LogOutput_Both,
+				},
+			},
+			Default: map[Network]log.LogOutput{
+				Network_All: log.LogOutput_File,
+			},
+		},
+
 		AddSource: Parameter[bool]{
 			ParameterCommon: &ParameterCommon{
 				ID:                ids.LoggerAddSourceID,
@@ -187,6 +223,7 @@ func (cfg *LoggerConfig) GetParameters() []IParameter {
 	return []IParameter{
 		&cfg.Level,
 		&cfg.Format,
+		&cfg.Output,
 		&cfg.AddSource,
 		&cfg.MaxSize,
 		&cfg.MaxBackups,
@@ -209,6 +246,7 @@ func (cfg *LoggerConfig) GetOptions() log.LoggerOptions {
 		MaxAge:     int(cfg.MaxAge.Value),
 		LocalTime:  cfg.LocalTime.Value,
 		Compress:   cfg.Compress.Value,
+		Output:     cfg.Output.Value,
 		Format:     cfg.Format.Value,
 		Level:      cfg.Level.Value,
 		AddSource:  cfg.AddSource.Value,
@@ -0,0 +1,403 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+const (
+	// Tags
+	mevBoostTagProd string = "flashbots/mev-boost:1.7.1"
+	mevBoostTagTest string = "flashbots/mev-boost:1.7.1"
+
+	// Relay URLs
+	flashbotsRelayUrlMainnet          string = "https://0xac6e77dfe25ecd6110b8e780608cce0dab71fdd5ebea22a16c0205200f2f8e2e3ad3b71d3499c54ad14d6c21b41a37ae@boost-relay.flashbots.net"
+	flashbotsRelayUrlHolesky          string = "https://0xafa4c6985aa049fb79dd37010438cfebeb0f2bd42b115b89dd678dab0670c1de38da0c4e9138c9290a398ecd9a0b3110@boost-relay-holesky.flashbots.net"
+	bloxrouteMaxProfitRelayUrlMainnet string = "https://0x8b5d2e73e2a3a55c6c87b8b6eb92e0149a125c852751db1422fa951e42a09b82c142c3ea98d0d9930b056a3bc9896b8f@bloxroute.max-profit.blxrbdn.com"
+	bloxrouteRegulatedRelayUrlMainnet string = "https://0xb0b07cd0abef743db4260b0ed50619cf6ad4d82064cb4fbec9d3ec530f7c5e6793d9f286c4e082c0244ffb9f2658fe88@bloxroute.regulated.blxrbdn.com"
+	bloxrouteRelayUrlHolesky          string = "https://0x821f2a65afb70e7f2e820a925a9b4c80a159620582c1766b1b09729fec178b11ea22abb3a51f07b288be815a1a2ff516@bloxroute.holesky.blxrbdn.com"
+	ultrasoundRelayUrlMainnet         string = "https://0xa1559ace749633b997cb3fdacffb890aeebdb0f5a3b6aaa7eeeaf1a38af0a8fe88b9e4b1f61f236d2e64d95733327a62@relay.ultrasound.money"
+	aestusRelayUrlMainnet             string = "https://0xa15b52576bcbf1072f4a011c0f99f9fb6c66f3e1ff321f11f461d15e31b1cb359caa092c71bbded0bae5b5ea401aab7e@aestus.live"
+	aestusRelayUrlHolesky             string = "https://0xab78bf8c781c58078c3beb5710c57940874dd96aef2835e7742c866b4c7c0406754376c2c8285a36c630346aa5c5f833@holesky.aestus.live"
+	titanGlobalRelayUrlMainnet        string = "https://0x8c4ed5e24fe5c6ae21018437bde147693f68cda427cd1122cf20819c30eda7ed74f72dece09bb313f2a1855595ab677d@global.titanrelay.xyz"
+	titanRegionalRelayUrlMainnet      string = "https://0x8c4ed5e24fe5c6ae21018437bde147693f68cda427cd1122cf20819c30eda7ed74f72dece09bb313f2a1855595ab677d@regional.titanrelay.xyz"
+)
+
+// The ID of a well-known MEV relay
+type MevRelayID string
+
+// Enum to describe the well-known MEV relays
+const (
+	MevRelayID_Unknown            MevRelayID = ""
+	MevRelayID_Flashbots          MevRelayID = "flashbots"
+	MevRelayID_BloxrouteMaxProfit MevRelayID = "bloxrouteMaxProfit"
+	MevRelayID_BloxrouteRegulated MevRelayID = "bloxrouteRegulated"
+	MevRelayID_Ultrasound         MevRelayID = "ultrasound"
+	MevRelayID_Aestus             MevRelayID = "aestus"
+	MevRelayID_TitanGlobal        MevRelayID = "titanGlobal"
+	MevRelayID_TitanRegional      MevRelayID = "titanRegional"
+)
+
+// A well-known MEV relay
+type MevRelay struct {
+	// The relay's ID
+	ID MevRelayID
+
+	// The relay's display name
+	Name string
+
+	// A description of the relay
+	Description string
+
+	// The relay's URL on each network it supports
+	Urls map[Network]string
+}
+
+// Configuration for MEV-Boost
+type MevBoostConfig struct {
+	// Toggle for enabling MEV-Boost
+	Enable Parameter[bool]
+
+	// The port that MEV-Boost should serve its API on
+	Port Parameter[uint16]
+
+	// Toggle for forwarding the API port outside of Docker
+	OpenPort Parameter[RpcPortMode]
+
+	// Toggles for the well-known relays
+	FlashbotsRelay          Parameter[bool]
+	BloxrouteMaxProfitRelay Parameter[bool]
+	BloxrouteRegulatedRelay Parameter[bool]
+	UltrasoundRelay         Parameter[bool]
+	AestusRelay             Parameter[bool]
+	TitanGlobalRelay        Parameter[bool]
+	TitanRegionalRelay      Parameter[bool]
+
+	// Additional relays to use, as a comma-separated list of URLs
+	CustomRelays Parameter[string]
+
+	// The Docker Hub tag for MEV-Boost
+	ContainerTag Parameter[string]
+
+	// Custom command line flags
+	AdditionalFlags Parameter[string]
+}
+
+// Generates a new MEV-Boost configuration
+func NewMevBoostConfig() *MevBoostConfig {
+	return &MevBoostConfig{
+		Enable: Parameter[bool]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.MevBoostEnableID,
+				Name:               "Enable MEV-Boost",
+				Description:        "Enable MEV-Boost, which connects your validator to one or more relays of your choice. The relays act as intermediaries between you and professional block builders that find and extract MEV opportunities. The builders will give you a healthy tip in return, which tends to be worth more than blocks you built on your own.",
+				AffectsContainers:  []ContainerID{ContainerID_MevBoost, ContainerID_BeaconNode, ContainerID_ValidatorClient},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]bool{
+				Network_All: false,
+			},
+		},
+
+		Port: Parameter[uint16]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.PortID,
+				Name:               "Port",
+				Description:        "The port that MEV-Boost should serve its API on.",
+				AffectsContainers:  []ContainerID{ContainerID_MevBoost, ContainerID_BeaconNode},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]uint16{
+				Network_All: 18550,
+			},
+		},
+
+		OpenPort: Parameter[RpcPortMode]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.OpenPortID,
+				Name:               "Expose API Port",
+				Description:        "Expose the API port to other processes on your machine, or to your local network so other Beacon Nodes can access MEV-Boost.",
+				AffectsContainers:  []ContainerID{ContainerID_MevBoost},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Options: GetPortModes(""),
+			Default: map[Network]RpcPortMode{
+				Network_All: RpcPortMode_Closed,
+			},
+		},
+
+		FlashbotsRelay:          newMevRelayParameter(ids.MevBoostFlashbotsID, MevRelayID_Flashbots),
+		BloxrouteMaxProfitRelay: newMevRelayParameter(ids.MevBoostBloxrouteMaxProfitID, MevRelayID_BloxrouteMaxProfit),
+		BloxrouteRegulatedRelay: newMevRelayParameter(ids.MevBoostBloxrouteRegulatedID, MevRelayID_BloxrouteRegulated),
+		UltrasoundRelay:         newMevRelayParameter(ids.MevBoostUltrasoundID, MevRelayID_Ultrasound),
+		AestusRelay:             newMevRelayParameter(ids.MevBoostAestusID, MevRelayID_Aestus),
+		TitanGlobalRelay:        newMevRelayParameter(ids.MevBoostTitanGlobalID, MevRelayID_TitanGlobal),
+		TitanRegionalRelay:      newMevRelayParameter(ids.MevBoostTitanRegionalID, MevRelayID_TitanRegional),
+
+		CustomRelays: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.MevBoostCustomRelaysID,
+				Name:               "Custom Relays",
+				Description:        "Add custom relay URLs to MEV-Boost that aren't part of the built-in set. You can add multiple relays by separating each one with a comma. Any relay URLs can be used as long as they match your selected Ethereum network.",
+				AffectsContainers:  []ContainerID{ContainerID_MevBoost},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Validator: validateRelayUrls,
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
+		ContainerTag: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.ContainerTagID,
+				Name:               "Container Tag",
+				Description:        "The tag name of the MEV-Boost container you want to use on Docker Hub.",
+				AffectsContainers:  []ContainerID{ContainerID_MevBoost},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: true,
+			},
+			Validator: ValidateDockerTag,
+			Default: map[Network]string{
+				Network_Mainnet: mevBoostTagProd,
+				Network_Holesky: mevBoostTagTest,
+				Network_All:     mevBoostTagTest,
+			},
+		},
+
+		AdditionalFlags: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.AdditionalFlagsID,
+				Name:               "Additional Flags",
+				Description:        "Additional custom command line flags you want to pass to MEV-Boost, to take advantage of other settings that aren't covered here.",
+				AffectsContainers:  []ContainerID{ContainerID_MevBoost},
+				CanBeBlank:         true,
+				OverwriteOnUpgrade: false,
+			},
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+	}
+}
+
+// The title for the config
+func (cfg *MevBoostConfig) GetTitle() string {
+	return "MEV-Boost"
+}
+
+// Get the parameters for this config
+func (cfg *MevBoostConfig) GetParameters() []IParameter {
+	return []IParameter{
+		&cfg.Enable,
+		&cfg.Port,
+		&cfg.OpenPort,
+		&cfg.FlashbotsRelay,
+		&cfg.BloxrouteMaxProfitRelay,
+		&cfg.BloxrouteRegulatedRelay,
+		&cfg.UltrasoundRelay,
+		&cfg.AestusRelay,
+		&cfg.TitanGlobalRelay,
+		&cfg.TitanRegionalRelay,
+		&cfg.CustomRelays,
+		&cfg.ContainerTag,
+		&cfg.AdditionalFlags,
+	}
+}
+
+// Get the sections underneath this one
+func (cfg *MevBoostConfig) GetSubconfigs() map[string]IConfigSection {
+	return map[string]IConfigSection{}
+}
+
+// Make sure at least one relay is selected if MEV-Boost is enabled, since it won't start without any
+func (cfg *MevBoostConfig) ValidateSection() []error {
+	if !cfg.Enable.Value {
+		return nil
+	}
+	for _, toggle := range cfg.getRelayToggles() {
+		if toggle.Value {
+			return nil
+		}
+	}
+	if len(splitRelayUrls(cfg.CustomRelays.Value)) > 0 {
+		return nil
+	}
+	return []error{
+		fmt.Errorf("MEV-Boost is enabled but no relays are selected; enable at least one relay or add a custom relay URL"),
+	}
+}
+
+// Get the well-known relays that are available on the provided network
+func (cfg *MevBoostConfig) GetAvailableRelays(network Network) []MevRelay {
+	relays := []MevRelay{}
+	for _, relay := range GetMevRelays() {
+		if _, exists := relay.Urls[network]; exists {
+			relays = append(relays, relay)
+		}
+	}
+	return relays
+}
+
+// ==================
+// === Templating ===
+// ==================
+
+// Get the Docker mapping for the selected API port mode
+func (cfg *MevBoostConfig) GetOpenApiPortMapping() string {
+	return cfg.OpenPort.Value.DockerPortMapping(cfg.Port.Value)
+}
+
+// Get the URLs of the enabled relays for the provided network, including the custom relays
+func (cfg *MevBoostConfig) GetEnabledRelayUrls(network Network) []string {
+	toggles := cfg.getRelayToggles()
+	urls := []string{}
+	for _, relay := range GetMevRelays() {
+		url, exists := relay.Urls[network]
+		if exists && toggles[relay.ID].Value {
+			urls = append(urls, url)
+		}
+	}
+	return append(urls, splitRelayUrls(cfg.CustomRelays.Value)...)
+}
+
+// Get the comma-separated list of enabled relay URLs for the provided network, for the MEV-Boost relays flag
+func (cfg *MevBoostConfig) GetRelayString(network Network) string {
+	return strings.Join(cfg.GetEnabledRelayUrls(network), ",")
+}
+
+// Get the toggle parameter for each of the well-known relays
+func (cfg *MevBoostConfig) getRelayToggles() map[MevRelayID]*Parameter[bool] {
+	return map[MevRelayID]*Parameter[bool]{
+		MevRelayID_Flashbots:          &cfg.FlashbotsRelay,
+		MevRelayID_BloxrouteMaxProfit: &cfg.BloxrouteMaxProfitRelay,
+		MevRelayID_BloxrouteRegulated: &cfg.BloxrouteRegulatedRelay,
+		MevRelayID_Ultrasound:         &cfg.UltrasoundRelay,
+		MevRelayID_Aestus:             &cfg.AestusRelay,
+		MevRelayID_TitanGlobal:        &cfg.TitanGlobalRelay,
+		MevRelayID_TitanRegional:      &cfg.TitanRegionalRelay,
+	}
+}
+
+// ==============
+// === Relays ===
+// ==============
+
+// Get the list of well-known MEV relays
+func GetMevRelays() []MevRelay {
+	return []MevRelay{
+		{
+			ID:          MevRelayID_Flashbots,
+			Name:        "Flashbots",
+			Description: "Flashbots is the developer of MEV-Boost, and one of the best-known and most trusted relays in the space.",
+			Urls: map[Network]string{
+				Network_Mainnet: flashbotsRelayUrlMainnet,
+				Network_Holesky: flashbotsRelayUrlHolesky,
+			},
+		},
+		{
+			ID:          MevRelayID_BloxrouteMaxProfit,
+			Name:        "bloXroute Max Profit",
+			Description: "Select this to enable the \"max profit\" relay from bloXroute.",
+			Urls: map[Network]string{
+				Network_Mainnet: bloxrouteMaxProfitRelayUrlMainnet,
+				Network_Holesky: bloxrouteRelayUrlHolesky,
+			},
+		},
+		{
+			ID:          MevRelayID_BloxrouteRegulated,
+			Name:        "bloXroute Regulated",
+			Description: "Select this to enable the \"regulated\" relay from bloXroute, which only allows transactions that comply with OFAC sanctions.",
+			Urls: map[Network]string{
+				Network_Mainnet: bloxrouteRegulatedRelayUrlMainnet,
+			},
+		},
+		{
+			ID:          MevRelayID_Ultrasound,
+			Name:        "Ultra Sound",
+			Description: "The ultra sound relay is a credibly-neutral and permissionless relay, a public good from the ultrasound.money team.",
+			Urls: map[Network]string{
+				Network_Mainnet: ultrasoundRelayUrlMainnet,
+			},
+		},
+		{
+			ID:          MevRelayID_Aestus,
+			Name:        "Aestus",
+			Description: "The Aestus MEV-Boost Relay is an independent and non-censoring relay. It is committed to neutrality and the development of a healthy MEV-Boost ecosystem.",
+			Urls: map[Network]string{
+				Network_Mainnet: aestusRelayUrlMainnet,
+				Network_Holesky: aestusRelayUrlHolesky,
+			},
+		},
+		{
+			ID:          MevRelayID_TitanGlobal,
+			Name:        "Titan Global",
+			Description: "Titan Relay is a neutral, Rust-based MEV-Boost Relay optimized for low latency throughput, geographical distribution, and robustness. Select this to enable the \"global\" relay from Titan, which doesn't filter any transactions.",
+			Urls: map[Network]string{
+				Network_Mainnet: titanGlobalRelayUrlMainnet,
+			},
+		},
+		{
+			ID:          MevRelayID_TitanRegional,
+			Name:        "Titan Regional",
+			Description: "Titan Relay is a neutral, Rust-based MEV-Boost Relay optimized for low latency throughput, geographical distribution, and robustness. Select this to enable the \"regional\" relay from Titan, which only allows transactions that comply with OFAC sanctions.",
+			Urls: map[Network]string{
+				Network_Mainnet: titanRegionalRelayUrlMainnet,
+			},
+		},
+	}
+}
+
+// Create the toggle parameter for one of the well-known relays
+func newMevRelayParameter(id string, relayID MevRelayID) Parameter[bool] {
+	var relay MevRelay
+	for _, candidate := range GetMevRelays() {
+		if candidate.ID == relayID {
+			relay = candidate
+			break
+		}
+	}
+
+	return Parameter[bool]{
+		ParameterCommon: &ParameterCommon{
+			ID:                 id,
+			Name:               fmt.Sprintf("Enable %s", relay.Name),
+			Description:        fmt.Sprintf("%s\n\nThis relay is only used on the networks it supports; it will be ignored on other networks.", relay.Description),
+			AffectsContainers:  []ContainerID{ContainerID_MevBoost},
+			CanBeBlank:         false,
+			OverwriteOnUpgrade: false,
+		},
+		Default: map[Network]bool{
+			Network_All: false,
+		},
+	}
+}
+
+// Split a comma-separated list of relay URLs, ignoring empty entries
+func splitRelayUrls(value string) []string {
+	urls := []string{}
+	for _, url := range strings.Split(value, ",") {
+		url = strings.TrimSpace(url)
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// Make sure each URL in a comma-separated list of relay URLs is valid
+func validateRelayUrls(value string) error {
+	for _, url := range splitRelayUrls(value) {
+		err := ValidateUrl(url)
+		if err != nil {
+			return fmt.Errorf("invalid relay URL [%s]: %w", url, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package config
+
+import "testing"
+
+func TestMevBoostDefaults(t *testing.T) {
+	tests := []struct {
+		network     Network
+		expectedTag string
+	}{
+		{network: Network_Mainnet, expectedTag: mevBoostTagProd},
+		{network: Network_Holesky, expectedTag: mevBoostTagTest},
+		{network: Network_Hoodi, expectedTag: mevBoostTagTest},
+		{network: Network_Sepolia, expectedTag: mevBoostTagTest},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.network), func(t *testing.T) {
+			cfg := NewMevBoostConfig()
+			if tag := cfg.ContainerTag.GetDefault(test.network); tag != test.expectedTag {
+				t.Errorf("expected tag %s, got %s", test.expectedTag, tag)
+			}
+			if err := ValidateDockerTag(cfg.ContainerTag.GetDefault(test.network)); err != nil {
+				t.Errorf("expected a valid tag, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// The key in the serialized config map that holds the version of the software that saved the config
+	ConfigVersionKey string = "version"
+)
+
+// A step that upgrades the serialized form of a config, such as renaming or removing parameters.
+// A migration runs when upgrading a config saved by a version in [FromVersion, ToVersion) to ToVersion or later.
+type Migration struct {
+	// The oldest config version this migration applies to (inclusive); leave blank to apply to all older versions
+	FromVersion string
+
+	// The version that introduced the change; configs saved by older versions will have the migration applied
+	ToVersion string
+
+	// A human-readable description of the migration, used in error messages
+	Description string
+
+	// The function that modifies the serialized config map in place
+	Apply func(data map[string]any) error
+}
+
+// A collection of migrations that are applied in version order during upgrades
+type MigrationRegistry struct {
+	migrations []Migration
+	lock       *sync.Mutex
+}
+
+// The registry used by the package-level RegisterMigration and ApplyUpgrades functions
+var defaultRegistry = NewMigrationRegistry()
+
+// Creates a new, empty migration registry
+func NewMigrationRegistry() *MigrationRegistry {
+	return &MigrationRegistry{
+		migrations: []Migration{},
+		lock:       &sync.Mutex{},
+	}
+}
+
+// Add a migration to the registry
+func (r *MigrationRegistry) Register(migration Migration) error {
+	if migration.Apply == nil {
+		return fmt.Errorf("migration [%s] doesn't have an Apply function", migration.Description)
+	}
+	if _, err := parseVersion(migration.ToVersion); err != nil {
+		return fmt.Errorf("migration [%s] has an invalid ToVersion: %w", migration.Description, err)
+	}
+	if migration.FromVersion != "" {
+		order, err := compareVersions(migration.FromVersion, migration.ToVersion)
+		if err != nil {
+			return fmt.Errorf("migration [%s] has an invalid FromVersion: %w", migration.Description, err)
+		}
+		if order >= 0 {
+			return fmt.Errorf("migration [%s] has a FromVersion (%s) that isn't older than its ToVersion (%s)", migration.Description, migration.FromVersion, migration.ToVersion)
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.migrations = append(r.migrations, migration)
+	return nil
+}
+
+// Apply each migration that covers the upgrade from fromVersion to toVersion to the serialized config, in version order,
+// then set the config's version to toVersion. Migrations with the same ToVersion run in the order they were registered.
+// The map is modified in place and returned for convenience. Downgrades aren't supported.
+func (r *MigrationRegistry) ApplyUpgrades(data map[string]any, fromVersion string, toVersion string) (map[string]any, error) {
+	order, err := compareVersions(fromVersion, toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error comparing config versions: %w", err)
+	}
+	if order > 0 {
+		return nil, fmt.Errorf("config version %s is newer than %s; downgrading isn't supported", fromVersion, toVersion)
+	}
+
+	// Get the applicable migrations in version order
+	r.lock.Lock()
+	migrations := []Migration{}
+	for _, migration := range r.migrations {
+		applies, err := migration.appliesTo(fromVersion, toVersion)
+		if err != nil {
+			r.lock.Unlock()
+			return nil, err
+		}
+		if applies {
+			migrations = append(migrations, migration)
+		}
+	}
+	r.lock.Unlock()
+	slices.SortStableFunc(migrations, func(a Migration, b Migration) int {
+		order, _ := compareVersions(a.ToVersion, b.ToVersion)
+		return order
+	})
+
+	// Run them
+	for _, migration := range migrations {
+		err := migration.Apply(data)
+		if err != nil {
+			return nil, fmt.Errorf("error applying config migration to %s [%s]: %w", migration.ToVersion, migration.Description, err)
+		}
+	}
+	data[ConfigVersionKey] = toVersion
+	return data, nil
+}
+
+// Check if the migration should run when upgrading from fromVersion to toVersion
+func (m Migration) appliesTo(fromVersion string, toVersion string) (bool, error) {
+	// The config must be older than the migration
+	order, err := compareVersions(fromVersion, m.ToVersion)
+	if err != nil {
+		return false, fmt.Errorf("error checking migration [%s]: %w", m.Description, err)
+	}
+	if order >= 0 {
+		return false, nil
+	}
+
+	// The target must include the migration
+	order, err = compareVersions(toVersion, m.ToVersion)
+	if err != nil {
+		return false, fmt.Errorf("error checking migration [%s]: %w", m.Description, err)
+	}
+	if order < 0 {
+		return false, nil
+	}
+
+	// The config must be new enough for the migration
+	if m.FromVersion != "" {
+		order, err = compareVersions(fromVersion, m.FromVersion)
+		if err != nil {
+			return false, fmt.Errorf("error checking migration [%s]: %w", m.Description, err)
+		}
+		if order < 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Add a migration to the default registry
+func RegisterMigration(migration Migration) error {
+	return defaultRegistry.Register(migration)
+}
+
+// Apply the migrations in the default registry that cover the upgrade from fromVersion to toVersion to the serialized
+// config. See MigrationRegistry.ApplyUpgrades for details.
+func ApplyUpgrades(data map[string]any, fromVersion string, toVersion string) (map[string]any, error) {
+	return defaultRegistry.ApplyUpgrades(data, fromVersion, toVersion)
+}
+
+// Upgrade a serialized config saved by fromVersion and load it into the config section.
+// The migrations in the default registry are applied first. Then, if the version changed, parameters flagged with
+// OverwriteOnUpgrade are reset to their defaults for the network, while the values of all other parameters are kept.
+func UpgradeConfig(cfg IConfigSection, data map[string]any, fromVersion string, toVersion string, network Network) error {
+	data, err := ApplyUpgrades(data, fromVersion, toVersion)
+	if err != nil {
+		return err
+	}
+	if fromVersion != toVersion {
+		removeOverwriteOnUpgradeParams(cfg, data)
+	}
+	return Deserialize(cfg, data, network)
+}
+
+// Get the version of the software that saved a serialized config, or a blank string if it doesn't have one
+func GetConfigVersion(data map[string]any) string {
+	version, _ := data[ConfigVersionKey].(string)
+	return version
+}
+
+// Remove the parameters flagged with OverwriteOnUpgrade from the serialized config, so they'll be set to their defaults
+// when it's deserialized
+func removeOverwriteOnUpgradeParams(cfg IConfigSection, data map[string]any) {
+	for _, param := range cfg.GetParameters() {
+		common := param.GetCommon()
+		if common.OverwriteOnUpgrade {
+			delete(data, common.ID)
+		}
+	}
+	for name, subconfig := range cfg.GetSubconfigs() {
+		submap, isMap := data[name].(map[string]any)
+		if isMap {
+			removeOverwriteOnUpgradeParams(subconfig, submap)
+		}
+	}
+}
+
+// =========================
+// === Migration Helpers ===
+// =========================
+
+// Rename a parameter in the serialized config. The section path is a "."-delimited list of subsection names, or blank
+// for the top-level section. This does nothing if the parameter isn't present.
+func RenameParameter(data map[string]any, sectionPath string, oldId string, newId string) error {
+	section, err := getSerializedSection(data, sectionPath)
+	if err != nil || section == nil {
+		return err
+	}
+	value, exists := section[oldId]
+	if !exists {
+		return nil
+	}
+	if _, exists := section[newId]; exists {
+		return fmt.Errorf("can't rename parameter [%s] to [%s] in section [%s] because it already exists", oldId, newId, sectionPath)
+	}
+	section[newId] = value
+	delete(section, oldId)
+	return nil
+}
+
+// Remove a parameter from the serialized config. The section path is a "."-delimited list of subsection names, or blank
+// for the top-level section. This does nothing if the parameter isn't present.
+func RemoveParameter(data map[string]any, sectionPath string, id string) error {
+	section, err := getSerializedSection(data, sectionPath)
+	if err != nil || section == nil {
+		return err
+	}
+	delete(section, id)
+	return nil
+}
+
+// Get a section of the serialized config by its path, or nil if it doesn't exist
+func getSerializedSection(data map[string]any, sectionPath string) (map[string]any, error) {
+	if sectionPath == "" {
+		return data, nil
+	}
+	section := data
+	for _, name := range strings.Split(sectionPath, ".") {
+		value, exists := section[name]
+		if !exists {
+			return nil, nil
+		}
+		submap, isMap := value.(map[string]any)
+		if !isMap {
+			return nil, fmt.Errorf("[%s] in section path [%s] is not a section", name, sectionPath)
+		}
+		section = submap
+	}
+	return section, nil
+}
+
+// ========================
+// === Version Handling ===
+// ========================
+
+// A parsed semantic version
+type version struct {
+	numbers    [3]uint64
+	prerelease []string
+}
+
+// Parse a semantic version such as "1.2.3" or "v1.2.3-rc1". Missing minor and patch numbers are treated as 0, and
+// build metadata is ignored.
+func parseVersion(value string) (version, error) {
+	var parsed version
+	trimmed := strings.TrimPrefix(strings.TrimSpace(value), "v")
+	trimmed, _, _ = strings.Cut(trimmed, "+")
+	core, prerelease, hasPrerelease := strings.Cut(trimmed, "-")
+	if hasPrerelease {
+		parsed.prerelease = strings.Split(prerelease, ".")
+	}
+
+	parts := strings.Split(core, ".")
+	if core == "" || len(parts) > 3 {
+		return version{}, fmt.Errorf("invalid version [%s]", value)
+	}
+	for i, part := range parts {
+		number, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return version{}, fmt.Errorf("invalid version [%s]", value)
+		}
+		parsed.numbers[i] = number
+	}
+	return parsed, nil
+}
+
+// Compare two semantic versions, returning -1 if a is older than b, 1 if it's newer, or 0 if they're the same.
+// A blank version is treated as older than every other version.
+func compareVersions(a string, b string) (int, error) {
+	if a == "" || b == "" {
+		switch {
+		case a == b:
+			return 0, nil
+		case a == "":
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	}
+
+	versionA, err := parseVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	versionB, err := parseVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range versionA.numbers {
+		if versionA.numbers[i] != versionB.numbers[i] {
+			if versionA.numbers[i] < versionB.numbers[i] {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+
+	// Prereleases are older than releases
+	switch {
+	case len(versionA.prerelease) == 0 && len(versionB.prerelease) == 0:
+		return 0, nil
+	case len(versionA.prerelease) == 0:
+		return 1, nil
+	case len(versionB.prerelease) == 0:
+		return -1, nil
+	}
+	for i := 0; i < len(versionA.prerelease) && i < len(versionB.prerelease); i++ {
+		order := comparePrereleaseIdentifiers(versionA.prerelease[i], versionB.prerelease[i])
+		if order != 0 {
+			return order, nil
+		}
+	}
+	switch {
+	case len(versionA.prerelease) < len(versionB.prerelease):
+		return -1, nil
+	case len(versionA.prerelease) > len(versionB.prerelease):
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// Compare two prerelease identifiers; numeric identifiers are compared numerically and are older than alphanumeric ones
+func comparePrereleaseIdentifiers(a string, b string) int {
+	numberA, errA := strconv.ParseUint(a, 10, 64)
+	numberB, errB := strconv.ParseUint(b, 10, 64)
+	switch {
+	case errA == nil && errB == nil:
+		switch {
+		case numberA < numberB:
+			return -1
+		case numberA > numberB:
+			return 1
+		}
+		return 0
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
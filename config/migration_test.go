@@ -0,0 +1,329 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+// Create a registry with the provided migrations, failing the test if any are invalid
+func newTestRegistry(t *testing.T, migrations ...Migration) *MigrationRegistry {
+	registry := NewMigrationRegistry()
+	for _, migration := range migrations {
+		if err := registry.Register(migration); err != nil {
+			t.Fatalf("error registering migration: %v", err)
+		}
+	}
+	return registry
+}
+
+func TestApplyUpgradesRename(t *testing.T) {
+	registry := newTestRegistry(t, Migration{
+		ToVersion:   "1.1.0",
+		Description: "rename the Geth cache",
+		Apply: func(data map[string]any) error {
+			return RenameParameter(data, "localExecution.geth", "cache", "cacheSize")
+		},
+	})
+
+	data := map[string]any{
+		"localExecution": map[string]any{
+			"geth": map[string]any{
+				"cache": "2048",
+			},
+		},
+	}
+	upgraded, err := registry.ApplyUpgrades(data, "1.0.0", "1.1.0")
+	if err != nil {
+		t.Fatalf("error applying upgrades: %v", err)
+	}
+	geth := getSubmap(t, upgraded, "localExecution", "geth")
+	if _, exists := geth["cache"]; exists {
+		t.Error("expected the old parameter to be removed")
+	}
+	if geth["cacheSize"] != "2048" {
+		t.Errorf("expected the value to move to the new parameter, got %v", geth["cacheSize"])
+	}
+	if GetConfigVersion(upgraded) != "1.1.0" {
+		t.Errorf("expected the config version to be 1.1.0, got %s", GetConfigVersion(upgraded))
+	}
+}
+
+func TestRenameParameter(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        map[string]any
+		sectionPath string
+		expected    map[string]any
+		expectError bool
+	}{
+		{
+			name:        "top level",
+			data:        map[string]any{"old": "1"},
+			sectionPath: "",
+			expected:    map[string]any{"new": "1"},
+		}, {
+			name:        "missing parameter",
+			data:        map[string]any{"other": "1"},
+			sectionPath: "",
+			expected:    map[string]any{"other": "1"},
+		}, {
+			name:        "missing section",
+			data:        map[string]any{"other": "1"},
+			sectionPath: "localBeacon.teku",
+			expected:    map[string]any{"other": "1"},
+		}, {
+			name:        "new parameter already exists",
+			data:        map[string]any{"old": "1", "new": "2"},
+			sectionPath: "",
+			expectError: true,
+		}, {
+			name:        "section isn't a map",
+			data:        map[string]any{"localBeacon": "teku"},
+			sectionPath: "localBeacon.teku",
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := RenameParameter(test.data, test.sectionPath, "old", "new")
+			if test.expectError {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(test.data) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, test.data)
+			}
+			for key, value := range test.expected {
+				if test.data[key] != value {
+					t.Errorf("expected %v, got %v", test.expected, test.data)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyUpgradesRemove(t *testing.T) {
+	registry := newTestRegistry(t, Migration{
+		ToVersion:   "2.0.0",
+		Description: "remove the Prysm RPC port",
+		Apply: func(data map[string]any) error {
+			return RemoveParameter(data, "localBeacon.prysm", "rpcPort")
+		},
+	})
+
+	data := map[string]any{
+		"localBeacon": map[string]any{
+			"prysm": map[string]any{
+				"rpcPort":  "5053",
+				"maxPeers": "80",
+			},
+		},
+	}
+	upgraded, err := registry.ApplyUpgrades(data, "1.5.0", "2.0.0")
+	if err != nil {
+		t.Fatalf("error applying upgrades: %v", err)
+	}
+	prysm := getSubmap(t, upgraded, "localBeacon", "prysm")
+	if _, exists := prysm["rpcPort"]; exists {
+		t.Error("expected the parameter to be removed")
+	}
+	if prysm["maxPeers"] != "80" {
+		t.Errorf("expected the other parameters to be kept, got %v", prysm)
+	}
+}
+
+func TestApplyUpgradesVersionRanges(t *testing.T) {
+	// Each migration records that it ran
+	var ran []string
+	record := func(name string) func(map[string]any) error {
+		return func(map[string]any) error {
+			ran = append(ran, name)
+			return nil
+		}
+	}
+	registry := newTestRegistry(t,
+		Migration{ToVersion: "1.2.0", Description: "1.2.0", Apply: record("1.2.0")},
+		Migration{ToVersion: "1.1.0", Description: "1.1.0", Apply: record("1.1.0")},
+		Migration{FromVersion: "1.1.0", ToVersion: "1.3.0", Description: "1.1.0 to 1.3.0", Apply: record("1.1.0 to 1.3.0")},
+		Migration{ToVersion: "1.3.0-rc1", Description: "1.3.0-rc1", Apply: record("1.3.0-rc1")},
+	)
+
+	tests := []struct {
+		name        string
+		fromVersion string
+		toVersion   string
+		expected    []string
+	}{
+		{
+			name:        "all migrations run in version order",
+			fromVersion: "1.0.0",
+			toVersion:   "1.3.0",
+			expected:    []string{"1.1.0", "1.2.0", "1.3.0-rc1"},
+		}, {
+			name:        "configs without a version get every migration",
+			fromVersion: "",
+			toVersion:   "1.2.0",
+			expected:    []string{"1.1.0", "1.2.0"},
+		}, {
+			name:        "migrations older than the config are skipped",
+			fromVersion: "1.1.0",
+			toVersion:   "1.3.0",
+			expected:    []string{"1.2.0", "1.3.0-rc1", "1.1.0 to 1.3.0"},
+		}, {
+			name:        "migrations newer than the target are skipped",
+			fromVersion: "v1.1.5",
+			toVersion:   "1.2.5",
+			expected:    []string{"1.2.0"},
+		}, {
+			name:        "prerelease targets include their own migrations",
+			fromVersion: "1.2.0",
+			toVersion:   "1.3.0-rc1",
+			expected:    []string{"1.3.0-rc1"},
+		}, {
+			name:        "same version",
+			fromVersion: "1.2.0",
+			toVersion:   "1.2.0",
+			expected:    []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ran = []string{}
+			if _, err := registry.ApplyUpgrades(map[string]any{}, test.fromVersion, test.toVersion); err != nil {
+				t.Fatalf("error applying upgrades: %v", err)
+			}
+			if len(ran) != len(test.expected) {
+				t.Fatalf("expected migrations %v, got %v", test.expected, ran)
+			}
+			for i := range ran {
+				if ran[i] != test.expected[i] {
+					t.Fatalf("expected migrations %v, got %v", test.expected, ran)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyUpgradesErrors(t *testing.T) {
+	registry := newTestRegistry(t, Migration{
+		ToVersion:   "1.1.0",
+		Description: "broken",
+		Apply: func(map[string]any) error {
+			return errors.New("broken")
+		},
+	})
+	if _, err := registry.ApplyUpgrades(map[string]any{}, "1.0.0", "1.1.0"); err == nil {
+		t.Error("expected a failing migration to return an error")
+	}
+	if _, err := registry.ApplyUpgrades(map[string]any{}, "2.0.0", "1.1.0"); err == nil {
+		t.Error("expected a downgrade to return an error")
+	}
+	if _, err := registry.ApplyUpgrades(map[string]any{}, "one", "1.1.0"); err == nil {
+		t.Error("expected an invalid version to return an error")
+	}
+
+	invalid := []Migration{
+		{ToVersion: "1.0.0", Description: "no apply function"},
+		{ToVersion: "latest", Description: "invalid version", Apply: func(map[string]any) error { return nil }},
+		{FromVersion: "1.2.0", ToVersion: "1.1.0", Description: "backwards range", Apply: func(map[string]any) error { return nil }},
+	}
+	for _, migration := range invalid {
+		if err := registry.Register(migration); err == nil {
+			t.Errorf("expected an error registering migration [%s]", migration.Description)
+		}
+	}
+}
+
+func TestUpgradeConfigResetsDefaults(t *testing.T) {
+	// A config saved by an old version, with an old container tag and a modified setting
+	saved := newCompositeConfig(Network_Mainnet)
+	saved.LocalExecution.Geth.ContainerTag.Value = "ethereum/client-go:v1.0.0"
+	saved.LocalExecution.Geth.EvmTimeout.Value = 17
+	saved.LocalBeacon.Teku.ContainerTag.Value = "consensys/teku:1.0.0"
+	data := Serialize(saved)
+	data[ConfigVersionKey] = "1.0.0"
+
+	tests := []struct {
+		name          string
+		toVersion     string
+		expectedReset bool
+	}{
+		{
+			name:          "upgrade resets container tags",
+			toVersion:     "1.1.0",
+			expectedReset: true,
+		}, {
+			name:          "same version keeps container tags",
+			toVersion:     "1.0.0",
+			expectedReset: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newCompositeConfig(Network_Mainnet)
+			err := UpgradeConfig(cfg, Serialize(saved), GetConfigVersion(data), test.toVersion, Network_Mainnet)
+			if err != nil {
+				t.Fatalf("error upgrading config: %v", err)
+			}
+
+			// Parameters flagged with OverwriteOnUpgrade are reset, everything else is kept
+			if !cfg.LocalExecution.Geth.ContainerTag.OverwriteOnUpgrade || cfg.LocalExecution.Geth.EvmTimeout.OverwriteOnUpgrade {
+				t.Fatal("expected the container tag to be the only overwritten parameter")
+			}
+			gethDefault := cfg.LocalExecution.Geth.ContainerTag.GetDefault(Network_Mainnet)
+			tekuDefault := cfg.LocalBeacon.Teku.ContainerTag.GetDefault(Network_Mainnet)
+			if test.expectedReset {
+				if cfg.LocalExecution.Geth.ContainerTag.Value != gethDefault {
+					t.Errorf("expected the Geth tag to be reset to %s, got %s", gethDefault, cfg.LocalExecution.Geth.ContainerTag.Value)
+				}
+				if cfg.LocalBeacon.Teku.ContainerTag.Value != tekuDefault {
+					t.Errorf("expected the Teku tag to be reset to %s, got %s", tekuDefault, cfg.LocalBeacon.Teku.ContainerTag.Value)
+				}
+			} else {
+				if cfg.LocalExecution.Geth.ContainerTag.Value != "ethereum/client-go:v1.0.0" {
+					t.Errorf("expected the saved Geth tag to be kept, got %s", cfg.LocalExecution.Geth.ContainerTag.Value)
+				}
+				if cfg.LocalBeacon.Teku.ContainerTag.Value != "consensys/teku:1.0.0" {
+					t.Errorf("expected the saved Teku tag to be kept, got %s", cfg.LocalBeacon.Teku.ContainerTag.Value)
+				}
+			}
+			if cfg.LocalExecution.Geth.EvmTimeout.Value != 17 {
+				t.Errorf("expected the modified EVM timeout to be kept, got %d", cfg.LocalExecution.Geth.EvmTimeout.Value)
+			}
+		})
+	}
+}
+
+func TestUpgradeConfigKeepsUserValues(t *testing.T) {
+	// Parameters without OverwriteOnUpgrade keep their saved values even when their defaults change
+	saved := newCompositeConfig(Network_Mainnet)
+	saved.LocalExecution.HttpPort.Value = 18545
+	saved.LocalExecution.Geth.MaxPeers.Value = 7
+	data := Serialize(saved)
+
+	cfg := newCompositeConfig(Network_Mainnet)
+	cfg.LocalExecution.HttpPort.Default[Network_All] = 28545
+	err := UpgradeConfig(cfg, data, "1.0.0", "2.0.0", Network_Mainnet)
+	if err != nil {
+		t.Fatalf("error upgrading config: %v", err)
+	}
+	if cfg.LocalExecution.HttpPort.Value != 18545 {
+		t.Errorf("expected the saved HTTP port to be kept, got %d", cfg.LocalExecution.HttpPort.Value)
+	}
+	if cfg.LocalExecution.Geth.MaxPeers.Value != 7 {
+		t.Errorf("expected the saved peer count to be kept, got %d", cfg.LocalExecution.Geth.MaxPeers.Value)
+	}
+	if _, exists := getSubmap(t, data, "localExecution")[ids.HttpPortID]; !exists {
+		t.Error("expected the HTTP port to stay in the serialized config")
+	}
+}
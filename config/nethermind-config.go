@@ -1,7 +1,6 @@
 package config
 
 import (
-	"fmt"
 	"runtime"
 
 	"github.com/pbnjay/memory"
@@ -66,7 +65,7 @@ func NewNethermindConfig() *NethermindConfig {
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.MaxPeersID,
 				Name:               "Max Peers",
-				Description:        "The maximum number of peers Nethermind should connect to. This can be lowered to improve performance on low-power systems or constrained Networks. We recommend keeping it at 12 or higher.",
+				Description:        getPeerCountDescription("The maximum number of peers Nethermind should connect to. This can be lowered to improve performance on low-power systems or constrained Networks. We recommend keeping it at 12 or higher."),
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -115,7 +114,7 @@ func NewNethermindConfig() *NethermindConfig {
 			},
 			Default: map[Network]uint64{
 				Network_Mainnet: uint64(307200),
-				Network_Holesky: uint64(51200),
+				Network_All:     uint64(51200),
 			},
 		},
 
@@ -156,9 +155,10 @@ func NewNethermindConfig() *NethermindConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: nethermindTagProd,
-				Network_Holesky: nethermindTagTest,
+				Network_All:     nethermindTagTest,
 			},
 		},
 
@@ -266,12 +266,10 @@ func calculateNethermindFullPruneMemBudget() uint64 {
 
 // Calculate the default number of Nethermind peers
 func calculateNethermindPeers() uint16 {
-	switch runtime.GOARCH {
-	case "arm64":
-		return 25
-	case "amd64":
+	switch getPeerCountTier(runtime.GOARCH) {
+	case peerCountTier_Standard:
 		return 50
 	default:
-		panic(fmt.Sprintf("unsupported architecture %s", runtime.GOARCH))
+		return 25
 	}
 }
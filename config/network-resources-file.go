@@ -0,0 +1,178 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// The length of a genesis fork version, in bytes
+	genesisForkVersionLength int = 4
+
+	// The file mode used when saving network resources
+	networkResourcesFileMode os.FileMode = 0644
+)
+
+// The on-disk representation of a NetworkResources collection
+type networkResourcesFile struct {
+	Network               string `json:"network" yaml:"network"`
+	EthNetworkName        string `json:"ethNetworkName,omitempty" yaml:"ethNetworkName,omitempty"`
+	ChainID               uint   `json:"chainId" yaml:"chainId"`
+	GenesisForkVersion    string `json:"genesisForkVersion" yaml:"genesisForkVersion"`
+	MulticallAddress      string `json:"multicallAddress" yaml:"multicallAddress"`
+	BalanceBatcherAddress string `json:"balanceBatcherAddress,omitempty" yaml:"balanceBatcherAddress,omitempty"`
+	TxWatchUrl            string `json:"txWatchUrl,omitempty" yaml:"txWatchUrl,omitempty"`
+	FlashbotsProtectUrl   string `json:"flashbotsProtectUrl,omitempty" yaml:"flashbotsProtectUrl,omitempty"`
+}
+
+// Loads a resource collection for a custom network, such as an ephemeral devnet, from a JSON or YAML file.
+// The network, chainId, genesisForkVersion, and multicallAddress fields are required; ethNetworkName defaults to the
+// network name if it's not provided.
+func LoadResourcesFromFile(path string) (*NetworkResources, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading network resources file [%s]: %w", path, err)
+	}
+
+	// YAML is a superset of JSON so this handles both formats
+	var file networkResourcesFile
+	err = yaml.Unmarshal(bytes, &file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing network resources file [%s]: %w", path, err)
+	}
+
+	resources, err := file.toResources()
+	if err != nil {
+		return nil, fmt.Errorf("invalid network resources file [%s]: %w", path, err)
+	}
+	return resources, nil
+}
+
+// Loads a resource collection for a custom network from a JSON or YAML file and registers it with
+// RegisterCustomResources, so configs that select the network will use it
+func RegisterCustomResourcesFromFile(path string) (*NetworkResources, error) {
+	resources, err := LoadResourcesFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	err = RegisterCustomResources(resources.Network, resources)
+	if err != nil {
+		return nil, fmt.Errorf("error registering network resources from [%s]: %w", path, err)
+	}
+	return resources, nil
+}
+
+// Saves the resource collection to a file that can be loaded with LoadResourcesFromFile.
+// Files with a .json extension are saved as JSON, and all other files are saved as YAML.
+func (r *NetworkResources) SaveToFile(path string) error {
+	file := newNetworkResourcesFile(r)
+	var bytes []byte
+	var err error
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		bytes, err = json.MarshalIndent(file, "", "    ")
+	} else {
+		bytes, err = yaml.Marshal(file)
+	}
+	if err != nil {
+		return fmt.Errorf("error serializing network resources: %w", err)
+	}
+
+	err = os.WriteFile(path, bytes, networkResourcesFileMode)
+	if err != nil {
+		return fmt.Errorf("error writing network resources file [%s]: %w", path, err)
+	}
+	return nil
+}
+
+// Create the on-disk representation of a resource collection
+func newNetworkResourcesFile(r *NetworkResources) *networkResourcesFile {
+	file := &networkResourcesFile{
+		Network:             string(r.Network),
+		EthNetworkName:      r.EthNetworkName,
+		ChainID:             r.ChainID,
+		GenesisForkVersion:  hexutil.Encode(r.GenesisForkVersion),
+		MulticallAddress:    r.MulticallAddress.Hex(),
+		TxWatchUrl:          r.TxWatchUrl,
+		FlashbotsProtectUrl: r.FlashbotsProtectUrl,
+	}
+	if r.BalanceBatcherAddress != (common.Address{}) {
+		file.BalanceBatcherAddress = r.BalanceBatcherAddress.Hex()
+	}
+	return file
+}
+
+// Validate the on-disk representation of a resource collection and convert it into a NetworkResources
+func (f *networkResourcesFile) toResources() (*NetworkResources, error) {
+	// Network name
+	network := Network(strings.TrimSpace(f.Network))
+	if network == Network_Unknown {
+		return nil, fmt.Errorf("field [network] is missing")
+	}
+	if network == Network_All {
+		return nil, fmt.Errorf("field [network] can't be [%s]", Network_All)
+	}
+	ethNetworkName := strings.TrimSpace(f.EthNetworkName)
+	if ethNetworkName == "" {
+		ethNetworkName = string(network)
+	}
+
+	// Chain ID
+	if f.ChainID == 0 {
+		return nil, fmt.Errorf("field [chainId] is missing")
+	}
+
+	// Genesis fork version
+	if f.GenesisForkVersion == "" {
+		return nil, fmt.Errorf("field [genesisForkVersion] is missing")
+	}
+	genesisForkVersion, err := hexutil.Decode(f.GenesisForkVersion)
+	if err != nil {
+		return nil, fmt.Errorf("field [genesisForkVersion] is not a valid hex string: %w", err)
+	}
+	if len(genesisForkVersion) != genesisForkVersionLength {
+		return nil, fmt.Errorf("field [genesisForkVersion] must be %d bytes but was %d", genesisForkVersionLength, len(genesisForkVersion))
+	}
+
+	// Addresses
+	if f.MulticallAddress == "" {
+		return nil, fmt.Errorf("field [multicallAddress] is missing")
+	}
+	if !common.IsHexAddress(f.MulticallAddress) {
+		return nil, fmt.Errorf("field [multicallAddress] is not a valid address: [%s]", f.MulticallAddress)
+	}
+	balanceBatcherAddress := common.Address{}
+	if f.BalanceBatcherAddress != "" {
+		if !common.IsHexAddress(f.BalanceBatcherAddress) {
+			return nil, fmt.Errorf("field [balanceBatcherAddress] is not a valid address: [%s]", f.BalanceBatcherAddress)
+		}
+		balanceBatcherAddress = common.HexToAddress(f.BalanceBatcherAddress)
+	}
+
+	// URLs
+	err = ValidateUrl(f.TxWatchUrl)
+	if err != nil {
+		return nil, fmt.Errorf("field [txWatchUrl] is invalid: %w", err)
+	}
+	err = ValidateUrl(f.FlashbotsProtectUrl)
+	if err != nil {
+		return nil, fmt.Errorf("field [flashbotsProtectUrl] is invalid: %w", err)
+	}
+
+	return &NetworkResources{
+		Network:               network,
+		EthNetworkName:        ethNetworkName,
+		ChainID:               f.ChainID,
+		GenesisForkVersion:    genesisForkVersion,
+		MulticallAddress:      common.HexToAddress(f.MulticallAddress),
+		BalanceBatcherAddress: balanceBatcherAddress,
+		TxWatchUrl:            strings.TrimSpace(f.TxWatchUrl),
+		FlashbotsProtectUrl:   strings.TrimSpace(f.FlashbotsProtectUrl),
+	}, nil
+}
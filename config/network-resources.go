@@ -1,11 +1,22 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 )
 
+var (
+	// Resources for networks registered with RegisterCustomResources
+	customResources     = map[Network]*NetworkResources{}
+	customResourcesLock = &sync.RWMutex{}
+
+	// Returned when a network doesn't have a BalanceChecker contract deployment
+	ErrNoBalanceBatcher = errors.New("network does not have a balance batcher contract")
+)
+
 // A collection of network-specific resources and getters for them
 type NetworkResources struct {
 	// The Network being used
@@ -23,7 +34,8 @@ type NetworkResources struct {
 	// The address of the multicall contract
 	MulticallAddress common.Address
 
-	// The BalanceChecker contract address
+	// The BalanceChecker contract address, or the zero address if the network doesn't have one.
+	// Use GetBalanceBatcherAddress to get it with a check for that.
 	BalanceBatcherAddress common.Address
 
 	// The URL for transaction monitoring on the network's chain explorer
@@ -33,8 +45,20 @@ type NetworkResources struct {
 	FlashbotsProtectUrl string
 }
 
-// Creates a new resource collection for the given network
-func NewResources(network Network) *NetworkResources {
+// Creates a new resource collection for the given network.
+// Networks registered with RegisterCustomResources take precedence over the built-in networks.
+// Returns an error if the network isn't a built-in or registered network.
+func NewResources(network Network) (*NetworkResources, error) {
+	// Check the registered networks
+	customResourcesLock.RLock()
+	resources, exists := customResources[network]
+	customResourcesLock.RUnlock()
+	if exists {
+		clone := *resources
+		clone.GenesisForkVersion = append([]byte(nil), resources.GenesisForkVersion...)
+		return &clone, nil
+	}
+
 	// Mainnet
 	mainnetResources := &NetworkResources{
 		Network:               Network_Mainnet,
@@ -59,12 +83,93 @@ func NewResources(network Network) *NetworkResources {
 		FlashbotsProtectUrl:   "https://rpc-holesky.flashbots.net",
 	}
 
+	// Sepolia
+	sepoliaResources := &NetworkResources{
+		Network:               Network_Sepolia,
+		EthNetworkName:        string(Network_Sepolia),
+		ChainID:               11155111,
+		GenesisForkVersion:    common.FromHex("0x90000069"), // https://github.com/eth-clients/sepolia
+		MulticallAddress:      common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+		BalanceBatcherAddress: common.Address{}, // No BalanceChecker deployment on Sepolia yet
+		TxWatchUrl:            "https://sepolia.etherscan.io/tx",
+		FlashbotsProtectUrl:   "https://rpc-sepolia.flashbots.net/",
+	}
+
+	// Hoodi
+	hoodiResources := &NetworkResources{
+		Network:               Network_Hoodi,
+		EthNetworkName:        string(Network_Hoodi),
+		ChainID:               560048,
+		GenesisForkVersion:    common.FromHex("0x10000910"), // https://github.com/eth-clients/hoodi
+		MulticallAddress:      common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11"),
+		BalanceBatcherAddress: common.Address{}, // No known BalanceChecker deployment on Hoodi; register custom resources to provide one
+		TxWatchUrl:            "https://hoodi.etherscan.io/tx",
+		FlashbotsProtectUrl:   "", // No Flashbots Protect endpoint for Hoodi yet
+	}
+
 	switch network {
 	case Network_Mainnet:
-		return mainnetResources
+		return mainnetResources, nil
 	case Network_Holesky:
-		return holeskyResources
+		return holeskyResources, nil
+	case Network_Sepolia:
+		return sepoliaResources, nil
+	case Network_Hoodi:
+		return hoodiResources, nil
+	}
+
+	return nil, fmt.Errorf("network %s is not a built-in network; register custom resources for it first", network)
+}
+
+// Creates a new resource collection for the given network, panicking if the network isn't a built-in or registered network.
+//
+// Deprecated: use NewResources and handle the error instead.
+func MustNewResources(network Network) *NetworkResources {
+	resources, err := NewResources(network)
+	if err != nil {
+		panic(err)
+	}
+	return resources
+}
+
+// Register the resources for a custom network, such as a private devnet, so NewResources can create them.
+// This can also override the resources for a built-in network.
+func RegisterCustomResources(network Network, resources *NetworkResources) error {
+	if network == Network_Unknown || network == Network_All {
+		return fmt.Errorf("network [%s] can't have custom resources", network)
+	}
+	if resources == nil {
+		return fmt.Errorf("resources for network %s can't be nil", network)
+	}
+	if resources.ChainID == 0 {
+		return fmt.Errorf("resources for network %s must have a chain ID", network)
 	}
 
-	panic(fmt.Sprintf("network %s is not supported", network))
+	clone := *resources
+	clone.Network = network
+	clone.GenesisForkVersion = append([]byte(nil), resources.GenesisForkVersion...)
+	customResourcesLock.Lock()
+	defer customResourcesLock.Unlock()
+	customResources[network] = &clone
+	return nil
+}
+
+// Get the address of the BalanceChecker contract, returning ErrNoBalanceBatcher if the network doesn't have one
+func (r *NetworkResources) GetBalanceBatcherAddress() (common.Address, error) {
+	if r.BalanceBatcherAddress == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("%w: %s", ErrNoBalanceBatcher, r.Network)
+	}
+	return r.BalanceBatcherAddress, nil
+}
+
+// Creates a new resource collection for a user-defined network, such as a private Ethereum network
+func NewCustomResources(network Network, chainID uint, ethNetworkName string, multicallAddr common.Address, balanceBatcherAddr common.Address, txWatchUrl string) *NetworkResources {
+	return &NetworkResources{
+		Network:               network,
+		EthNetworkName:        ethNetworkName,
+		ChainID:               chainID,
+		MulticallAddress:      multicallAddr,
+		BalanceBatcherAddress: balanceBatcherAddr,
+		TxWatchUrl:            txWatchUrl,
+	}
 }
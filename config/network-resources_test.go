@@ -0,0 +1,82 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestGetBalanceBatcherAddress(t *testing.T) {
+	tests := []struct {
+		name            string
+		network         Network
+		expectedAddress common.Address
+	}{
+		{
+			name:            "mainnet",
+			network:         Network_Mainnet,
+			expectedAddress: common.HexToAddress("0xb1f8e55c7f64d203c1400b9d8555d050f94adf39"),
+		}, {
+			name:            "holesky",
+			network:         Network_Holesky,
+			expectedAddress: common.HexToAddress("0xfAa2e7C84eD801dd9D27Ac1ed957274530796140"),
+		}, {
+			name:    "sepolia",
+			network: Network_Sepolia,
+		}, {
+			name:    "hoodi",
+			network: Network_Hoodi,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resources, err := NewResources(test.network)
+			if err != nil {
+				t.Fatalf("error creating resources: %v", err)
+			}
+			address, err := resources.GetBalanceBatcherAddress()
+			if test.expectedAddress == (common.Address{}) {
+				if !errors.Is(err, ErrNoBalanceBatcher) {
+					t.Errorf("expected ErrNoBalanceBatcher, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error getting balance batcher address: %v", err)
+			}
+			if address != test.expectedAddress {
+				t.Errorf("expected address %s, got %s", test.expectedAddress.Hex(), address.Hex())
+			}
+		})
+	}
+}
+
+func TestGetBalanceBatcherAddressOverride(t *testing.T) {
+	resources, err := NewResources(Network_Hoodi)
+	if err != nil {
+		t.Fatalf("error creating resources: %v", err)
+	}
+	resources.BalanceBatcherAddress = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	if err := RegisterCustomResources(Network_Hoodi, resources); err != nil {
+		t.Fatalf("error registering resources: %v", err)
+	}
+	t.Cleanup(func() {
+		customResourcesLock.Lock()
+		defer customResourcesLock.Unlock()
+		delete(customResources, Network_Hoodi)
+	})
+
+	registered, err := NewResources(Network_Hoodi)
+	if err != nil {
+		t.Fatalf("error creating resources: %v", err)
+	}
+	address, err := registered.GetBalanceBatcherAddress()
+	if err != nil {
+		t.Fatalf("error getting balance batcher address: %v", err)
+	}
+	if address != resources.BalanceBatcherAddress {
+		t.Errorf("expected the registered address, got %s", address.Hex())
+	}
+}
@@ -1,7 +1,6 @@
 package config
 
 import (
-	"fmt"
 	"runtime"
 
 	"github.com/rocket-pool/node-manager-core/config/ids"
@@ -43,7 +42,7 @@ func NewNimbusBnConfig() *NimbusBnConfig {
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.MaxPeersID,
 				Name:               "Max Peers",
-				Description:        "The maximum number of peers your client should try to maintain. You can try lowering this if you have a low-resource system or a constrained network.",
+				Description:        getPeerCountDescription("The maximum number of peers your client should try to maintain. You can try lowering this if you have a low-resource system or a constrained network."),
 				AffectsContainers:  []ContainerID{ContainerID_BeaconNode},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -91,9 +90,10 @@ func NewNimbusBnConfig() *NimbusBnConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: nimbusBnTagProd,
-				Network_Holesky: nimbusBnTagTest,
+				Network_All:     nimbusBnTagTest,
 			},
 		},
 
@@ -135,12 +135,10 @@ func (cfg *NimbusBnConfig) GetSubconfigs() map[string]IConfigSection {
 
 // Get the default number of peers
 func getNimbusDefaultPeers() uint16 {
-	switch runtime.GOARCH {
-	case "arm64":
-		return 100
-	case "amd64":
+	switch getPeerCountTier(runtime.GOARCH) {
+	case peerCountTier_Standard:
 		return 160
 	default:
-		panic(fmt.Sprintf("unsupported architecture %s", runtime.GOARCH))
+		return 100
 	}
 }
@@ -31,9 +31,10 @@ func NewNimbusVcConfig() *NimbusVcConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: nimbusVcTagProd,
-				Network_Holesky: nimbusVcTagTest,
+				Network_All:     nimbusVcTagTest,
 			},
 		},
 
@@ -41,6 +41,10 @@ type ParameterCommon struct {
 
 	// Descriptions of the parameter that change depending on the selected network
 	DescriptionsByNetwork map[Network]string
+
+	// The name of the environment variable that can override this parameter's value (see ApplyEnvOverrides).
+	// If this is blank, a name will be generated from the parameter's section path and ID.
+	EnvVarName string
 }
 
 // Set the network-specific description of the parameter
@@ -59,6 +63,9 @@ type Parameter[Type comparable] struct {
 	Default map[Network]Type
 	Value   Type
 	Options []*ParameterOption[Type]
+
+	// An optional function used to check that the parameter's value is legal
+	Validator func(Type) error
 }
 
 // An interface for typed Parameter structs, to get common fields from them
@@ -81,6 +88,9 @@ type IParameter interface {
 	// Get the parameter's default value for the supplied network as a string
 	GetDefaultAsAny(network Network) any
 
+	// True if the parameter's value is the default value for the provided network
+	IsDefault(network Network) bool
+
 	// Deserializes a string into this parameter's value
 	Deserialize(serializedParam string, network Network) error
 
@@ -89,6 +99,9 @@ type IParameter interface {
 
 	// Change the current network
 	ChangeNetwork(oldNetwork Network, newNetwork Network)
+
+	// Check that the parameter's value is legal, using its validator if it has one
+	Validate() error
 }
 
 // Get the parameter's common fields
@@ -113,6 +126,16 @@ func (p *Parameter[Type]) SetToDefault(network Network) {
 	p.Value = p.GetDefault(network)
 }
 
+// Revert the value to the default for the provided network; this is an alias for SetToDefault
+func (p *Parameter[Type]) Reset(network Network) {
+	p.SetToDefault(network)
+}
+
+// True if the parameter's value is the default value for the provided network
+func (p *Parameter[Type]) IsDefault(network Network) bool {
+	return p.Value == p.GetDefault(network)
+}
+
 // Get the default value for the provided network
 func (p *Parameter[Type]) GetDefault(network Network) Type {
 	defaultSetting, exists := p.Default[network]
@@ -230,3 +253,15 @@ func (p *Parameter[_]) ChangeNetwork(oldNetwork Network, newNetwork Network) {
 	// Update the description, if applicable
 	p.UpdateDescription(newNetwork)
 }
+
+// Check that the parameter's value is legal, using its validator if it has one
+func (p *Parameter[_]) Validate() error {
+	if p.Validator == nil {
+		return nil
+	}
+	err := p.Validator(p.Value)
+	if err != nil {
+		return fmt.Errorf("parameter [%s] has an invalid value [%v]: %w", p.ID, p.Value, err)
+	}
+	return nil
+}
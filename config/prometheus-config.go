@@ -65,6 +65,7 @@ func NewPrometheusConfig() *PrometheusConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_All: prometheusTag,
 			},
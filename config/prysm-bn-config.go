@@ -83,9 +83,10 @@ func NewPrysmBnConfig() *PrysmBnConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: prysmBnTagProd,
-				Network_Holesky: prysmBnTagTest,
+				Network_All:     prysmBnTagTest,
 			},
 		},
 
@@ -31,9 +31,10 @@ func NewPrysmVcConfig() *PrysmVcConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: prysmVcTagProd,
-				Network_Holesky: prysmVcTagTest,
+				Network_All:     prysmVcTagTest,
 			},
 		},
 
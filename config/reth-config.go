@@ -52,7 +52,7 @@ func NewRethConfig() *RethConfig {
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.RethMaxInboundPeersID,
 				Name:               "Max Inbound Peers",
-				Description:        "The maximum number of inbound peers that should be allowed to connect to Reth (peers that request to connect to your node). This can be lowered to improve performance on low-power systems or constrained networks. Inbound peers requires you to have properly forwarded ports. We recommend keeping the sum of this and max outbound peers at 12 or higher.",
+				Description:        getPeerCountDescription("The maximum number of inbound peers that should be allowed to connect to Reth (peers that request to connect to your node). This can be lowered to improve performance on low-power systems or constrained networks. Inbound peers requires you to have properly forwarded ports. We recommend keeping the sum of this and max outbound peers at 12 or higher."),
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -64,7 +64,7 @@ func NewRethConfig() *RethConfig {
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.RethMaxOutboundPeersID,
 				Name:               "Max Outbound Peers",
-				Description:        "The maximum number of outbound peers that Reth can connect to (peers that your node requests to connect to). This can be lowered to improve performance on low-power systems or constrained networks. Outbound peers do not require proper port forwarding, but are slower to accumulate than inbound peers. We recommend keeping the sum of this and max outbound peers at 12 or higher.",
+				Description:        getPeerCountDescription("The maximum number of outbound peers that Reth can connect to (peers that your node requests to connect to). This can be lowered to improve performance on low-power systems or constrained networks. Outbound peers do not require proper port forwarding, but are slower to accumulate than inbound peers. We recommend keeping the sum of this and max outbound peers at 12 or higher."),
 				AffectsContainers:  []ContainerID{ContainerID_ExecutionClient},
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: false,
@@ -81,9 +81,10 @@ func NewRethConfig() *RethConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: rethTagProd,
-				Network_Holesky: rethTagTest,
+				Network_All:     rethTagTest,
 			},
 		},
 
@@ -147,8 +148,10 @@ func calculateRethCache() uint64 {
 
 // Calculate the default number of Reth peers
 func calculateRethPeers() uint16 {
-	if runtime.GOARCH == "arm64" {
+	switch getPeerCountTier(runtime.GOARCH) {
+	case peerCountTier_Standard:
+		return 25
+	default:
 		return 12
 	}
-	return 25
 }
@@ -83,9 +83,10 @@ func NewTekuBnConfig() *TekuBnConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: tekuBnTagProd,
-				Network_Holesky: tekuBnTagTest,
+				Network_All:     tekuBnTagTest,
 			},
 		},
 
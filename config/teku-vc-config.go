@@ -48,9 +48,10 @@ func NewTekuVcConfig() *TekuVcConfig {
 				CanBeBlank:         false,
 				OverwriteOnUpgrade: true,
 			},
+			Validator: ValidateDockerTag,
 			Default: map[Network]string{
 				Network_Mainnet: tekuVcTagProd,
-				Network_Holesky: tekuVcTagTest,
+				Network_All:     tekuVcTagTest,
 			},
 		},
 
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Serialize a config into TOML, using the parameter IDs as keys and the subconfig names as tables
+func Marshal(cfg IConfig) ([]byte, error) {
+	var buffer bytes.Buffer
+	err := toml.NewEncoder(&buffer).Encode(getValueMap(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("error serializing config to TOML: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// Deserialize a config from TOML. Parameters that aren't present will be set to the default for the config's network.
+func Unmarshal(data []byte, cfg IConfig) error {
+	var values map[string]any
+	_, err := toml.Decode(string(data), &values)
+	if err != nil {
+		return fmt.Errorf("error deserializing config from TOML: %w", err)
+	}
+
+	network := Network_Unknown
+	resources := cfg.GetNetworkResources()
+	if resources != nil {
+		network = resources.Network
+	}
+	return Deserialize(cfg, values, network)
+}
+
+// Get a map of each parameter's value, with nested maps for the subconfigs
+func getValueMap(cfg IConfigSection) map[string]any {
+	masterMap := map[string]any{}
+	for _, param := range cfg.GetParameters() {
+		masterMap[param.GetCommon().ID] = param.GetValueAsAny()
+	}
+	for name, subconfig := range cfg.GetSubconfigs() {
+		masterMap[name] = getValueMap(subconfig)
+	}
+	return masterMap
+}
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+// A top-level config built from every client section, like the config of a project built on this package
+type tomlTestConfig struct {
+	*compositeConfig
+	resources *NetworkResources
+}
+
+func newTomlTestConfig(t *testing.T, network Network) *tomlTestConfig {
+	resources, err := NewResources(network)
+	if err != nil {
+		t.Fatalf("error creating resources: %v", err)
+	}
+	return &tomlTestConfig{
+		compositeConfig: newCompositeConfig(network),
+		resources:       resources,
+	}
+}
+
+func (cfg *tomlTestConfig) GetApiLogFilePath() string {
+	return "/var/log/test/api.log"
+}
+
+func (cfg *tomlTestConfig) GetTasksLogFilePath() string {
+	return "/var/log/test/tasks.log"
+}
+
+func (cfg *tomlTestConfig) GetNodeAddressFilePath() string {
+	return "/data/address"
+}
+
+func (cfg *tomlTestConfig) GetWalletFilePath() string {
+	return "/data/wallet"
+}
+
+func (cfg *tomlTestConfig) GetPasswordFilePath() string {
+	return "/data/password"
+}
+
+func (cfg *tomlTestConfig) GetNetworkResources() *NetworkResources {
+	return cfg.resources
+}
+
+func (cfg *tomlTestConfig) GetExecutionClientUrls() (string, string) {
+	return "http://localhost:8545", ""
+}
+
+func (cfg *tomlTestConfig) GetBeaconNodeUrls() (string, string) {
+	return "http://localhost:5052", ""
+}
+
+func (cfg *tomlTestConfig) GetLoggerOptions() log.LoggerOptions {
+	return cfg.Logging.GetOptions()
+}
+
+func TestTomlRoundTrip(t *testing.T) {
+	// Change parameters of each type across several levels of the tree
+	cfg := newTomlTestConfig(t, Network_Holesky)
+	cfg.LocalExecution.ExecutionClient.Value = ExecutionClient_Besu
+	cfg.LocalExecution.HttpPort.Value = 18545
+	cfg.LocalExecution.Besu.MaxPeers.Value = 40
+	cfg.LocalExecution.Geth.ArchiveMode.Value = true
+	cfg.LocalExecution.Geth.AdditionalFlags.Value = `--verbosity 4 --http.api "eth,net"`
+	cfg.ExternalBeacon.HttpUrl.Value = "http://192.168.1.10:5052"
+	cfg.LocalBeacon.BeaconNode.Value = BeaconNode_Lighthouse
+	cfg.LocalBeacon.CheckpointSyncProvider.Value = "https://checkpoint-sync.holesky.ethpandaops.io"
+	cfg.Fallback.UseFallbackClients.Value = true
+	cfg.Metrics.EnableMetrics.Value = true
+	cfg.MevBoost.Enable.Value = true
+	cfg.Logging.Level.Value = slog.LevelDebug
+	cfg.Logging.Format.Value = log.LogFormat_Json
+
+	data, err := Marshal(cfg)
+	if err != nil {
+		t.Fatalf("error marshalling config: %v", err)
+	}
+
+	// Subconfigs are written as tables, with nested subconfigs as nested tables
+	text := string(data)
+	for _, table := range []string{"[localExecution]", "[localExecution.besu]", "[localBeacon.lighthouse]", "[logging]", "[mevBoost]"} {
+		if !strings.Contains(text, table) {
+			t.Errorf("expected the TOML to contain table %s", table)
+		}
+	}
+
+	// Unmarshalling into a fresh config restores every value
+	loaded := newTomlTestConfig(t, Network_Holesky)
+	err = Unmarshal(data, loaded)
+	if err != nil {
+		t.Fatalf("error unmarshalling config: %v", err)
+	}
+	if !reflect.DeepEqual(Serialize(loaded), Serialize(cfg)) {
+		t.Errorf("expected the config to survive a TOML round trip")
+	}
+	if loaded.LocalExecution.Geth.AdditionalFlags.Value != cfg.LocalExecution.Geth.AdditionalFlags.Value {
+		t.Errorf("expected quoted flags to be restored, got [%s]", loaded.LocalExecution.Geth.AdditionalFlags.Value)
+	}
+	if loaded.Logging.Level.Value != slog.LevelDebug || loaded.Logging.Format.Value != log.LogFormat_Json {
+		t.Errorf("expected the logging settings to be restored, got %s and %s", loaded.Logging.Level.Value, loaded.Logging.Format.Value)
+	}
+
+	// Marshalling the loaded config gives the same TOML
+	reserialized, err := Marshal(loaded)
+	if err != nil {
+		t.Fatalf("error marshalling loaded config: %v", err)
+	}
+	if string(reserialized) != text {
+		t.Errorf("expected the TOML to be stable across a round trip")
+	}
+}
+
+func TestTomlUnmarshalMissingValues(t *testing.T) {
+	// Parameters missing from the TOML get the defaults for the config's network
+	data := []byte(`
+[localExecution]
+executionClient = "nethermind"
+
+[logging]
+level = "WARN"
+`)
+	cfg := newTomlTestConfig(t, Network_Mainnet)
+	cfg.LocalExecution.HttpPort.Value = 18545
+	err := Unmarshal(data, cfg)
+	if err != nil {
+		t.Fatalf("error unmarshalling config: %v", err)
+	}
+	if cfg.LocalExecution.ExecutionClient.Value != ExecutionClient_Nethermind {
+		t.Errorf("expected the execution client to be nethermind, got %s", cfg.LocalExecution.ExecutionClient.Value)
+	}
+	if cfg.Logging.Level.Value != slog.LevelWarn {
+		t.Errorf("expected the log level to be WARN, got %s", cfg.Logging.Level.Value)
+	}
+	if cfg.LocalExecution.HttpPort.Value != cfg.LocalExecution.HttpPort.Default[Network_All] {
+		t.Errorf("expected the missing HTTP port to be reset to its default, got %d", cfg.LocalExecution.HttpPort.Value)
+	}
+}
+
+func TestTomlUnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "invalid syntax",
+			data: "[localExecution\nhttpPort = 1",
+		}, {
+			name: "wrong type",
+			data: "[localExecution]\nhttpPort = \"not a port\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := newTomlTestConfig(t, Network_Mainnet)
+			if err := Unmarshal([]byte(test.data), cfg); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
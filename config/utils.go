@@ -2,6 +2,8 @@ package config
 
 import (
 	"net"
+	"runtime"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -57,8 +59,48 @@ func GetExternalIP(timeout time.Duration) (net.IP, error) {
 	return ip6Consensus.ExternalIP()
 }
 
+// Normalize a URL provided by the user by removing surrounding whitespace and trailing slashes
+func normalizeUrl(value string) string {
+	return strings.TrimRight(strings.TrimSpace(value), "/")
+}
+
 // Convert a hex string to an address, wrapped in a pointer
 func HexToAddressPtr(hexAddress string) *common.Address {
 	address := common.HexToAddress(hexAddress)
 	return &address
 }
+
+// The tier of default peer limits to use for a system architecture
+type peerCountTier int
+
+const (
+	// An architecture without known defaults, which gets the same conservative limits as the low tier
+	peerCountTier_Unknown peerCountTier = iota
+
+	// Low-power architectures, such as ARM boards
+	peerCountTier_Low
+
+	// Standard architectures, such as x86-64 desktops and servers
+	peerCountTier_Standard
+)
+
+// Get the peer count tier for a system architecture (in GOARCH format)
+func getPeerCountTier(arch string) peerCountTier {
+	switch arch {
+	case "amd64":
+		return peerCountTier_Standard
+	case "arm64":
+		return peerCountTier_Low
+	default:
+		return peerCountTier_Unknown
+	}
+}
+
+// Adds a warning to a peer count parameter's description if the default is a fallback because this system's
+// architecture doesn't have known defaults
+func getPeerCountDescription(description string) string {
+	if getPeerCountTier(runtime.GOARCH) != peerCountTier_Unknown {
+		return description
+	}
+	return description + "\n\n[orange]WARNING: Your system's architecture (" + runtime.GOARCH + ") doesn't have a recommended peer count, so the default is a conservative value for low-power systems."
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// The suffix of the IDs of parameters that configure network ports
+	portIdSuffix string = "port"
+)
+
+var (
+	// Matches Docker image references: an optional registry host, a repository path, an optional tag, and an optional digest
+	dockerTagRegex = regexp.MustCompile(`^(?:[a-zA-Z0-9.-]+(?::[0-9]+)?/)?[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*(?::[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127})?(?:@[a-z0-9]+:[a-fA-F0-9]{32,})?$`)
+)
+
+// Checks that a port number is in the usable range (1 - 65535)
+func ValidatePort(port uint16) error {
+	if port == 0 {
+		return errors.New("port must be between 1 and 65535")
+	}
+	return nil
+}
+
+// Checks that a value is an absolute HTTP(S) or WS(S) URL with a host.
+// Blank values are allowed, since URL parameters are often only used in certain modes; use CanBeBlank to indicate
+// whether a blank value is acceptable.
+func ValidateUrl(value string) error {
+	if value == "" {
+		return nil
+	}
+	parsedUrl, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	switch parsedUrl.Scheme {
+	case "http", "https", "ws", "wss":
+	default:
+		return fmt.Errorf("URL must start with http://, https://, ws://, or wss://")
+	}
+	if parsedUrl.Host == "" {
+		return fmt.Errorf("URL must include a host")
+	}
+	return nil
+}
+
+// Checks that a value is a gRPC address: a host and port pair such as "192.168.1.100:4000", optionally with a URL
+// scheme in front of it.
+// Blank values are allowed; use CanBeBlank to indicate whether a blank value is acceptable.
+func ValidateGrpcAddress(value string) error {
+	if value == "" {
+		return nil
+	}
+	if _, address, hasScheme := strings.Cut(value, "://"); hasScheme {
+		value = strings.TrimRight(address, "/")
+	}
+	host, portString, err := net.SplitHostPort(value)
+	if err != nil {
+		return fmt.Errorf("must be in host:port format: %w", err)
+	}
+	if host == "" {
+		return fmt.Errorf("must include a host")
+	}
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid port [%s]", portString)
+	}
+	return ValidatePort(uint16(port))
+}
+
+// Checks that a value is an Ethereum address with a valid EIP-55 checksum.
+// Blank values are allowed, since address parameters are often only used in certain modes; use CanBeBlank to indicate
+// whether a blank value is acceptable.
+func ValidateChecksumAddress(value string) error {
+	if value == "" {
+		return nil
+	}
+	if !common.IsHexAddress(value) || !strings.HasPrefix(value, "0x") {
+		return fmt.Errorf("not a valid 0x-prefixed address")
+	}
+	checksummed := common.HexToAddress(value).Hex()
+	if value != checksummed {
+		return fmt.Errorf("address does not have a valid EIP-55 checksum (expected %s)", checksummed)
+	}
+	return nil
+}
+
+// Checks that a value is a valid Docker image reference, such as "ethereum/client-go:v1.14.3"
+func ValidateDockerTag(value string) error {
+	if !dockerTagRegex.MatchString(value) {
+		return fmt.Errorf("not a valid Docker image tag")
+	}
+	return nil
+}
+
+// Checks that the port parameters in a section are in the usable range and don't collide with each other.
+// Port parameters are the uint16 parameters with an ID that ends in "Port" (e.g. HttpPortID and MetricsPortID).
+func validatePorts(cfg IConfigSection) []error {
+	errs := []error{}
+	portOwners := map[uint16]string{}
+	for _, param := range cfg.GetParameters() {
+		id := param.GetCommon().ID
+		if !strings.HasSuffix(strings.ToLower(id), portIdSuffix) {
+			continue
+		}
+		port, ok := param.GetValueAsAny().(uint16)
+		if !ok {
+			continue
+		}
+
+		err := ValidatePort(port)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("parameter [%s] has an invalid value [%d]: %w", id, port, err))
+			continue
+		}
+		owner, exists := portOwners[port]
+		if exists {
+			errs = append(errs, fmt.Errorf("parameters [%s] and [%s] both use port %d", owner, id, port))
+			continue
+		}
+		portOwners[port] = id
+	}
+	return errs
+}
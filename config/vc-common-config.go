@@ -1,6 +1,10 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/node-manager-core/config/ids"
 )
 
@@ -9,6 +13,9 @@ type ValidatorClientCommonConfig struct {
 	// Custom proposal graffiti
 	Graffiti Parameter[string]
 
+	// The address that should receive priority fees and MEV rewards from proposals
+	FeeRecipient Parameter[string]
+
 	// Toggle for enabling doppelganger detection
 	DoppelgangerDetection Parameter[bool]
 
@@ -36,6 +43,21 @@ func NewValidatorClientCommonConfig() *ValidatorClientCommonConfig {
 			},
 		},
 
+		FeeRecipient: Parameter[string]{
+			ParameterCommon: &ParameterCommon{
+				ID:                 ids.FeeRecipientID,
+				Name:               "Fee Recipient",
+				Description:        "The address that should receive the priority fees and MEV rewards from any blocks your validators propose. This must be a checksummed address, such as one copied from a block explorer or wallet.",
+				AffectsContainers:  []ContainerID{ContainerID_ValidatorClient},
+				CanBeBlank:         false,
+				OverwriteOnUpgrade: false,
+			},
+			Validator: ValidateChecksumAddress,
+			Default: map[Network]string{
+				Network_All: "",
+			},
+		},
+
 		DoppelgangerDetection: Parameter[bool]{
 			ParameterCommon: &ParameterCommon{
 				ID:                 ids.DoppelgangerDetectionID,
@@ -75,6 +97,7 @@ func (cfg *ValidatorClientCommonConfig) GetTitle() string {
 func (cfg *ValidatorClientCommonConfig) GetParameters() []IParameter {
 	return []IParameter{
 		&cfg.Graffiti,
+		&cfg.FeeRecipient,
 		&cfg.DoppelgangerDetection,
 		&cfg.MetricsPort,
 	}
@@ -84,3 +107,17 @@ func (cfg *ValidatorClientCommonConfig) GetParameters() []IParameter {
 func (cfg *ValidatorClientCommonConfig) GetSubconfigs() map[string]IConfigSection {
 	return map[string]IConfigSection{}
 }
+
+// Get the fee recipient as an address.
+// Returns an error if the fee recipient hasn't been set or isn't a valid checksummed address.
+func (cfg *ValidatorClientCommonConfig) GetFeeRecipientAddress() (common.Address, error) {
+	value := cfg.FeeRecipient.Value
+	if value == "" {
+		return common.Address{}, errors.New("fee recipient has not been set")
+	}
+	err := ValidateChecksumAddress(value)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("fee recipient [%s] is invalid: %w", value, err)
+	}
+	return common.HexToAddress(value), nil
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/node-manager-core/config/ids"
+)
+
+func TestValidateChecksumAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		isValid bool
+	}{
+		// Test vectors from EIP-55
+		{name: "all caps", value: "0x52908400098527886E0F7030069857D2E4169EE7", isValid: true},
+		{name: "all lower", value: "0xde709f2102306220921060314715629080e2fb77", isValid: true},
+		{name: "mixed case 1", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", isValid: true},
+		{name: "mixed case 2", value: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359", isValid: true},
+		{name: "mixed case 3", value: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB", isValid: true},
+		{name: "mixed case 4", value: "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb", isValid: true},
+
+		// Bad checksums
+		{name: "one flipped letter", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD", isValid: false},
+		{name: "lowercased mixed case", value: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", isValid: false},
+		{name: "uppercased mixed case", value: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", isValid: false},
+
+		// Malformed addresses
+		{name: "missing prefix", value: "5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", isValid: false},
+		{name: "too short", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA", isValid: false},
+		{name: "too long", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00", isValid: false},
+		{name: "invalid hex", value: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg", isValid: false},
+		{name: "ENS name", value: "vitalik.eth", isValid: false},
+
+		// Blank values are left to CanBeBlank
+		{name: "blank", value: "", isValid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateChecksumAddress(test.value)
+			if test.isValid && err != nil {
+				t.Errorf("unexpected error for [%s]: %v", test.value, err)
+			}
+			if !test.isValid && err == nil {
+				t.Errorf("expected an error for [%s]", test.value)
+			}
+		})
+	}
+}
+
+func TestGetFeeRecipientAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected common.Address
+		isValid  bool
+	}{
+		{
+			name:     "checksummed",
+			value:    "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			expected: common.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"),
+			isValid:  true,
+		}, {
+			name:    "bad checksum",
+			value:   "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD",
+			isValid: false,
+		}, {
+			name:    "blank",
+			value:   "",
+			isValid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := NewValidatorClientCommonConfig()
+			cfg.FeeRecipient.Value = test.value
+			address, err := cfg.GetFeeRecipientAddress()
+			if !test.isValid {
+				if err == nil {
+					t.Errorf("expected an error, got %s", address.Hex())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if address != test.expected {
+				t.Errorf("expected %s, got %s", test.expected.Hex(), address.Hex())
+			}
+		})
+	}
+}
+
+func TestFeeRecipientParameter(t *testing.T) {
+	cfg := NewValidatorClientCommonConfig()
+	ApplyDefaults(cfg, Network_Mainnet)
+	if cfg.FeeRecipient.CanBeBlank {
+		t.Error("expected the fee recipient not to be allowed to be blank")
+	}
+	affectsVc := false
+	for _, container := range cfg.FeeRecipient.AffectsContainers {
+		if container == ContainerID_ValidatorClient {
+			affectsVc = true
+		}
+	}
+	if !affectsVc {
+		t.Error("expected the fee recipient to affect the Validator Client")
+	}
+
+	// Bad checksums are reported by the section's validation, naming the parameter
+	cfg.FeeRecipient.Value = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	err := Validate(cfg)
+	if err == nil {
+		t.Fatal("expected the bad checksum to fail validation")
+	}
+	if !strings.Contains(err.Error(), ids.FeeRecipientID) {
+		t.Errorf("expected the error to name [%s], got [%v]", ids.FeeRecipientID, err)
+	}
+
+	cfg.FeeRecipient.Value = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	if err := Validate(cfg); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+
+	// Deserializing a blank value falls back to the default, which is unset
+	if err := cfg.FeeRecipient.Deserialize("", Network_Mainnet); err != nil {
+		t.Fatalf("error deserializing blank fee recipient: %v", err)
+	}
+	if _, err := cfg.GetFeeRecipientAddress(); err == nil {
+		t.Error("expected an error for a blank fee recipient")
+	}
+}
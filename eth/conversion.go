@@ -1,8 +1,10 @@
 package eth
 
 import (
+	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 )
 
 // Conversion factors
@@ -15,11 +17,17 @@ const (
 
 	// Amount of gwei in 1 ETH
 	GweiPerEth float64 = WeiPerEth / WeiPerGwei
+
+	// Number of decimal places in an ETH amount
+	EthDecimals int = 18
 )
 
 var (
 	weiPerEthFloat  *big.Float = big.NewFloat(WeiPerEth)
 	WeiPerGweiFloat *big.Float = big.NewFloat(WeiPerGwei)
+	weiPerGweiInt   *big.Int   = big.NewInt(int64(WeiPerGwei))
+	weiPerEthInt    *big.Int   = big.NewInt(int64(WeiPerEth))
+	maxUint256      *big.Int   = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
 )
 
 // Convert a wei amount (a native uint256 value on the execution layer) to a floating-point ETH amount
@@ -64,6 +72,17 @@ func GweiToWei(gwei float64) *big.Int {
 	return &wei
 }
 
+// Convert a wei amount to an integer gwei amount without losing precision to floating-point math.
+// Any remainder below 1 gwei is truncated.
+func WeiToGweiInt(wei *big.Int) *big.Int {
+	return new(big.Int).Quo(wei, weiPerGweiInt)
+}
+
+// Convert an integer gwei amount to a wei amount without losing precision to floating-point math
+func GweiToWeiInt(gwei *big.Int) *big.Int {
+	return new(big.Int).Mul(gwei, weiPerGweiInt)
+}
+
 // Convert a floating-point ETH amount to a floating-point gwei amount
 func EthToGwei(eth float64) float64 {
 	return eth * GweiPerEth
@@ -73,3 +92,58 @@ func EthToGwei(eth float64) float64 {
 func GweiToEth(gwei float64) float64 {
 	return gwei / GweiPerEth
 }
+
+// Format a wei amount as a human-readable ETH amount with the provided number of decimal places (e.g. "1.2340").
+// The result is rounded to the nearest decimal place. A nil amount is treated as zero.
+func FormatEthAmount(wei *big.Int, decimals int) string {
+	if wei == nil {
+		wei = big.NewInt(0)
+	}
+	if decimals < 0 {
+		decimals = 0
+	}
+	eth := new(big.Rat).SetFrac(wei, weiPerEthInt)
+	return eth.FloatString(decimals)
+}
+
+// Parse a decimal ETH amount (e.g. "0.05") into a wei amount.
+// Amounts can have at most 18 decimal places and must fit into a uint256.
+func ParseEthAmount(s string) (*big.Int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("amount is empty")
+	}
+
+	// Split the whole and fractional parts
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" {
+		return nil, fmt.Errorf("[%s] is not a valid ETH amount", s)
+	}
+	if !isDigits(whole) || !isDigits(fraction) {
+		return nil, fmt.Errorf("[%s] is not a valid ETH amount", s)
+	}
+	if len(fraction) > EthDecimals {
+		return nil, fmt.Errorf("[%s] has more than %d decimal places", s, EthDecimals)
+	}
+
+	// Shift the amount into wei
+	digits := whole + fraction + strings.Repeat("0", EthDecimals-len(fraction))
+	wei, success := new(big.Int).SetString(digits, 10)
+	if !success {
+		return nil, fmt.Errorf("[%s] is not a valid ETH amount", s)
+	}
+	if wei.Cmp(maxUint256) > 0 {
+		return nil, fmt.Errorf("[%s] is too large to be an ETH amount", s)
+	}
+	return wei, nil
+}
+
+// Check if a string only contains decimal digits
+func isDigits(s string) bool {
+	for _, char := range s {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
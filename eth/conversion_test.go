@@ -0,0 +1,129 @@
+package eth
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// Parse a base-10 integer for a test case
+func mustParseBig(t *testing.T, value string) *big.Int {
+	result, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		t.Fatalf("invalid test value [%s]", value)
+	}
+	return result
+}
+
+func TestWeiToGweiInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		wei      string
+		expected string
+	}{
+		{
+			name:     "zero",
+			wei:      "0",
+			expected: "0",
+		}, {
+			name:     "less than a gwei",
+			wei:      "999999999",
+			expected: "0",
+		}, {
+			name:     "exactly one gwei",
+			wei:      "1000000000",
+			expected: "1",
+		}, {
+			name:     "remainder is truncated",
+			wei:      "1999999999",
+			expected: "1",
+		}, {
+			name:     "uint64 max",
+			wei:      "18446744073709551615",
+			expected: "18446744073",
+		}, {
+			name:     "beyond uint64 max",
+			wei:      "18446744073709551616000000000",
+			expected: "18446744073709551616",
+		}, {
+			name:     "beyond 2^53 gwei",
+			wei:      "9007199254740993000000001",
+			expected: "9007199254740993",
+		}, {
+			name:     "negative remainder truncates toward zero",
+			wei:      "-1999999999",
+			expected: "-1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wei := mustParseBig(t, test.wei)
+			gwei := WeiToGweiInt(wei)
+			if gwei.String() != test.expected {
+				t.Errorf("expected %s gwei, got %s", test.expected, gwei.String())
+			}
+			if wei.String() != test.wei {
+				t.Errorf("expected the input to be unchanged, got %s", wei.String())
+			}
+		})
+	}
+}
+
+func TestGweiToWeiInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		gwei     string
+		expected string
+	}{
+		{
+			name:     "zero",
+			gwei:     "0",
+			expected: "0",
+		}, {
+			name:     "one gwei",
+			gwei:     "1",
+			expected: "1000000000",
+		}, {
+			name:     "largest amount that fits in uint64 wei",
+			gwei:     "18446744073",
+			expected: "18446744073000000000",
+		}, {
+			name:     "first amount that overflows uint64 wei",
+			gwei:     "18446744074",
+			expected: "18446744074000000000",
+		}, {
+			name:     "uint64 max",
+			gwei:     "18446744073709551615",
+			expected: "18446744073709551615000000000",
+		}, {
+			name:     "negative",
+			gwei:     "-5",
+			expected: "-5000000000",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gwei := mustParseBig(t, test.gwei)
+			wei := GweiToWeiInt(gwei)
+			if wei.String() != test.expected {
+				t.Errorf("expected %s wei, got %s", test.expected, wei.String())
+			}
+			if gwei.String() != test.gwei {
+				t.Errorf("expected the input to be unchanged, got %s", gwei.String())
+			}
+
+			// Whole gwei amounts should survive a round trip
+			if roundTrip := WeiToGweiInt(wei); roundTrip.Cmp(gwei) != 0 {
+				t.Errorf("expected %s gwei after a round trip, got %s", test.gwei, roundTrip.String())
+			}
+		})
+	}
+
+	// The result must be larger than uint64 can hold instead of wrapping
+	wei := GweiToWeiInt(new(big.Int).SetUint64(math.MaxUint64))
+	if wei.IsUint64() {
+		t.Errorf("expected %s wei to be larger than uint64 max", wei.String())
+	}
+}
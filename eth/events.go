@@ -0,0 +1,126 @@
+package eth
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Decodes a log emitted by the contract into a struct representing the event with the given name.
+// Fields of T are matched to the event's arguments using the ABI's CamelCase naming convention (e.g. "node_address"
+// becomes "NodeAddress"). Indexed arguments of dynamic types (strings, bytes, arrays, and tuples) can't be recovered
+// from the log since only their Keccak256 hashes are stored as topics, so their fields must be of type common.Hash.
+func DecodeEvent[T any](contract *Contract, eventName string, log types.Log) (*T, error) {
+	event, exists := contract.ABI.Events[eventName]
+	if !exists {
+		return nil, fmt.Errorf("contract %s does not have an event named %s", contract.Name, eventName)
+	}
+	if log.Address != contract.Address {
+		return nil, fmt.Errorf("log was emitted by %s, not contract %s (%s)", log.Address.Hex(), contract.Name, contract.Address.Hex())
+	}
+
+	// Get the topics for the indexed arguments
+	topics := log.Topics
+	if !event.Anonymous {
+		if len(topics) == 0 || topics[0] != event.ID {
+			return nil, fmt.Errorf("log is not a %s event", eventName)
+		}
+		topics = topics[1:]
+	}
+
+	// Unpack the non-indexed arguments from the data
+	out := new(T)
+	if len(log.Data) > 0 || len(event.Inputs.NonIndexed()) > 0 {
+		err := contract.ABI.UnpackIntoInterface(out, eventName, log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("error unpacking data for event %s: %w", eventName, err)
+		}
+	}
+
+	// Unpack the indexed arguments from the topics
+	err := parseEventTopics(out, event, topics)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing topics for event %s: %w", eventName, err)
+	}
+	return out, nil
+}
+
+// Decodes all of the logs in a receipt that were emitted by the contract as the event with the given name.
+// Logs from other contracts or for other events are skipped.
+// Anonymous events don't have a topic identifying them, so for those any log from the contract with the right
+// number of topics is assumed to be the event.
+func FilterAndDecodeEvents[T any](contract *Contract, eventName string, receipt *types.Receipt) ([]*T, error) {
+	event, exists := contract.ABI.Events[eventName]
+	if !exists {
+		return nil, fmt.Errorf("contract %s does not have an event named %s", contract.Name, eventName)
+	}
+	indexedCount := len(event.Inputs) - len(event.Inputs.NonIndexed())
+
+	events := []*T{}
+	for _, log := range receipt.Logs {
+		if log.Address != contract.Address {
+			continue
+		}
+		if event.Anonymous {
+			if len(log.Topics) != indexedCount {
+				continue
+			}
+		} else if len(log.Topics) == 0 || log.Topics[0] != event.ID {
+			continue
+		}
+
+		decoded, err := DecodeEvent[T](contract, eventName, *log)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding log %d: %w", log.Index, err)
+		}
+		events = append(events, decoded)
+	}
+	return events, nil
+}
+
+// Parses the indexed arguments of an event from the log topics and assigns them to the output struct
+func parseEventTopics(out any, event abi.Event, topics []common.Hash) error {
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if len(indexed) != len(topics) {
+		return fmt.Errorf("event has %d indexed arguments but the log has %d topics", len(indexed), len(topics))
+	}
+
+	outVal := reflect.ValueOf(out).Elem()
+	for i, arg := range indexed {
+		// Get the value of the argument
+		var value any
+		switch arg.Type.T {
+		case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+			// Dynamic types are stored as their hashes
+			value = topics[i]
+		default:
+			values := map[string]any{}
+			err := abi.ParseTopicsIntoMap(values, abi.Arguments{arg}, topics[i:i+1])
+			if err != nil {
+				return fmt.Errorf("error parsing topic for argument %s: %w", arg.Name, err)
+			}
+			value = values[arg.Name]
+		}
+
+		// Assign it to the struct
+		fieldName := abi.ToCamelCase(arg.Name)
+		field := outVal.FieldByName(fieldName)
+		if !field.IsValid() || !field.CanSet() {
+			return fmt.Errorf("output type does not have a settable field named %s for argument %s", fieldName, arg.Name)
+		}
+		valueVal := reflect.ValueOf(value)
+		if !valueVal.Type().AssignableTo(field.Type()) {
+			return fmt.Errorf("field %s has type %s but argument %s requires %s", fieldName, field.Type(), arg.Name, valueVal.Type())
+		}
+		field.Set(valueVal)
+	}
+	return nil
+}
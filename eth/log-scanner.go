@@ -0,0 +1,187 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"golang.org/x/sync/errgroup"
+)
+
+// Fragments of the error messages that Execution clients and providers return when an eth_getLogs request covers too
+// many blocks or returns too many results
+var logRangeTooLargeMessages = []string{
+	"response too large",
+	"response size exceeded",
+	"query returned more than",
+	"block range is too large",
+	"block range too large",
+	"exceed maximum block range",
+	"exceeds max results",
+	"too many blocks",
+}
+
+// Scan the logs matching a filter query over a large range of blocks, splitting the range into chunks of at most
+// chunkSize blocks so it works with providers that limit the range of eth_getLogs requests.
+// Chunks are fetched in parallel, up to the manager's concurrent call limit. If a chunk fails because its response was
+// too large, it will be retried in halves until it succeeds or can't be split any further.
+// The handler is called once for each chunk in block order; if it returns an error, scanning will stop.
+// If the query's ToBlock is nil, the latest block will be used. Special block numbers such as rpc.LatestBlockNumber or
+// rpc.FinalizedBlockNumber are resolved against the chain before scanning. The query can't specify a BlockHash.
+func (q *QueryManager) ScanLogs(ctx context.Context, query ethereum.FilterQuery, chunkSize uint64, handler func([]types.Log) error) error {
+	if query.BlockHash != nil {
+		return fmt.Errorf("log scanning requires a block range, not a block hash")
+	}
+	if chunkSize == 0 {
+		return fmt.Errorf("chunk size must be greater than zero")
+	}
+
+	// Get the block range
+	var fromBlock uint64
+	if query.FromBlock != nil {
+		var err error
+		fromBlock, err = q.resolveBlockNumber(ctx, query.FromBlock)
+		if err != nil {
+			return fmt.Errorf("error resolving start block: %w", err)
+		}
+	}
+	toBlockNumber := query.ToBlock
+	if toBlockNumber == nil {
+		toBlockNumber = big.NewInt(int64(rpc.LatestBlockNumber))
+	}
+	toBlock, err := q.resolveBlockNumber(ctx, toBlockNumber)
+	if err != nil {
+		return fmt.Errorf("error resolving end block: %w", err)
+	}
+	if fromBlock > toBlock {
+		return nil
+	}
+
+	// Break the range into chunks
+	type blockRange struct {
+		from uint64
+		to   uint64
+	}
+	chunks := []blockRange{}
+	for start := fromBlock; start <= toBlock; start += chunkSize {
+		end := start + chunkSize - 1
+		if end > toBlock || end < start {
+			end = toBlock
+		}
+		chunks = append(chunks, blockRange{from: start, to: end})
+		if end == toBlock {
+			break
+		}
+	}
+
+	// Process the chunks in windows so the handler can be run in order without holding every log in memory
+	windowSize := q.concurrentCallLimit
+	if windowSize <= 0 {
+		windowSize = len(chunks)
+	}
+	for i := 0; i < len(chunks); i += windowSize {
+		windowEnd := i + windowSize
+		if windowEnd > len(chunks) {
+			windowEnd = len(chunks)
+		}
+		window := chunks[i:windowEnd]
+
+		// Fetch the logs for each chunk in the window
+		results := make([][]types.Log, len(window))
+		var wg errgroup.Group
+		for j, chunk := range window {
+			j, chunk := j, chunk
+			wg.Go(func() error {
+				logs, err := q.getLogsInRange(ctx, query, chunk.from, chunk.to)
+				if err != nil {
+					return err
+				}
+				results[j] = logs
+				return nil
+			})
+		}
+		if err := wg.Wait(); err != nil {
+			return fmt.Errorf("error scanning logs: %w", err)
+		}
+
+		// Handle them in order
+		for j, logs := range results {
+			err := handler(logs)
+			if err != nil {
+				return fmt.Errorf("error handling logs for blocks %d to %d: %w", window[j].from, window[j].to, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Get the block number for a query's block, resolving the special block numbers (such as rpc.LatestBlockNumber) against
+// the chain. Returns an error for negative block numbers that aren't one of the special values.
+func (q *QueryManager) resolveBlockNumber(ctx context.Context, number *big.Int) (uint64, error) {
+	if number.Sign() >= 0 {
+		if !number.IsUint64() {
+			return 0, fmt.Errorf("block number %s is too large", number.String())
+		}
+		return number.Uint64(), nil
+	}
+	if !number.IsInt64() {
+		return 0, fmt.Errorf("invalid block number %s", number.String())
+	}
+
+	switch rpc.BlockNumber(number.Int64()) {
+	case rpc.LatestBlockNumber:
+		latestBlock, err := q.client.BlockNumber(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("error getting latest block number: %w", err)
+		}
+		return latestBlock, nil
+	case rpc.PendingBlockNumber, rpc.SafeBlockNumber, rpc.FinalizedBlockNumber:
+		header, err := q.client.HeaderByNumber(ctx, number)
+		if err != nil {
+			return 0, fmt.Errorf("error getting header for block %s: %w", rpc.BlockNumber(number.Int64()).String(), err)
+		}
+		return header.Number.Uint64(), nil
+	default:
+		return 0, fmt.Errorf("invalid block number %s", number.String())
+	}
+}
+
+// Get the logs matching a query within a block range, splitting the range in half if the response is too large
+func (q *QueryManager) getLogsInRange(ctx context.Context, query ethereum.FilterQuery, from uint64, to uint64) ([]types.Log, error) {
+	query.FromBlock = new(big.Int).SetUint64(from)
+	query.ToBlock = new(big.Int).SetUint64(to)
+	logs, err := q.client.FilterLogs(ctx, query)
+	if err == nil {
+		return logs, nil
+	}
+	if from == to || !isLogRangeTooLargeError(err) {
+		return nil, fmt.Errorf("error getting logs for blocks %d to %d: %w", from, to, err)
+	}
+
+	// Retry with each half of the range
+	mid := from + (to-from)/2
+	firstLogs, err := q.getLogsInRange(ctx, query, from, mid)
+	if err != nil {
+		return nil, err
+	}
+	secondLogs, err := q.getLogsInRange(ctx, query, mid+1, to)
+	if err != nil {
+		return nil, err
+	}
+	return append(firstLogs, secondLogs...), nil
+}
+
+// Check if an error from eth_getLogs was caused by the requested range being too large
+func isLogRangeTooLargeError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range logRangeTooLargeMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// An Execution client that records the block ranges requested from it
+type logScannerClient struct {
+	IExecutionClient
+	latestBlock    uint64
+	finalizedBlock uint64
+
+	lock   sync.Mutex
+	ranges [][2]uint64
+}
+
+func (c *logScannerClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return c.latestBlock, nil
+}
+
+func (c *logScannerClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	switch rpc.BlockNumber(number.Int64()) {
+	case rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+		return &types.Header{Number: new(big.Int).SetUint64(c.finalizedBlock)}, nil
+	case rpc.PendingBlockNumber:
+		return &types.Header{Number: new(big.Int).SetUint64(c.latestBlock + 1)}, nil
+	}
+	return nil, errors.New("unexpected header request")
+}
+
+func (c *logScannerClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ranges = append(c.ranges, [2]uint64{query.FromBlock.Uint64(), query.ToBlock.Uint64()})
+	return []types.Log{{BlockNumber: query.FromBlock.Uint64()}}, nil
+}
+
+func TestScanLogsBlockRange(t *testing.T) {
+	number := func(value int64) *big.Int {
+		return big.NewInt(value)
+	}
+	special := func(value rpc.BlockNumber) *big.Int {
+		return big.NewInt(int64(value))
+	}
+
+	tests := []struct {
+		name           string
+		fromBlock      *big.Int
+		toBlock        *big.Int
+		expectedRanges [][2]uint64
+		isValid        bool
+	}{
+		{
+			name:           "explicit range",
+			fromBlock:      number(100),
+			toBlock:        number(125),
+			expectedRanges: [][2]uint64{{100, 109}, {110, 119}, {120, 125}},
+			isValid:        true,
+		}, {
+			name:           "nil end block",
+			fromBlock:      number(990),
+			expectedRanges: [][2]uint64{{990, 999}, {1000, 1000}},
+			isValid:        true,
+		}, {
+			name:           "latest end block",
+			fromBlock:      number(995),
+			toBlock:        special(rpc.LatestBlockNumber),
+			expectedRanges: [][2]uint64{{995, 1000}},
+			isValid:        true,
+		}, {
+			name:           "finalized end block",
+			fromBlock:      number(930),
+			toBlock:        special(rpc.FinalizedBlockNumber),
+			expectedRanges: [][2]uint64{{930, 936}},
+			isValid:        true,
+		}, {
+			name:           "earliest start block",
+			fromBlock:      special(rpc.EarliestBlockNumber),
+			toBlock:        number(5),
+			expectedRanges: [][2]uint64{{0, 5}},
+			isValid:        true,
+		}, {
+			name:           "latest start block",
+			fromBlock:      special(rpc.LatestBlockNumber),
+			expectedRanges: [][2]uint64{{1000, 1000}},
+			isValid:        true,
+		}, {
+			name:           "start after end",
+			fromBlock:      special(rpc.LatestBlockNumber),
+			toBlock:        special(rpc.FinalizedBlockNumber),
+			expectedRanges: nil,
+			isValid:        true,
+		}, {
+			name:      "unknown negative block",
+			fromBlock: number(-100),
+			toBlock:   number(5),
+		}, {
+			name:      "block beyond uint64",
+			fromBlock: number(0),
+			toBlock:   new(big.Int).Lsh(big.NewInt(1), 64),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &logScannerClient{
+				latestBlock:    1000,
+				finalizedBlock: 936,
+			}
+			q := NewQueryManager(client, common.Address{}, 2)
+			query := ethereum.FilterQuery{
+				FromBlock: test.fromBlock,
+				ToBlock:   test.toBlock,
+			}
+			handledBlocks := []uint64{}
+			err := q.ScanLogs(context.Background(), query, 10, func(logs []types.Log) error {
+				for _, entry := range logs {
+					handledBlocks = append(handledBlocks, entry.BlockNumber)
+				}
+				return nil
+			})
+			if !test.isValid {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				if len(client.ranges) != 0 {
+					t.Errorf("expected no logs to be requested, got %v", client.ranges)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// The chunks are fetched in parallel, but handled in order
+			if len(handledBlocks) != len(test.expectedRanges) {
+				t.Fatalf("expected ranges %v, got %v", test.expectedRanges, client.ranges)
+			}
+			for i, expected := range test.expectedRanges {
+				if handledBlocks[i] != expected[0] {
+					t.Errorf("expected chunk %d to start at block %d, got %d", i, expected[0], handledBlocks[i])
+				}
+				found := false
+				for _, requested := range client.ranges {
+					found = found || requested == expected
+				}
+				if !found {
+					t.Errorf("expected range %v to be requested, got %v", expected, client.ranges)
+				}
+			}
+		})
+	}
+}
@@ -2,16 +2,20 @@ package eth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -27,8 +31,36 @@ const (
 
 	// Prefix for errors caused by gas estimation
 	gasSimErrorPrefix string = "error estimating gas needed"
+
+	// How often to check the network's base fee when waiting for it to drop
+	GasPollInterval time.Duration = 12 * time.Second
+)
+
+var (
+	// The gas price for a transaction is higher than the transaction manager's ceiling
+	ErrGasTooHigh = errors.New("gas price is higher than the maximum allowed")
 )
 
+// Error returned when a transaction's gas price is higher than the transaction manager's ceiling.
+// It matches ErrGasTooHigh with errors.Is().
+type GasTooHighError struct {
+	// The gas price of the transaction, in wei
+	GasPrice *big.Int
+
+	// The maximum allowed gas price, in wei
+	MaxGasPrice *big.Int
+}
+
+// Get the error message
+func (e *GasTooHighError) Error() string {
+	return fmt.Sprintf("%s: %.2f gwei is higher than the limit of %.2f gwei", ErrGasTooHigh.Error(), WeiToGwei(e.GasPrice), WeiToGwei(e.MaxGasPrice))
+}
+
+// Check if the target error is ErrGasTooHigh
+func (e *GasTooHighError) Is(target error) bool {
+	return target == ErrGasTooHigh
+}
+
 // A simple calculator to bolster gas estimates to safe values, checking against the Ethereum gas block limit.
 type TransactionManager struct {
 	// Gwei ammount added to estimated gas limits, as a safety buffer
@@ -39,6 +71,12 @@ type TransactionManager struct {
 
 	// The client to use for running transaction simulations
 	client IExecutionClient
+
+	// The maximum gas price transactions can be submitted with, in wei; nil for no limit
+	maxGasPrice *big.Int
+
+	// Guards the max gas price, which can be changed while transactions are being sent
+	maxGasPriceLock *sync.RWMutex
 }
 
 // Creates a new transaction manager, which can simulate and execute transactions.
@@ -50,12 +88,115 @@ func NewTransactionManager(client IExecutionClient, safeGasBuffer uint64, safeGa
 	}
 
 	return &TransactionManager{
-		client:     client,
-		buffer:     safeGasBuffer,
-		multiplier: safeGasMultiplier,
+		client:          client,
+		buffer:          safeGasBuffer,
+		multiplier:      safeGasMultiplier,
+		maxGasPriceLock: &sync.RWMutex{},
 	}, nil
 }
 
+// =================
+// === Gas Price ===
+// =================
+
+// Set the maximum gas price that transactions can be submitted with, in wei. Transactions with a higher gas fee cap
+// (or a higher suggested gas price, if they don't have one set) will be rejected with a GasTooHighError.
+// Use nil to remove the limit.
+func (t *TransactionManager) SetMaxGasPrice(wei *big.Int) {
+	t.maxGasPriceLock.Lock()
+	defer t.maxGasPriceLock.Unlock()
+
+	if wei == nil {
+		t.maxGasPrice = nil
+		return
+	}
+	t.maxGasPrice = big.NewInt(0).Set(wei)
+}
+
+// Get the maximum gas price that transactions can be submitted with, in wei; nil means there's no limit
+func (t *TransactionManager) GetMaxGasPrice() *big.Int {
+	t.maxGasPriceLock.RLock()
+	defer t.maxGasPriceLock.RUnlock()
+
+	if t.maxGasPrice == nil {
+		return nil
+	}
+	return big.NewInt(0).Set(t.maxGasPrice)
+}
+
+// Wait until the network's base fee is at or below the provided threshold (in wei), checking it every GasPollInterval.
+// Returns an error if the context is cancelled before that happens.
+func (t *TransactionManager) WaitForAcceptableGas(ctx context.Context, threshold *big.Int) error {
+	for {
+		baseFee, err := t.getCurrentBaseFee(ctx)
+		if err != nil {
+			return err
+		}
+		if baseFee.Cmp(threshold) <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(GasPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("stopped waiting for the base fee to drop below %.2f gwei: %w", WeiToGwei(threshold), ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// Make sure the gas price for a transaction is below the ceiling, if there is one
+func (t *TransactionManager) checkGasPrice(opts *bind.TransactOpts) error {
+	// Use a copy of the ceiling so it can be changed while the gas price is being checked
+	maxGasPrice := t.GetMaxGasPrice()
+	if maxGasPrice == nil {
+		return nil
+	}
+
+	// Get the gas price that will be used
+	gasPrice := opts.GasFeeCap
+	if gasPrice == nil {
+		gasPrice = opts.GasPrice
+	}
+	if gasPrice == nil {
+		ctx := opts.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		var err error
+		gasPrice, err = t.client.SuggestGasPrice(ctx)
+		if err != nil {
+			return fmt.Errorf("error getting suggested gas price: %w", err)
+		}
+	}
+
+	if gasPrice.Cmp(maxGasPrice) > 0 {
+		return &GasTooHighError{
+			GasPrice:    big.NewInt(0).Set(gasPrice),
+			MaxGasPrice: maxGasPrice,
+		}
+	}
+	return nil
+}
+
+// Get the base fee of the latest block, falling back to the suggested gas price if the chain doesn't have one
+func (t *TransactionManager) getCurrentBaseFee(ctx context.Context) (*big.Int, error) {
+	header, err := t.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting latest block header: %w", err)
+	}
+	if header.BaseFee != nil {
+		return header.BaseFee, nil
+	}
+
+	gasPrice, err := t.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting suggested gas price: %w", err)
+	}
+	return gasPrice, nil
+}
+
 // ==================
 // === Simulation ===
 // ==================
@@ -189,7 +330,17 @@ func (t *TransactionManager) ExecuteTransaction(txInfo *TransactionInfo, opts *b
 
 // Create a transaction from serialized info, signs it, and submits it to the network if requested in opts.
 // Note the value in opts is not used; set it in the value argument instead.
+// If the transaction will be submitted and its gas price is higher than the ceiling set with SetMaxGasPrice, a
+// GasTooHighError will be returned instead.
 func (t *TransactionManager) ExecuteTransactionRaw(to common.Address, data []byte, value *big.Int, opts *bind.TransactOpts) (*types.Transaction, error) {
+	// Make sure the gas price is acceptable
+	if !opts.NoSend {
+		err := t.checkGasPrice(opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Create a "dummy" contract for the Geth API with no ABI since we don't need it for this
 	contract := bind.NewBoundContract(to, abi.ABI{}, t.client, t.client, t.client)
 
@@ -247,71 +398,121 @@ func (t *TransactionManager) BatchExecuteTransactions(txSubmissions []*Transacti
 // === Waiting ===
 // ===============
 
-// Wait for a transaction to get included in blocks
-func (t *TransactionManager) WaitForTransaction(tx *types.Transaction) error {
+// Wait for a transaction to get included in blocks, returning its receipt.
+// If the transaction reverted, the error will include the revert reason if it could be retrieved.
+func (t *TransactionManager) WaitForTransaction(tx *types.Transaction) (*types.Receipt, error) {
 	// Wait for transaction to be included
 	txReceipt, err := bind.WaitMined(context.Background(), t.client, tx)
 	if err != nil {
-		return fmt.Errorf("error running transaction %s: %w", tx.Hash().Hex(), err)
+		return nil, fmt.Errorf("error running transaction %s: %w", tx.Hash().Hex(), err)
 	}
 
 	// Check transaction status
-	if txReceipt.Status == 0 {
-		return fmt.Errorf("transaction %s failed with status 0", tx.Hash().Hex())
+	if txReceipt.Status == types.ReceiptStatusFailed {
+		reason, err := t.GetRevertReason(context.Background(), tx, txReceipt)
+		if err != nil {
+			return txReceipt, fmt.Errorf("transaction %s failed with status 0 (error getting revert reason: %s)", tx.Hash().Hex(), err.Error())
+		}
+		return txReceipt, fmt.Errorf("transaction %s failed with status 0: %s", tx.Hash().Hex(), reason)
 	}
 
 	// Return
-	return nil
+	return txReceipt, nil
 }
 
-// Wait for a set of transactions to get included in blocks
-func (t *TransactionManager) WaitForTransactions(txs []*types.Transaction) error {
+// Wait for a set of transactions to get included in blocks, returning their receipts in the same order
+func (t *TransactionManager) WaitForTransactions(txs []*types.Transaction) ([]*types.Receipt, error) {
 	var wg errgroup.Group
-	for _, tx := range txs {
-		tx := tx
+	receipts := make([]*types.Receipt, len(txs))
+	for i, tx := range txs {
+		i, tx := i, tx
 		wg.Go(func() error {
-			return t.WaitForTransaction(tx)
+			receipt, err := t.WaitForTransaction(tx)
+			receipts[i] = receipt
+			return err
 		})
 	}
 
 	err := wg.Wait()
 	if err != nil {
-		return fmt.Errorf("error waiting for transactions: %w", err)
+		return receipts, fmt.Errorf("error waiting for transactions: %w", err)
 	}
 
-	return nil
+	return receipts, nil
 }
 
-// Wait for a transaction to get included in blocks
-func (t *TransactionManager) WaitForTransactionByHash(hash common.Hash) error {
+// Wait for a transaction to get included in blocks, returning its receipt
+func (t *TransactionManager) WaitForTransactionByHash(hash common.Hash) (*types.Receipt, error) {
 	// Get the TX
 	tx, err := t.getTransactionFromHash(hash)
 	if err != nil {
-		return fmt.Errorf("error getting transaction %s: %w", hash.Hex(), err)
+		return nil, fmt.Errorf("error getting transaction %s: %w", hash.Hex(), err)
 	}
 
 	// Wait for transaction to be included
 	return t.WaitForTransaction(tx)
 }
 
-// Wait for a set of transactions to get included in blocks
-func (t *TransactionManager) WaitForTransactionsByHash(hashes []common.Hash) error {
+// Wait for a set of transactions to get included in blocks, returning their receipts in the same order
+func (t *TransactionManager) WaitForTransactionsByHash(hashes []common.Hash) ([]*types.Receipt, error) {
 	var wg errgroup.Group
+	receipts := make([]*types.Receipt, len(hashes))
 
-	// Get the TXs from the hashes
-	for _, hash := range hashes {
-		hash := hash
+	// Get the TXs from the hashes and wait for them
+	for i, hash := range hashes {
+		i, hash := i, hash
 		wg.Go(func() error {
-			return t.WaitForTransactionByHash(hash)
+			receipt, err := t.WaitForTransactionByHash(hash)
+			receipts[i] = receipt
+			return err
 		})
 	}
 	err := wg.Wait()
 	if err != nil {
-		return fmt.Errorf("error waiting for transactions: %w", err)
+		return receipts, fmt.Errorf("error waiting for transactions: %w", err)
 	}
 
-	// Wait for the TXs
-	return nil
+	return receipts, nil
+}
+
+// Get the reason a mined transaction reverted by replaying it with a call at the block it was included in.
+// Error(string) and Panic(uint256) revert data are decoded; other reasons are returned as reported by the client.
+// Note that the replay uses the state at the end of the block, so it may not match the original execution exactly if
+// other transactions in the same block changed the relevant state.
+func (t *TransactionManager) GetRevertReason(ctx context.Context, tx *types.Transaction, receipt *types.Receipt) (string, error) {
+	// Get the sender
+	signer := types.LatestSignerForChainID(tx.ChainId())
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return "", fmt.Errorf("error getting sender of transaction %s: %w", tx.Hash().Hex(), err)
+	}
+
+	// Replay the transaction
+	_, err = t.client.CallContract(ctx, ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}, receipt.BlockNumber)
+	if err == nil {
+		return "", fmt.Errorf("transaction %s did not revert when replayed at block %s", tx.Hash().Hex(), receipt.BlockNumber.String())
+	}
+
+	// Decode the revert data if the client provided it
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if hexData, ok := dataErr.ErrorData().(string); ok {
+			data, decodeErr := hexutil.Decode(hexData)
+			if decodeErr == nil && len(data) > 0 {
+				reason, decodeErr := decodeRevertData(data)
+				if decodeErr == nil {
+					return reason, nil
+				}
+			}
+		}
+	}
+	return normalizeRevertMessage(err).Error(), nil
 }
 
 // Get a TX from its hash
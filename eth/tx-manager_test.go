@@ -0,0 +1,64 @@
+package eth
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+func TestMaxGasPriceConcurrentUpdates(t *testing.T) {
+	txMgr, err := NewTransactionManager(nil, 0, 0)
+	if err != nil {
+		t.Fatalf("error creating transaction manager: %v", err)
+	}
+	txMgr.SetMaxGasPrice(GweiToWeiInt(big.NewInt(100)))
+
+	// Change the ceiling while gas prices are being checked, like a config reload during transaction submission
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if j%10 == 0 {
+					txMgr.SetMaxGasPrice(nil)
+				} else {
+					txMgr.SetMaxGasPrice(GweiToWeiInt(big.NewInt(int64(100 + i + j))))
+				}
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			opts := &bind.TransactOpts{
+				GasFeeCap: GweiToWeiInt(big.NewInt(50)),
+			}
+			for j := 0; j < 100; j++ {
+				if err := txMgr.checkGasPrice(opts); err != nil {
+					t.Errorf("unexpected error checking gas price: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The ceiling is still enforced after the updates
+	txMgr.SetMaxGasPrice(GweiToWeiInt(big.NewInt(10)))
+	err = txMgr.checkGasPrice(&bind.TransactOpts{GasFeeCap: GweiToWeiInt(big.NewInt(50))})
+	var gasErr *GasTooHighError
+	if !errors.As(err, &gasErr) {
+		t.Fatalf("expected a GasTooHighError, got %v", err)
+	}
+	if gasErr.MaxGasPrice.Cmp(GweiToWeiInt(big.NewInt(10))) != 0 {
+		t.Errorf("expected a ceiling of 10 gwei, got %s wei", gasErr.MaxGasPrice.String())
+	}
+
+	// Changing the returned ceiling doesn't change the manager's copy
+	gasErr.MaxGasPrice.SetUint64(0)
+	if maxGasPrice := txMgr.GetMaxGasPrice(); maxGasPrice.Cmp(GweiToWeiInt(big.NewInt(10))) != 0 {
+		t.Errorf("expected the ceiling to stay at 10 gwei, got %s wei", maxGasPrice.String())
+	}
+}
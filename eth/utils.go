@@ -1,22 +1,52 @@
 package eth
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"reflect"
 	"regexp"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	batch "github.com/rocket-pool/batch-query"
 )
 
 const (
 	// Regex to check for reversion messages from Nethermind
 	nethermindRevertRegexString string = "Reverted 0x(?P<message>[0-9a-fA-F]+).*"
+
+	// Regex to check for reversion messages from Geth
+	gethRevertRegexString string = "execution reverted: 0x(?P<message>[0-9a-fA-F]+).*"
+
+	// Regex to check for reversion messages from Besu
+	besuRevertRegexString string = "Execution reverted:? \\(?0x(?P<message>[0-9a-fA-F]+)\\)?.*"
 )
 
 var (
 	// Regex to check for reversion messages from Nethermind
 	nethermindRevertRegex *regexp.Regexp = regexp.MustCompile(nethermindRevertRegexString)
+
+	// Regex to check for reversion messages from Geth
+	gethRevertRegex *regexp.Regexp = regexp.MustCompile(gethRevertRegexString)
+
+	// Regex to check for reversion messages from Besu
+	besuRevertRegex *regexp.Regexp = regexp.MustCompile(besuRevertRegexString)
+
+	// All of the supported revert message formats
+	revertRegexes []*regexp.Regexp = []*regexp.Regexp{
+		nethermindRevertRegex,
+		gethRevertRegex,
+		besuRevertRegex,
+	}
+
+	// The selector for ABI-encoded Error(string) revert messages
+	revertErrorSelector []byte = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+	// The selector for ABI-encoded Panic(uint256) revert messages
+	revertPanicSelector []byte = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+	// The reflected type of IQueryable, used to check slice elements
+	queryableType reflect.Type = reflect.TypeOf((*IQueryable)(nil)).Elem()
 )
 
 // Create a transaction submission directly from serialized info (and the error provided by the transaction info constructor),
@@ -69,6 +99,23 @@ func QueryAllFields(object any, mc *batch.MultiCaller) {
 			} else if typeField.Type.Kind() == reflect.Struct {
 				// If it's a struct, recurse
 				QueryAllFields(field.Interface(), mc)
+			} else if typeField.Type.Kind() == reflect.Slice &&
+				typeField.Type.Elem().Implements(queryableType) {
+				// If it's a slice of IQueryables, run each element
+				for j := 0; j < field.Len(); j++ {
+					element := field.Index(j)
+					if (element.Kind() == reflect.Pointer || element.Kind() == reflect.Interface) && element.IsNil() {
+						continue
+					}
+					element.Interface().(IQueryable).AddToQuery(mc)
+				}
+			} else if typeField.Type.Kind() == reflect.Slice &&
+				reflect.PointerTo(typeField.Type.Elem()).Implements(queryableType) {
+				// If it's a slice of values that implement IQueryable with a pointer receiver, run each element through
+				// its address (slice elements are always addressable)
+				for j := 0; j < field.Len(); j++ {
+					field.Index(j).Addr().Interface().(IQueryable).AddToQuery(mc)
+				}
 			}
 		}
 	}
@@ -81,21 +128,41 @@ func normalizeRevertMessage(err error) error {
 	}
 
 	// Get the message in hex format, if it exists
-	matches := nethermindRevertRegex.FindStringSubmatch(err.Error())
-	if matches == nil {
-		return err
-	}
-	messageIndex := nethermindRevertRegex.SubexpIndex("message")
-	if messageIndex == -1 {
-		return err
+	for _, regex := range revertRegexes {
+		matches := regex.FindStringSubmatch(err.Error())
+		if matches == nil {
+			continue
+		}
+		messageIndex := regex.SubexpIndex("message")
+		if messageIndex == -1 {
+			continue
+		}
+		message, err2 := decodeRevertMessage(matches[messageIndex])
+		if err2 != nil {
+			return err // Return the original error if decoding failed somehow
+		}
+		return fmt.Errorf("reverted: %s", message)
 	}
-	message := matches[messageIndex]
+	return err
+}
 
-	// Convert the hex message to ASCII
-	bytes, err2 := hex.DecodeString(message)
-	if err2 != nil {
-		return err // Return the original error if decoding failed somehow
+// Decode a hex-encoded revert message, which is either an ABI-encoded Error(string) or Panic(uint256), or raw ASCII
+func decodeRevertMessage(message string) (string, error) {
+	bytes, err := hex.DecodeString(message)
+	if err != nil {
+		return "", err
 	}
+	return decodeRevertData(bytes)
+}
 
-	return fmt.Errorf("reverted: %s", string(bytes))
+// Decode revert data, which is either an ABI-encoded Error(string) or Panic(uint256), or raw ASCII
+func decodeRevertData(data []byte) (string, error) {
+	// Unpack it if it has the Error(string) or Panic(uint256) selector
+	if len(data) >= len(revertErrorSelector) {
+		selector := data[:len(revertErrorSelector)]
+		if bytes.Equal(selector, revertErrorSelector) || bytes.Equal(selector, revertPanicSelector) {
+			return abi.UnpackRevert(data)
+		}
+	}
+	return string(data), nil
 }
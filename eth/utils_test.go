@@ -0,0 +1,141 @@
+package eth
+
+import (
+	"errors"
+	"testing"
+
+	batch "github.com/rocket-pool/batch-query"
+)
+
+// Counts how many times AddToQuery is called on it, with a value receiver
+type valueQueryable struct {
+	calls *int
+}
+
+func (q valueQueryable) AddToQuery(mc *batch.MultiCaller) {
+	*q.calls++
+}
+
+// Counts how many times AddToQuery is called on it, with a pointer receiver
+type pointerQueryable struct {
+	calls int
+}
+
+func (q *pointerQueryable) AddToQuery(mc *batch.MultiCaller) {
+	q.calls++
+}
+
+type sliceFieldsBinding struct {
+	Values   []valueQueryable
+	Pointers []*pointerQueryable
+	Structs  []pointerQueryable
+	Ignored  []int
+}
+
+func TestQueryAllFieldsSlices(t *testing.T) {
+	valueCalls := 0
+	binding := &sliceFieldsBinding{
+		Values: []valueQueryable{
+			{calls: &valueCalls},
+			{calls: &valueCalls},
+		},
+		Pointers: []*pointerQueryable{
+			{},
+			nil,
+			{},
+		},
+		Structs: []pointerQueryable{
+			{},
+			{},
+			{},
+		},
+		Ignored: []int{1, 2, 3},
+	}
+
+	QueryAllFields(binding, nil)
+
+	if valueCalls != 2 {
+		t.Errorf("expected 2 calls on the value receiver slice, got %d", valueCalls)
+	}
+	for i, element := range binding.Pointers {
+		if element != nil && element.calls != 1 {
+			t.Errorf("expected 1 call on pointer element %d, got %d", i, element.calls)
+		}
+	}
+	for i, element := range binding.Structs {
+		if element.calls != 1 {
+			t.Errorf("expected 1 call on struct element %d with a pointer receiver, got %d", i, element.calls)
+		}
+	}
+}
+
+const (
+	// ABI-encoded Error("Minipool is not in a valid state")
+	testRevertErrorData string = "08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"4d696e69706f6f6c206973206e6f7420696e20612076616c6964207374617465"
+
+	// ABI-encoded Panic(0x11), an arithmetic overflow
+	testRevertPanicData string = "4e487b71" +
+		"0000000000000000000000000000000000000000000000000000000000000011"
+)
+
+func TestNormalizeRevertMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "nethermind",
+			err:      errors.New("Reverted 0x" + testRevertErrorData),
+			expected: "reverted: Minipool is not in a valid state",
+		}, {
+			name:     "nethermind raw ASCII",
+			err:      errors.New("Reverted 0x4d696e69706f6f6c206973206e6f7420696e20612076616c6964207374617465"),
+			expected: "reverted: Minipool is not in a valid state",
+		}, {
+			name:     "geth",
+			err:      errors.New("execution reverted: 0x" + testRevertErrorData),
+			expected: "reverted: Minipool is not in a valid state",
+		}, {
+			name:     "geth panic",
+			err:      errors.New("execution reverted: 0x" + testRevertPanicData),
+			expected: "reverted: arithmetic underflow or overflow",
+		}, {
+			name:     "besu",
+			err:      errors.New("Execution reverted: 0x" + testRevertErrorData),
+			expected: "reverted: Minipool is not in a valid state",
+		}, {
+			name:     "besu parenthesized",
+			err:      errors.New("Execution reverted (0x" + testRevertErrorData + ")"),
+			expected: "reverted: Minipool is not in a valid state",
+		}, {
+			name:     "unknown format",
+			err:      errors.New("insufficient funds for gas * price + value"),
+			expected: "insufficient funds for gas * price + value",
+		}, {
+			name:     "geth without revert data",
+			err:      errors.New("execution reverted"),
+			expected: "execution reverted",
+		}, {
+			name:     "undecodable revert data",
+			err:      errors.New("execution reverted: 0x08c379a0ff"),
+			expected: "execution reverted: 0x08c379a0ff",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := normalizeRevertMessage(test.err)
+			if result == nil || result.Error() != test.expected {
+				t.Errorf("expected [%s], got [%v]", test.expected, result)
+			}
+		})
+	}
+
+	if normalizeRevertMessage(nil) != nil {
+		t.Error("expected a nil error to stay nil")
+	}
+}
@@ -2,12 +2,13 @@ package log
 
 // API keys
 const (
-	OriginKey string = "origin"
-	MethodKey string = "method"
-	PathKey   string = "path"
-	QueryKey  string = "query"
-	CodeKey   string = "code"
-	CauseKey  string = "cause"
-	BodyKey   string = "body"
-	ErrorKey  string = "err"
+	OriginKey    string = "origin"
+	MethodKey    string = "method"
+	PathKey      string = "path"
+	QueryKey     string = "query"
+	CodeKey      string = "code"
+	CauseKey     string = "cause"
+	BodyKey      string = "body"
+	ErrorKey     string = "err"
+	RequestIdKey string = "requestId"
 )
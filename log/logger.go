@@ -1,8 +1,10 @@
 package log
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -15,46 +17,57 @@ type Logger struct {
 	*slog.Logger
 	logFile *lumberjack.Logger
 	path    string
+	level   *slog.LevelVar
 }
 
-// Creates a new logger that writes out to a log file on disk.
+// Creates a new logger that writes out to a log file on disk, stdout, or both depending on the output in the options.
+// If the logs are only written to stdout, the log file path is ignored.
 func NewLogger(logFilePath string, options LoggerOptions) (*Logger, error) {
-	// Make the file
-	err := os.MkdirAll(filepath.Dir(logFilePath), logDirMode)
-	if err != nil {
-		return nil, fmt.Errorf("error creating API log directory for [%s]: %w", logFilePath, err)
-	}
-	handle, _ := os.OpenFile(logFilePath, os.O_CREATE|os.O_RDWR, logFileMode)
-	handle.Close()
-
-	logFile := &lumberjack.Logger{
-		Filename:   logFilePath,
-		MaxSize:    options.MaxSize,
-		MaxBackups: options.MaxBackups,
-		MaxAge:     options.MaxAge,
-		LocalTime:  options.LocalTime,
-		Compress:   options.Compress,
+	var writer io.Writer
+	var logFile *lumberjack.Logger
+	switch options.Output {
+	case LogOutput_File, "":
+		var err error
+		logFile, err = newLogFile(logFilePath, options)
+		if err != nil {
+			return nil, err
+		}
+		writer = logFile
+	case LogOutput_Stdout:
+		writer = os.Stdout
+		logFilePath = ""
+	case LogOutput_Both:
+		var err error
+		logFile, err = newLogFile(logFilePath, options)
+		if err != nil {
+			return nil, err
+		}
+		writer = io.MultiWriter(logFile, os.Stdout)
+	default:
+		return nil, fmt.Errorf("unknown log output [%s]", options.Output)
 	}
 
-	// Create the logging options
-	logOptions := &slog.HandlerOptions{
-		AddSource:   options.AddSource,
-		Level:       options.Level,
-		ReplaceAttr: ReplaceTime,
-	}
+	// Create the logging options, using a variable level so it can be changed at runtime
+	logOptions := options.GetHandlerOptions()
+	level := &slog.LevelVar{}
+	level.Set(options.Level)
+	logOptions.Level = level
 
 	// Make the logger
 	var handler slog.Handler
 	switch options.Format {
 	case LogFormat_Json:
-		handler = slog.NewJSONHandler(logFile, logOptions)
-	case LogFormat_Logfmt:
-		handler = slog.NewTextHandler(logFile, logOptions)
+		handler = slog.NewJSONHandler(writer, logOptions)
+	case LogFormat_Logfmt, "":
+		handler = slog.NewTextHandler(writer, logOptions)
+	default:
+		return nil, fmt.Errorf("unknown log format [%s]", options.Format)
 	}
 	return &Logger{
 		Logger:  slog.New(handler),
 		logFile: logFile,
 		path:    logFilePath,
+		level:   level,
 	}, nil
 }
 
@@ -66,12 +79,38 @@ func NewDefaultLogger() *Logger {
 	}
 }
 
-// Get the path of the file this logger is writing to
+// Creates a new logger that discards everything written to it.
+// Useful for tests or other situations where a logger is required but its output isn't relevant.
+func NewNopLogger() *Logger {
+	return &Logger{
+		Logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+}
+
+// Creates a new logger that writes to an in-memory buffer instead of a file, logging all levels including debug.
+// Useful for tests that need to inspect the logger's output. The buffer isn't thread-safe, so it should only be read
+// once the logger is no longer in use.
+func NewMemLogger() (*Logger, *bytes.Buffer) {
+	buffer := &bytes.Buffer{}
+	level := &slog.LevelVar{}
+	level.Set(slog.LevelDebug)
+	logOptions := &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: ReplaceTime,
+	}
+	return &Logger{
+		Logger: slog.New(slog.NewTextHandler(buffer, logOptions)),
+		level:  level,
+	}, buffer
+}
+
+// Get the path of the file this logger is writing to; this is empty if it isn't writing to a file
 func (l *Logger) GetFilePath() string {
 	return l.path
 }
 
-// Rotate the log file, migrating the current file to an old backup and starting a new one
+// Rotate the log file, migrating the current file to an old backup and starting a new one.
+// This does nothing if the logger isn't writing to a file.
 func (l *Logger) Rotate() error {
 	if l.logFile != nil {
 		return l.logFile.Rotate()
@@ -79,6 +118,25 @@ func (l *Logger) Rotate() error {
 	return nil
 }
 
+// Get the minimum record level that will be logged.
+// Loggers that weren't created with a configurable level, such as the default logger, always report Info.
+func (l *Logger) GetLevel() slog.Level {
+	if l.level == nil {
+		return slog.LevelInfo
+	}
+	return l.level.Level()
+}
+
+// Set the minimum record level that will be logged, taking effect immediately for this logger and all of its subloggers.
+// Returns false if the logger wasn't created with a configurable level, such as the default logger.
+func (l *Logger) SetLevel(level slog.Level) bool {
+	if l.level == nil {
+		return false
+	}
+	l.level.Set(level)
+	return true
+}
+
 // Closes the log file
 func (l *Logger) Close() {
 	if l.logFile != nil {
@@ -94,6 +152,25 @@ func (l *Logger) CreateSubLogger(origin string) *Logger {
 	return &Logger{
 		Logger:  l.With(slog.String(OriginKey, origin)),
 		logFile: nil,
+		level:   l.level,
+	}
+}
+
+// Create a clone of the logger that prints each message with the ID of the API request in the context, if it has one.
+// The underlying file handle isn't copied, so calling Close() on the sublogger won't do anything.
+func (l *Logger) CreateRequestSubLogger(ctx context.Context) *Logger {
+	requestId, ok := RequestIdFromContext(ctx)
+	if !ok {
+		return &Logger{
+			Logger:  l.Logger,
+			logFile: nil,
+			level:   l.level,
+		}
+	}
+	return &Logger{
+		Logger:  l.With(slog.String(RequestIdKey, requestId)),
+		logFile: nil,
+		level:   l.level,
 	}
 }
 
@@ -107,3 +184,37 @@ func FromContext(ctx context.Context) (*Logger, bool) {
 	log, ok := ctx.Value(ContextLogKey).(*Logger)
 	return log, ok
 }
+
+// Creates a copy of the parent context with the API request ID put into the ContextRequestIdKey value
+func CreateContextWithRequestId(parent context.Context, requestId string) context.Context {
+	return context.WithValue(parent, ContextRequestIdKey, requestId)
+}
+
+// Retrieves the API request ID from the context
+func RequestIdFromContext(ctx context.Context) (string, bool) {
+	requestId, ok := ctx.Value(ContextRequestIdKey).(string)
+	return requestId, ok && requestId != ""
+}
+
+// Creates the log file on disk and a rotating writer for it
+func newLogFile(logFilePath string, options LoggerOptions) (*lumberjack.Logger, error) {
+	// Make the file
+	err := os.MkdirAll(filepath.Dir(logFilePath), logDirMode)
+	if err != nil {
+		return nil, fmt.Errorf("error creating API log directory for [%s]: %w", logFilePath, err)
+	}
+	handle, err := os.OpenFile(logFilePath, os.O_CREATE|os.O_RDWR, logFileMode)
+	if err != nil {
+		return nil, fmt.Errorf("error creating log file [%s]: %w", logFilePath, err)
+	}
+	handle.Close()
+
+	return &lumberjack.Logger{
+		Filename:   logFilePath,
+		MaxSize:    options.MaxSize,
+		MaxBackups: options.MaxBackups,
+		MaxAge:     options.MaxAge,
+		LocalTime:  options.LocalTime,
+		Compress:   options.Compress,
+	}, nil
+}
@@ -0,0 +1,232 @@
+package log
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+// Read a log file, failing the test if it can't be read
+func readLogFile(t *testing.T, path string) string {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading log file: %v", err)
+	}
+	return string(bytes)
+}
+
+func TestNewLoggerRotationSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		options LoggerOptions
+	}{
+		{
+			name: "defaults",
+			options: LoggerOptions{
+				MaxSize:    20,
+				MaxBackups: 3,
+				MaxAge:     90,
+			},
+		}, {
+			name: "local time and compression",
+			options: LoggerOptions{
+				MaxSize:    5,
+				MaxBackups: 10,
+				MaxAge:     7,
+				LocalTime:  true,
+				Compress:   true,
+			},
+		}, {
+			name: "written to both the file and stdout",
+			options: LoggerOptions{
+				Output:     LogOutput_Both,
+				MaxSize:    1,
+				MaxBackups: 0,
+				MaxAge:     0,
+				Compress:   true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "logs", "api.log")
+			logger, err := NewLogger(path, test.options)
+			if err != nil {
+				t.Fatalf("error creating logger: %v", err)
+			}
+			defer logger.Close()
+
+			logFile := logger.logFile
+			if logFile == nil {
+				t.Fatal("expected the logger to write to a rotating log file")
+			}
+			if logFile.Filename != path {
+				t.Errorf("expected filename %s, got %s", path, logFile.Filename)
+			}
+			if logFile.MaxSize != test.options.MaxSize {
+				t.Errorf("expected max size %d, got %d", test.options.MaxSize, logFile.MaxSize)
+			}
+			if logFile.MaxBackups != test.options.MaxBackups {
+				t.Errorf("expected max backups %d, got %d", test.options.MaxBackups, logFile.MaxBackups)
+			}
+			if logFile.MaxAge != test.options.MaxAge {
+				t.Errorf("expected max age %d, got %d", test.options.MaxAge, logFile.MaxAge)
+			}
+			if logFile.LocalTime != test.options.LocalTime {
+				t.Errorf("expected local time %t, got %t", test.options.LocalTime, logFile.LocalTime)
+			}
+			if logFile.Compress != test.options.Compress {
+				t.Errorf("expected compression %t, got %t", test.options.Compress, logFile.Compress)
+			}
+			if logger.GetFilePath() != path {
+				t.Errorf("expected file path %s, got %s", path, logger.GetFilePath())
+			}
+		})
+	}
+}
+
+func TestNewLoggerRotate(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "api.log")
+	logger, err := NewLogger(path, LoggerOptions{
+		MaxSize:    1,
+		MaxBackups: 1,
+	})
+	if err != nil {
+		t.Fatalf("error creating logger: %v", err)
+	}
+	defer logger.Close()
+
+	logger.Info("before rotation")
+	if err := logger.Rotate(); err != nil {
+		t.Fatalf("error rotating log file: %v", err)
+	}
+	logger.Info("after rotation")
+
+	// The old messages move to a backup, and the current file only has the new ones
+	if contents := readLogFile(t, path); strings.Contains(contents, "before rotation") || !strings.Contains(contents, "after rotation") {
+		t.Errorf("expected only the new message in the current log file, got %s", contents)
+	}
+	backups, err := filepath.Glob(filepath.Join(dir, "api-*.log"))
+	if err != nil {
+		t.Fatalf("error listing backups: %v", err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("expected 1 backup, got %v", backups)
+	}
+	if contents := readLogFile(t, backups[0]); !strings.Contains(contents, "before rotation") {
+		t.Errorf("expected the old message in the backup, got %s", contents)
+	}
+}
+
+func TestNewLoggerRotateCompress(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "api.log")
+	logger, err := NewLogger(path, LoggerOptions{
+		MaxSize:  1,
+		Compress: true,
+	})
+	if err != nil {
+		t.Fatalf("error creating logger: %v", err)
+	}
+	defer logger.Close()
+
+	logger.Info("before rotation")
+	if err := logger.Rotate(); err != nil {
+		t.Fatalf("error rotating log file: %v", err)
+	}
+
+	// Lumberjack compresses backups in the background
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		compressed, err := filepath.Glob(filepath.Join(dir, "api-*.log.gz"))
+		if err != nil {
+			t.Fatalf("error listing backups: %v", err)
+		}
+		if len(compressed) == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the rotated log file to be compressed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNewLoggerFormatAndLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api.log")
+	logger, err := NewLogger(path, LoggerOptions{
+		Format: LogFormat_Json,
+		Level:  slog.LevelWarn,
+	})
+	if err != nil {
+		t.Fatalf("error creating logger: %v", err)
+	}
+	defer logger.Close()
+	logger.Info("filtered")
+	logger.Warn("logged", slog.String("key", "value"))
+
+	contents := strings.TrimSpace(readLogFile(t, path))
+	if strings.Contains(contents, "filtered") {
+		t.Errorf("expected messages below the configured level to be filtered, got %s", contents)
+	}
+	var record map[string]any
+	if err := json.Unmarshal([]byte(contents), &record); err != nil {
+		t.Fatalf("expected a JSON log record, got %s: %v", contents, err)
+	}
+	if record["msg"] != "logged" || record["level"] != "WARN" || record["key"] != "value" {
+		t.Errorf("unexpected log record: %v", record)
+	}
+
+	// Lowering the level at runtime takes effect immediately
+	logger.SetLevel(slog.LevelDebug)
+	logger.Debug("debug message")
+	if contents := readLogFile(t, path); !strings.Contains(contents, "debug message") {
+		t.Errorf("expected the debug message after lowering the level, got %s", contents)
+	}
+}
+
+func TestNewLoggerStdout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api.log")
+	logger, err := NewLogger(path, LoggerOptions{
+		Output: LogOutput_Stdout,
+	})
+	if err != nil {
+		t.Fatalf("error creating logger: %v", err)
+	}
+	if logger.logFile != nil || logger.GetFilePath() != "" {
+		t.Error("expected a stdout logger not to use a log file")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the log file not to be created, got %v", err)
+	}
+}
+
+func TestNewLoggerInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options LoggerOptions
+	}{
+		{
+			name:    "unknown format",
+			options: LoggerOptions{Format: "xml"},
+		}, {
+			name:    "unknown output",
+			options: LoggerOptions{Output: "syslog"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := NewLogger(filepath.Join(t.TempDir(), "api.log"), test.options); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -13,8 +13,26 @@ const (
 	LogFormat_Json LogFormat = "json"
 )
 
+// Destination for log output
+type LogOutput string
+
+const (
+	// Write logs to a file, with rotation
+	LogOutput_File LogOutput = "file"
+
+	// Write logs to stdout
+	LogOutput_Stdout LogOutput = "stdout"
+
+	// Write logs to both a file and stdout
+	LogOutput_Both LogOutput = "both"
+)
+
 // Options for logging
 type LoggerOptions struct {
+	// Where to write the logs; if empty, they'll be written to the log file.
+	// The Lumberjack options only apply when a log file is used.
+	Output LogOutput
+
 	// === Lumberjack Options ===
 
 	// The maximum size (in megabytes) of the log file before it gets rotated
@@ -45,3 +63,12 @@ type LoggerOptions struct {
 	// True to include the source code position of the log statement in log messages
 	AddSource bool
 }
+
+// Get the slog handler options corresponding to these logger options
+func (o LoggerOptions) GetHandlerOptions() *slog.HandlerOptions {
+	return &slog.HandlerOptions{
+		AddSource:   o.AddSource,
+		Level:       o.Level,
+		ReplaceAttr: ReplaceTime,
+	}
+}
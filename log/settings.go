@@ -6,6 +6,9 @@ const (
 	// The key used in contexts to retrieve the logger that should be used
 	ContextLogKey NmcContextKey = "nmc_logger"
 
+	// The key used in contexts to retrieve the ID of the API request being handled
+	ContextRequestIdKey NmcContextKey = "nmc_request_id"
+
 	// Lumberjack settings
 	MaxLogSize    int = 20
 	MaxLogBackups int = 3
@@ -1,10 +1,20 @@
 package log
 
 import (
+	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 )
 
+// The names of the log levels that can be selected, in order of verbosity
+var levelNames = map[string]slog.Level{
+	"debug": slog.LevelDebug,
+	"info":  slog.LevelInfo,
+	"warn":  slog.LevelWarn,
+	"error": slog.LevelError,
+}
+
 // Prints an error to a log line
 func Err(err error) slog.Attr {
 	msg := ""
@@ -30,3 +40,17 @@ func WithoutTimeAndLevel(groups []string, a slog.Attr) slog.Attr {
 	}
 	return a
 }
+
+// Parses the name of a log level (debug, info, warn, or error), ignoring case
+func ParseLevel(name string) (slog.Level, error) {
+	level, exists := levelNames[strings.ToLower(strings.TrimSpace(name))]
+	if !exists {
+		return slog.LevelInfo, fmt.Errorf("invalid log level [%s]; must be one of debug, info, warn, or error", name)
+	}
+	return level, nil
+}
+
+// Gets the name of a log level in the format accepted by ParseLevel
+func FormatLevel(level slog.Level) string {
+	return strings.ToLower(level.String())
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/rocket-pool/node-manager-core/api/types"
 	"github.com/rocket-pool/node-manager-core/beacon"
+	bnclient "github.com/rocket-pool/node-manager-core/beacon/client"
+	"github.com/rocket-pool/node-manager-core/log"
 )
 
 // This is a proxy for multiple Beacon clients, providing natural fallback support if one of them fails.
@@ -18,28 +20,38 @@ type BeaconClientManager struct {
 	fallbackReady   bool
 	expectedChainID uint
 	fallbackEnabled bool
+
+	// The timeout applied to each call that doesn't already have a deadline
+	timeout time.Duration
+
+	// The timeout applied instead for calls with large responses, such as validators and committees
+	largeResponseTimeout time.Duration
 }
 
 // Creates a new BeaconClientManager instance
 func NewBeaconClientManager(primaryBc beacon.IBeaconClient, chainID uint, clientTimeout time.Duration) *BeaconClientManager {
 	return &BeaconClientManager{
-		primaryBc:       primaryBc,
-		primaryReady:    true,
-		fallbackReady:   false,
-		expectedChainID: chainID,
-		fallbackEnabled: false,
+		primaryBc:            primaryBc,
+		primaryReady:         true,
+		fallbackReady:        false,
+		expectedChainID:      chainID,
+		fallbackEnabled:      false,
+		timeout:              clientTimeout,
+		largeResponseTimeout: bnclient.DefaultLargeResponseTimeout,
 	}
 }
 
 // Creates a new BeaconClientManager instance with a fallback client
 func NewBeaconClientManagerWithFallback(primaryBc beacon.IBeaconClient, fallbackBc beacon.IBeaconClient, chainID uint, clientTimeout time.Duration) *BeaconClientManager {
 	return &BeaconClientManager{
-		primaryBc:       primaryBc,
-		fallbackBc:      fallbackBc,
-		primaryReady:    true,
-		fallbackReady:   true,
-		expectedChainID: chainID,
-		fallbackEnabled: true,
+		primaryBc:            primaryBc,
+		fallbackBc:           fallbackBc,
+		primaryReady:         true,
+		fallbackReady:        true,
+		expectedChainID:      chainID,
+		fallbackEnabled:      true,
+		timeout:              clientTimeout,
+		largeResponseTimeout: bnclient.DefaultLargeResponseTimeout,
 	}
 }
 
@@ -71,6 +83,16 @@ func (m *BeaconClientManager) GetClientTypeName() string {
 	return "Beacon Node"
 }
 
+func (m *BeaconClientManager) GetClientTimeout() time.Duration {
+	return m.timeout
+}
+
+// Set the timeout for calls with large responses, such as validators and committees, that don't already have a
+// deadline. Defaults to the Beacon client's default large response timeout.
+func (m *BeaconClientManager) SetLargeResponseTimeout(timeout time.Duration) {
+	m.largeResponseTimeout = timeout
+}
+
 func (m *BeaconClientManager) SetPrimaryReady(ready bool) {
 	m.primaryReady = ready
 }
@@ -85,137 +107,239 @@ func (m *BeaconClientManager) SetFallbackReady(ready bool) {
 
 // Get the client's sync status
 func (m *BeaconClientManager) GetSyncStatus(ctx context.Context) (beacon.SyncStatus, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (beacon.SyncStatus, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.SyncStatus, error) {
 		return client.GetSyncStatus(ctx)
 	})
 }
 
 // Get the Beacon configuration
 func (m *BeaconClientManager) GetEth2Config(ctx context.Context) (beacon.Eth2Config, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (beacon.Eth2Config, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.Eth2Config, error) {
 		return client.GetEth2Config(ctx)
 	})
 }
 
 // Get the Beacon configuration
 func (m *BeaconClientManager) GetEth2DepositContract(ctx context.Context) (beacon.Eth2DepositContract, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (beacon.Eth2DepositContract, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.Eth2DepositContract, error) {
 		return client.GetEth2DepositContract(ctx)
 	})
 }
 
 // Get the attestations in a Beacon chain block
 func (m *BeaconClientManager) GetAttestations(ctx context.Context, blockId string) ([]beacon.AttestationInfo, bool, error) {
-	return runFunction2(m, ctx, func(client beacon.IBeaconClient) ([]beacon.AttestationInfo, bool, error) {
+	return runFunction2(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) ([]beacon.AttestationInfo, bool, error) {
 		return client.GetAttestations(ctx, blockId)
 	})
 }
 
 // Get a Beacon chain block
 func (m *BeaconClientManager) GetBeaconBlock(ctx context.Context, blockId string) (beacon.BeaconBlock, bool, error) {
-	return runFunction2(m, ctx, func(client beacon.IBeaconClient) (beacon.BeaconBlock, bool, error) {
+	return runFunction2(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.BeaconBlock, bool, error) {
 		return client.GetBeaconBlock(ctx, blockId)
 	})
 }
 
 // Get the header of a Beacon chain block
 func (m *BeaconClientManager) GetBeaconBlockHeader(ctx context.Context, blockId string) (beacon.BeaconBlockHeader, bool, error) {
-	return runFunction2(m, ctx, func(client beacon.IBeaconClient) (beacon.BeaconBlockHeader, bool, error) {
+	return runFunction2(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.BeaconBlockHeader, bool, error) {
 		return client.GetBeaconBlockHeader(ctx, blockId)
 	})
 }
 
 // Get the Beacon chain's head information
 func (m *BeaconClientManager) GetBeaconHead(ctx context.Context) (beacon.BeaconHead, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (beacon.BeaconHead, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.BeaconHead, error) {
 		return client.GetBeaconHead(ctx)
 	})
 }
 
 // Get a validator's status by its index
 func (m *BeaconClientManager) GetValidatorStatusByIndex(ctx context.Context, index string, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (beacon.ValidatorStatus, error) {
+	return runFunction1WithTimeout(m, ctx, m.largeResponseTimeout, func(ctx context.Context, client beacon.IBeaconClient) (beacon.ValidatorStatus, error) {
 		return client.GetValidatorStatusByIndex(ctx, index, opts)
 	})
 }
 
 // Get a validator's status by its pubkey
 func (m *BeaconClientManager) GetValidatorStatus(ctx context.Context, pubkey beacon.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (beacon.ValidatorStatus, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (beacon.ValidatorStatus, error) {
+	return runFunction1WithTimeout(m, ctx, m.largeResponseTimeout, func(ctx context.Context, client beacon.IBeaconClient) (beacon.ValidatorStatus, error) {
 		return client.GetValidatorStatus(ctx, pubkey, opts)
 	})
 }
 
 // Get the statuses of multiple validators by their pubkeys
 func (m *BeaconClientManager) GetValidatorStatuses(ctx context.Context, pubkeys []beacon.ValidatorPubkey, opts *beacon.ValidatorStatusOptions) (map[beacon.ValidatorPubkey]beacon.ValidatorStatus, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (map[beacon.ValidatorPubkey]beacon.ValidatorStatus, error) {
+	return runFunction1WithTimeout(m, ctx, m.largeResponseTimeout, func(ctx context.Context, client beacon.IBeaconClient) (map[beacon.ValidatorPubkey]beacon.ValidatorStatus, error) {
 		return client.GetValidatorStatuses(ctx, pubkeys, opts)
 	})
 }
 
 // Get a validator's index
 func (m *BeaconClientManager) GetValidatorIndex(ctx context.Context, pubkey beacon.ValidatorPubkey) (string, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (string, error) {
+	return runFunction1WithTimeout(m, ctx, m.largeResponseTimeout, func(ctx context.Context, client beacon.IBeaconClient) (string, error) {
 		return client.GetValidatorIndex(ctx, pubkey)
 	})
 }
 
 // Get a validator's sync duties
 func (m *BeaconClientManager) GetValidatorSyncDuties(ctx context.Context, indices []string, epoch uint64) (map[string]bool, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (map[string]bool, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (map[string]bool, error) {
 		return client.GetValidatorSyncDuties(ctx, indices, epoch)
 	})
 }
 
+// Get the positions that each validator holds in the sync committee
+func (m *BeaconClientManager) GetValidatorSyncCommitteeIndices(ctx context.Context, indices []string, epoch uint64) (map[string][]uint64, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (map[string][]uint64, error) {
+		return client.GetValidatorSyncCommitteeIndices(ctx, indices, epoch)
+	})
+}
+
+// Get the sync committee for a state
+func (m *BeaconClientManager) GetSyncCommittee(ctx context.Context, stateId string, epoch *uint64) (beacon.SyncCommittee, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.SyncCommittee, error) {
+		return client.GetSyncCommittee(ctx, stateId, epoch)
+	})
+}
+
 // Get a validator's proposer duties
 func (m *BeaconClientManager) GetValidatorProposerDuties(ctx context.Context, indices []string, epoch uint64) (map[string]uint64, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (map[string]uint64, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (map[string]uint64, error) {
 		return client.GetValidatorProposerDuties(ctx, indices, epoch)
 	})
 }
 
+// Get the slots that each validator is scheduled to propose in
+func (m *BeaconClientManager) GetValidatorProposerSlots(ctx context.Context, indices []string, epoch uint64) (map[string][]uint64, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (map[string][]uint64, error) {
+		return client.GetValidatorProposerSlots(ctx, indices, epoch)
+	})
+}
+
 // Get the Beacon chain's domain data
 func (m *BeaconClientManager) GetDomainData(ctx context.Context, domainType []byte, epoch uint64, useGenesisFork bool) ([]byte, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) ([]byte, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) ([]byte, error) {
 		return client.GetDomainData(ctx, domainType, epoch, useGenesisFork)
 	})
 }
 
+// Get the Beacon chain's domain data using the fork of a state
+func (m *BeaconClientManager) GetDomainDataForState(ctx context.Context, domainType []byte, epoch uint64, stateId string) ([]byte, error) {
+	return runFunction1(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) ([]byte, error) {
+		return client.GetDomainDataForState(ctx, domainType, epoch, stateId)
+	})
+}
+
+// Get the fork of a Beacon chain state
+func (m *BeaconClientManager) GetFork(ctx context.Context, stateId string) (beacon.ForkInfo, bool, error) {
+	return runFunction2(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.ForkInfo, bool, error) {
+		return client.GetFork(ctx, stateId)
+	})
+}
+
 // Voluntarily exit a validator
 func (m *BeaconClientManager) ExitValidator(ctx context.Context, validatorIndex string, epoch uint64, signature beacon.ValidatorSignature) error {
-	return runFunction0(m, ctx, func(client beacon.IBeaconClient) error {
+	return runFunction0(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) error {
 		return client.ExitValidator(ctx, validatorIndex, epoch, signature)
 	})
 }
 
-// Close the connection to the Beacon client
+// Close the connections to the primary and fallback Beacon clients
 func (m *BeaconClientManager) Close(ctx context.Context) error {
-	return runFunction0(m, ctx, func(client beacon.IBeaconClient) error {
-		return client.Close(ctx)
-	})
+	err := m.primaryBc.Close(ctx)
+	if err != nil {
+		return fmt.Errorf("error closing primary Beacon client: %w", err)
+	}
+	if m.fallbackBc != nil {
+		err = m.fallbackBc.Close(ctx)
+		if err != nil {
+			return fmt.Errorf("error closing fallback Beacon client: %w", err)
+		}
+	}
+	return nil
 }
 
 // Get the EL data for a CL block
 func (m *BeaconClientManager) GetEth1DataForEth2Block(ctx context.Context, blockId string) (beacon.Eth1Data, bool, error) {
-	return runFunction2(m, ctx, func(client beacon.IBeaconClient) (beacon.Eth1Data, bool, error) {
+	return runFunction2(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) (beacon.Eth1Data, bool, error) {
 		return client.GetEth1DataForEth2Block(ctx, blockId)
 	})
 }
 
 // Get the attestation committees for an epoch
 func (m *BeaconClientManager) GetCommitteesForEpoch(ctx context.Context, epoch *uint64) (beacon.Committees, error) {
-	return runFunction1(m, ctx, func(client beacon.IBeaconClient) (beacon.Committees, error) {
+	return runFunction1WithTimeout(m, ctx, m.largeResponseTimeout, func(ctx context.Context, client beacon.IBeaconClient) (beacon.Committees, error) {
 		return client.GetCommitteesForEpoch(ctx, epoch)
 	})
 }
 
 // Change the withdrawal credentials for a validator
 func (m *BeaconClientManager) ChangeWithdrawalCredentials(ctx context.Context, validatorIndex string, fromBlsPubkey beacon.ValidatorPubkey, toExecutionAddress common.Address, signature beacon.ValidatorSignature) error {
-	return runFunction0(m, ctx, func(client beacon.IBeaconClient) error {
+	return runFunction0(m, ctx, func(ctx context.Context, client beacon.IBeaconClient) error {
 		return client.ChangeWithdrawalCredentials(ctx, validatorIndex, fromBlsPubkey, toExecutionAddress, signature)
 	})
 }
 
+// Subscribe to events from the Beacon Node's event stream.
+// If the stream from the client in use is abandoned while the context is still alive, that client is flagged as not
+// ready and the subscription is re-established on the other one. The channel is closed once the context is cancelled
+// or no client can provide the stream.
+func (m *BeaconClientManager) SubscribeToEvents(ctx context.Context, topics []beacon.EventTopic) (<-chan beacon.BeaconEvent, error) {
+	var usingPrimary bool
+	subscribe := func() (<-chan beacon.BeaconEvent, error) {
+		// The stream outlives this call, so it uses the caller's context instead of the one with the client timeout
+		return runFunction1(m, ctx, func(_ context.Context, client beacon.IBeaconClient) (<-chan beacon.BeaconEvent, error) {
+			usingPrimary = (client == m.primaryBc)
+			return client.SubscribeToEvents(ctx, topics)
+		})
+	}
+
+	stream, err := subscribe()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan beacon.BeaconEvent)
+	go func() {
+		defer close(events)
+		logger, hasLogger := log.FromContext(ctx)
+		for {
+			for event := range stream {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+
+			// The stream was abandoned, so move to the other client
+			if usingPrimary {
+				m.SetPrimaryReady(false)
+				if hasLogger {
+					logger.Warn("Primary Beacon Node event stream failed, re-subscribing...")
+				}
+			} else {
+				m.SetFallbackReady(false)
+				if hasLogger {
+					logger.Warn("Fallback Beacon Node event stream failed, re-subscribing...")
+				}
+			}
+			stream, err = subscribe()
+			if err != nil {
+				if hasLogger {
+					logger.Warn("Error re-subscribing to Beacon Node events", log.Err(err))
+				}
+				return
+			}
+		}
+	}()
+	return events, nil
+}
+
 /// =================
 /// Manager Functions
 /// =================
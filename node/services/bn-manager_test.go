@@ -0,0 +1,196 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+	"github.com/rocket-pool/node-manager-core/beacon/client/mock"
+	"github.com/rocket-pool/node-manager-core/log"
+	"github.com/rocket-pool/node-manager-core/node/services"
+)
+
+const (
+	// A timeout long enough that the Beacon client's own HTTP timeouts never trigger in these tests
+	testHttpTimeout time.Duration = 30 * time.Second
+)
+
+// Create a fake Beacon Node that waits for the delay (or until the request is aborted) before responding.
+// Returns the server and a channel that receives a value whenever a request is aborted before the delay passes.
+func newSlowBeaconNode(t *testing.T, delay time.Duration) (*httptest.Server, <-chan struct{}) {
+	aborted := make(chan struct{}, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			aborted <- struct{}{}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case client.RequestSyncStatusPath:
+			_, _ = w.Write([]byte(`{"data":{"is_syncing":false,"head_slot":"100","sync_distance":"0"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[{"index":"0","slot":"3200","validators":["1","2"]}]}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, aborted
+}
+
+// Create a Beacon client for a fake Beacon Node
+func newTestBeaconClient(server *httptest.Server) *client.StandardHttpClient {
+	return client.NewStandardHttpClient(server.URL, testHttpTimeout, client.WithLargeResponseTimeout(testHttpTimeout))
+}
+
+// Create a context with a logger, since the function runners log failovers to it
+func newTestContext() context.Context {
+	return log.NewDefaultLogger().CreateContextWithLogger(context.Background())
+}
+
+// Wait for the fake Beacon Node to report that a request was aborted
+func waitForAbort(t *testing.T, aborted <-chan struct{}) {
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the hung request to be aborted on the server")
+	}
+}
+
+func TestClientTimeoutAbortsHungRequest(t *testing.T) {
+	server, aborted := newSlowBeaconNode(t, time.Hour)
+	manager := services.NewBeaconClientManager(newTestBeaconClient(server), 1, 100*time.Millisecond)
+
+	start := time.Now()
+	_, err := manager.GetSyncStatus(newTestContext())
+	if err == nil {
+		t.Fatal("expected the hung request to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to be aborted by the client timeout, took %s", elapsed)
+	}
+	waitForAbort(t, aborted)
+}
+
+func TestLargeResponseTimeoutReplacesClientTimeout(t *testing.T) {
+	// The response takes longer than the client timeout but not the large response timeout
+	server, _ := newSlowBeaconNode(t, 300*time.Millisecond)
+	manager := services.NewBeaconClientManager(newTestBeaconClient(server), 1, 50*time.Millisecond)
+	manager.SetLargeResponseTimeout(5 * time.Second)
+
+	epoch := uint64(100)
+	committees, err := manager.GetCommitteesForEpoch(newTestContext(), &epoch)
+	if err != nil {
+		t.Fatalf("expected the large response timeout to be used for committees: %v", err)
+	}
+	defer committees.Release()
+	if committees.Count() != 1 {
+		t.Errorf("expected 1 committee, got %d", committees.Count())
+	}
+}
+
+func TestLargeResponseTimeoutAbortsHungRequest(t *testing.T) {
+	server, aborted := newSlowBeaconNode(t, time.Hour)
+	manager := services.NewBeaconClientManager(newTestBeaconClient(server), 1, time.Hour)
+	manager.SetLargeResponseTimeout(100 * time.Millisecond)
+
+	epoch := uint64(100)
+	start := time.Now()
+	_, err := manager.GetCommitteesForEpoch(newTestContext(), &epoch)
+	if err == nil {
+		t.Fatal("expected the hung committees request to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the request to be aborted by the large response timeout, took %s", elapsed)
+	}
+	waitForAbort(t, aborted)
+}
+
+func TestLargeResponseTimeoutPerAttempt(t *testing.T) {
+	// The primary hangs until it times out; the fallback still gets the full timeout for its own attempt
+	primary, aborted := newSlowBeaconNode(t, time.Hour)
+	fallback, _ := newSlowBeaconNode(t, 150*time.Millisecond)
+	manager := services.NewBeaconClientManagerWithFallback(newTestBeaconClient(primary), newTestBeaconClient(fallback), 1, 10*time.Millisecond)
+	manager.SetLargeResponseTimeout(250 * time.Millisecond)
+
+	epoch := uint64(100)
+	committees, err := manager.GetCommitteesForEpoch(newTestContext(), &epoch)
+	if err != nil {
+		t.Fatalf("expected the fallback to get its own timeout: %v", err)
+	}
+	defer committees.Release()
+	if committees.Count() != 1 {
+		t.Errorf("expected 1 committee from the fallback, got %d", committees.Count())
+	}
+	waitForAbort(t, aborted)
+}
+
+func TestCallerDeadlineTakesPrecedence(t *testing.T) {
+	server, aborted := newSlowBeaconNode(t, time.Hour)
+	manager := services.NewBeaconClientManager(newTestBeaconClient(server), 1, time.Hour)
+
+	ctx, cancel := context.WithTimeout(newTestContext(), 100*time.Millisecond)
+	defer cancel()
+	_, err := manager.GetSyncStatus(ctx)
+	if err == nil {
+		t.Fatal("expected the request to fail when the caller's deadline passed")
+	}
+	waitForAbort(t, aborted)
+}
+
+func TestGetForkThroughManager(t *testing.T) {
+	provider := mock.NewMockBeaconApiProvider()
+	var fork client.ForkResponse
+	fork.Data.PreviousVersion = client.ByteArray{0x03, 0, 0, 0}
+	fork.Data.CurrentVersion = client.ByteArray{0x04, 0, 0, 0}
+	fork.Data.Epoch = 269568
+	provider.SetForkResponse(fork)
+	manager := services.NewBeaconClientManager(client.NewStandardClient(provider), 1, testHttpTimeout)
+
+	info, exists, err := manager.GetFork(newTestContext(), "head")
+	if err != nil {
+		t.Fatalf("error getting fork: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected the state to exist")
+	}
+	if !bytes.Equal(info.CurrentVersion, []byte{0x04, 0, 0, 0}) || info.Epoch != 269568 {
+		t.Errorf("unexpected fork %+v", info)
+	}
+	if count := provider.GetCallCount("Beacon_Fork"); count != 1 {
+		t.Errorf("expected 1 Beacon_Fork call, got %d", count)
+	}
+
+	// Domain lookups go through the same client as the direct call
+	var spec client.Eth2ConfigResponse
+	spec.Data.SecondsPerSlot = 12
+	spec.Data.SlotsPerEpoch = 32
+	for _, epoch := range []**client.Uinteger{&spec.Data.AltairForkEpoch, &spec.Data.BellatrixForkEpoch, &spec.Data.CapellaForkEpoch, &spec.Data.DenebForkEpoch, &spec.Data.ElectraForkEpoch} {
+		farFuture := client.Uinteger(beacon.FarFutureEpoch)
+		*epoch = &farFuture
+	}
+	provider.SetSpecResponse(spec)
+	var genesis client.GenesisResponse
+	genesis.Data.GenesisValidatorsRoot = bytes.Repeat([]byte{0x4b}, 32)
+	provider.SetGenesisResponse(genesis)
+	bc := client.NewStandardClient(provider)
+	expected, err := bc.GetDomainDataForState(context.Background(), []byte{0, 0, 0, 0}, 269568, "head")
+	if err != nil {
+		t.Fatalf("error getting domain data from the client: %v", err)
+	}
+	domain, err := manager.GetDomainDataForState(newTestContext(), []byte{0, 0, 0, 0}, 269568, "head")
+	if err != nil {
+		t.Fatalf("error getting domain data: %v", err)
+	}
+	if !bytes.Equal(domain, expected) {
+		t.Errorf("expected domain %x, got %x", expected, domain)
+	}
+	if count := provider.GetCallCount("Beacon_Fork"); count != 3 {
+		t.Errorf("expected each domain lookup to request the state's fork, got %d Beacon_Fork calls", count)
+	}
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// The state of a Docker container
+type ContainerStatus struct {
+	// True if the container is currently running
+	Running bool
+
+	// The exit code of the container's last run, if it has stopped
+	ExitCode int
+
+	// The time the container was last started; zero if it's never been started
+	StartedAt time.Time
+
+	// The time the container last stopped; zero if it's never stopped
+	FinishedAt time.Time
+}
+
+// Get the status of a Docker container, such as one running an Ethereum client
+func (p *ServiceProvider) GetDockerContainerStatus(ctx context.Context, containerName string) (ContainerStatus, error) {
+	info, err := p.docker.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return ContainerStatus{}, fmt.Errorf("error inspecting container [%s]: %w", containerName, err)
+	}
+	if info.ContainerJSONBase == nil || info.State == nil {
+		return ContainerStatus{}, fmt.Errorf("container [%s] didn't report its state", containerName)
+	}
+
+	startedAt, err := parseDockerTime(info.State.StartedAt)
+	if err != nil {
+		return ContainerStatus{}, fmt.Errorf("error parsing start time of container [%s]: %w", containerName, err)
+	}
+	finishedAt, err := parseDockerTime(info.State.FinishedAt)
+	if err != nil {
+		return ContainerStatus{}, fmt.Errorf("error parsing finish time of container [%s]: %w", containerName, err)
+	}
+
+	return ContainerStatus{
+		Running:    info.State.Running,
+		ExitCode:   info.State.ExitCode,
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
+	}, nil
+}
+
+// Parse a timestamp reported by the Docker API. Docker reports unset times as the zero time, so they come back as a
+// zero time.Time.
+func parseDockerTime(timestamp string) (time.Time, error) {
+	if timestamp == "" {
+		return time.Time{}, nil
+	}
+	parsedTime, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if parsedTime.IsZero() {
+		return time.Time{}, nil
+	}
+	return parsedTime, nil
+}
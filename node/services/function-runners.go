@@ -3,22 +3,30 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/rocket-pool/node-manager-core/log"
 )
 
-// This is a signature for a wrapped function that only returns an error
-type function0[ClientType any] func(ClientType) error
+// This is a signature for a wrapped function that only returns an error.
+// The context passed to it includes the manager's client timeout, so it should be used for the client call.
+type function0[ClientType any] func(context.Context, ClientType) error
 
 // This is a signature for a wrapped function that returns 1 var and an error
-type function1[ClientType any, ReturnType any] func(ClientType) (ReturnType, error)
+type function1[ClientType any, ReturnType any] func(context.Context, ClientType) (ReturnType, error)
 
 // This is a signature for a wrapped function that returns 2 vars and an error
-type function2[ClientType any, ReturnType1 any, ReturnType2 any] func(ClientType) (ReturnType1, ReturnType2, error)
+type function2[ClientType any, ReturnType1 any, ReturnType2 any] func(context.Context, ClientType) (ReturnType1, ReturnType2, error)
 
 // Attempts to run a function progressively through each client until one succeeds or they all fail.
 // Expects functions with 1 output and an error; for functions with other signatures, see the other runFunctionX functions.
 func runFunction1[ClientType any, ReturnType any](m iClientManagerImpl[ClientType], ctx context.Context, function function1[ClientType, ReturnType]) (ReturnType, error) {
+	return runFunction1WithTimeout(m, ctx, m.GetClientTimeout(), function)
+}
+
+// Like runFunction1, but applies the provided timeout to each attempt instead of the manager's client timeout.
+// Each client gets its own deadline, so a primary that hangs until it times out doesn't use up the fallback's time.
+func runFunction1WithTimeout[ClientType any, ReturnType any](m iClientManagerImpl[ClientType], ctx context.Context, timeout time.Duration, function function1[ClientType, ReturnType]) (ReturnType, error) {
 	logger, _ := log.FromContext(ctx)
 	var blank ReturnType
 	typeName := m.GetClientTypeName()
@@ -26,14 +34,15 @@ func runFunction1[ClientType any, ReturnType any](m iClientManagerImpl[ClientTyp
 	// Check if we can use the primary
 	if m.IsPrimaryReady() {
 		// Try to run the function on the primary
-		result, err := function(m.GetPrimaryClient())
+		result, err := runWithTimeout(ctx, timeout, m.GetPrimaryClient(), function)
 		if err != nil {
-			if isDisconnected(err) {
+			// Errors from the caller's own context being cancelled or expiring aren't the client's fault
+			if ctx.Err() == nil && IsDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				m.SetPrimaryReady(false)
 				if m.IsFallbackEnabled() {
 					logger.Warn("Primary "+typeName+" client disconnected, using fallback...", log.Err(err))
-					return runFunction1[ClientType, ReturnType](m, ctx, function)
+					return runFunction1WithTimeout[ClientType, ReturnType](m, ctx, timeout, function)
 				} else {
 					logger.Warn("Primary "+typeName+" disconnected and no fallback is configured.", log.Err(err))
 					return blank, fmt.Errorf("all " + typeName + "s failed")
@@ -48,9 +57,9 @@ func runFunction1[ClientType any, ReturnType any](m iClientManagerImpl[ClientTyp
 
 	if m.IsFallbackReady() {
 		// Try to run the function on the fallback
-		result, err := function(m.GetFallbackClient())
+		result, err := runWithTimeout(ctx, timeout, m.GetFallbackClient(), function)
 		if err != nil {
-			if isDisconnected(err) {
+			if ctx.Err() == nil && IsDisconnected(err) {
 				// If it's disconnected, log it and try the fallback
 				logger.Warn("Fallback "+typeName+" disconnected", log.Err(err))
 				m.SetFallbackReady(false)
@@ -69,8 +78,8 @@ func runFunction1[ClientType any, ReturnType any](m iClientManagerImpl[ClientTyp
 
 // Run a function with 0 outputs and an error
 func runFunction0[ClientType any](m iClientManagerImpl[ClientType], ctx context.Context, function function0[ClientType]) error {
-	_, err := runFunction1(m, ctx, func(client ClientType) (any, error) {
-		return nil, function(client)
+	_, err := runFunction1(m, ctx, func(ctx context.Context, client ClientType) (any, error) {
+		return nil, function(ctx, client)
 	})
 	return err
 }
@@ -81,8 +90,8 @@ func runFunction2[ClientType any, ReturnType1 any, ReturnType2 any](m iClientMan
 		arg1 ReturnType1
 		arg2 ReturnType2
 	}
-	result, err := runFunction1(m, ctx, func(client ClientType) (out, error) {
-		arg1, arg2, err := function(client)
+	result, err := runFunction1(m, ctx, func(ctx context.Context, client ClientType) (out, error) {
+		arg1, arg2, err := function(ctx, client)
 		return out{
 			arg1: arg1,
 			arg2: arg2,
@@ -90,3 +99,19 @@ func runFunction2[ClientType any, ReturnType1 any, ReturnType2 any](m iClientMan
 	})
 	return result.arg1, result.arg2, err
 }
+
+// Run a function on a single client. If the context doesn't have a deadline, the timeout is applied so a hung client
+// can't stall the call forever.
+func runWithTimeout[ClientType any, ReturnType any](ctx context.Context, timeout time.Duration, client ClientType, function function1[ClientType, ReturnType]) (ReturnType, error) {
+	ctx, cancel := contextWithDefaultTimeout(ctx, timeout)
+	defer cancel()
+	return function(ctx, client)
+}
+
+// Add a timeout to the context if it doesn't already have a deadline. The timeout is ignored if it isn't positive.
+func contextWithDefaultTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, hasDeadline := ctx.Deadline(); hasDeadline || timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
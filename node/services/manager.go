@@ -1,5 +1,7 @@
 package services
 
+import "time"
+
 type IClientManager[ClientType any] interface {
 	GetPrimaryClient() ClientType
 	GetFallbackClient() ClientType
@@ -7,6 +9,7 @@ type IClientManager[ClientType any] interface {
 	IsFallbackReady() bool
 	IsFallbackEnabled() bool
 	GetClientTypeName() string
+	GetClientTimeout() time.Duration
 }
 
 type iClientManagerImpl[ClientType any] interface {
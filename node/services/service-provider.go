@@ -5,27 +5,47 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"time"
 
 	dclient "github.com/docker/docker/client"
 	"github.com/ethereum/go-ethereum/ethclient"
+	apitypes "github.com/rocket-pool/node-manager-core/api/types"
 	"github.com/rocket-pool/node-manager-core/beacon/client"
 	"github.com/rocket-pool/node-manager-core/config"
 	"github.com/rocket-pool/node-manager-core/eth"
 	"github.com/rocket-pool/node-manager-core/log"
-	"github.com/rocket-pool/node-manager-core/node/wallet"
+	nodewallet "github.com/rocket-pool/node-manager-core/node/wallet"
+	"github.com/rocket-pool/node-manager-core/wallet"
 )
 
 const (
+	// The Docker API version used when one isn't specified in the Docker options
 	DockerApiVersion string = "1.40"
 )
 
+// Settings for the Docker client used by the service provider
+type DockerOptions struct {
+	// The Docker API version to use; if empty, DockerApiVersion is used
+	ApiVersion string
+
+	// The path of the Docker daemon's socket; if empty, the default location is used
+	SocketPath string
+}
+
+// A function that loads the latest config, such as by reading the config file from disk
+type ConfigLoader func() (config.IConfig, error)
+
+// A function that's called after the service provider has been reloaded, so dependent services can refresh their
+// references to it
+type ReloadHook func(ctx context.Context, p *ServiceProvider) error
+
 // A container for all of the various services used by the node service
 type ServiceProvider struct {
 	// Services
 	cfg        config.IConfig
 	resources  *config.NetworkResources
-	nodeWallet *wallet.Wallet
+	nodeWallet *nodewallet.Wallet
 	ecManager  *ExecutionClientManager
 	bcManager  *BeaconClientManager
 	docker     dclient.APIClient
@@ -39,51 +59,74 @@ type ServiceProvider struct {
 	// Logging
 	apiLogger   *log.Logger
 	tasksLogger *log.Logger
+
+	// The time the service provider was created
+	startTime time.Time
+
+	// Reloading
+	clientTimeout time.Duration
+	configLoader  ConfigLoader
+	reloadHooks   []ReloadHook
+	lock          *sync.RWMutex
 }
 
-// Creates a new ServiceProvider instance based on the given config
-func NewServiceProvider(cfg config.IConfig, clientTimeout time.Duration) (*ServiceProvider, error) {
+// Creates a new ServiceProvider instance based on the given config.
+// The config is validated first, so any invalid settings are reported together before any services are created.
+// If dockerOpts is nil, the Docker client uses DockerApiVersion and the default socket location.
+func NewServiceProvider(cfg config.IConfig, clientTimeout time.Duration, dockerOpts *DockerOptions) (*ServiceProvider, error) {
+	// Validate the config
+	err := config.Validate(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
 	resources := cfg.GetNetworkResources()
 
 	// EC Manager
-	var ecManager *ExecutionClientManager
-	primaryEcUrl, fallbackEcUrl := cfg.GetExecutionClientUrls()
-	primaryEc, err := ethclient.Dial(primaryEcUrl)
+	ecManager, err := createExecutionClientManager(cfg, resources, clientTimeout)
 	if err != nil {
-		return nil, fmt.Errorf("error connecting to primary EC at [%s]: %w", primaryEcUrl, err)
-	}
-	if fallbackEcUrl != "" {
-		// Get the fallback EC url, if applicable
-		fallbackEc, err := ethclient.Dial(fallbackEcUrl)
-		if err != nil {
-			return nil, fmt.Errorf("error connecting to fallback EC at [%s]: %w", fallbackEcUrl, err)
-		}
-		ecManager = NewExecutionClientManagerWithFallback(primaryEc, fallbackEc, resources.ChainID, clientTimeout)
-	} else {
-		ecManager = NewExecutionClientManager(primaryEc, resources.ChainID, clientTimeout)
+		return nil, err
 	}
 
 	// Beacon manager
-	var bcManager *BeaconClientManager
-	primaryBnUrl, fallbackBnUrl := cfg.GetBeaconNodeUrls()
-	primaryBc := client.NewStandardHttpClient(primaryBnUrl, clientTimeout)
-	if fallbackBnUrl != "" {
-		fallbackBc := client.NewStandardHttpClient(fallbackBnUrl, clientTimeout)
-		bcManager = NewBeaconClientManagerWithFallback(primaryBc, fallbackBc, resources.ChainID, clientTimeout)
-	} else {
-		bcManager = NewBeaconClientManager(primaryBc, resources.ChainID, clientTimeout)
-	}
+	bcManager := createBeaconClientManager(cfg, resources, clientTimeout)
 
 	// Docker client
-	dockerClient, err := dclient.NewClientWithOpts(dclient.WithVersion(DockerApiVersion))
+	dockerClient, err := NewDockerClient(dockerOpts)
 	if err != nil {
-		return nil, fmt.Errorf("error creating Docker client: %w", err)
+		return nil, err
 	}
 
-	return NewServiceProviderWithCustomServices(cfg, resources, ecManager, bcManager, dockerClient)
+	provider, err := NewServiceProviderWithCustomServices(cfg, resources, ecManager, bcManager, dockerClient)
+	if err != nil {
+		return nil, err
+	}
+	provider.clientTimeout = clientTimeout
+	return provider, nil
 }
 
-// Creates a new ServiceProvider instance with custom services instead of creating them from the config
+// Creates a new Docker client with the provided options, or the defaults if they're nil
+func NewDockerClient(opts *DockerOptions) (dclient.APIClient, error) {
+	apiVersion := DockerApiVersion
+	clientOpts := []dclient.Opt{}
+	if opts != nil {
+		if opts.ApiVersion != "" {
+			apiVersion = opts.ApiVersion
+		}
+		if opts.SocketPath != "" {
+			clientOpts = append(clientOpts, dclient.WithHost("unix://"+opts.SocketPath))
+		}
+	}
+	clientOpts = append(clientOpts, dclient.WithVersion(apiVersion))
+
+	dockerClient, err := dclient.NewClientWithOpts(clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Docker client: %w", err)
+	}
+	return dockerClient, nil
+}
+
+// Creates a new ServiceProvider instance with custom services instead of creating them from the config.
+// The Docker client can be created with NewDockerClient to apply custom Docker options.
 func NewServiceProviderWithCustomServices(cfg config.IConfig, resources *config.NetworkResources, ecManager *ExecutionClientManager, bcManager *BeaconClientManager, dockerClient dclient.APIClient) (*ServiceProvider, error) {
 	// Make the API logger
 	loggerOpts := cfg.GetLoggerOptions()
@@ -102,24 +145,17 @@ func NewServiceProviderWithCustomServices(cfg config.IConfig, resources *config.
 	nodeAddressPath := filepath.Join(cfg.GetNodeAddressFilePath())
 	walletDataPath := filepath.Join(cfg.GetWalletFilePath())
 	passwordPath := filepath.Join(cfg.GetPasswordFilePath())
-	nodeWallet, err := wallet.NewWallet(tasksLogger.Logger, walletDataPath, nodeAddressPath, passwordPath, resources.ChainID)
+	nodeWallet, err := nodewallet.NewWallet(tasksLogger.Logger, walletDataPath, nodeAddressPath, passwordPath, resources.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("error creating node wallet: %w", err)
 	}
 
-	// TX Manager
-	txMgr, err := eth.NewTransactionManager(ecManager, eth.DefaultSafeGasBuffer, eth.DefaultSafeGasMultiplier)
+	// TX and Query Managers
+	txMgr, queryMgr, err := createEthManagers(resources, ecManager)
 	if err != nil {
-		return nil, fmt.Errorf("error creating transaction manager: %w", err)
+		return nil, err
 	}
 
-	// Query Manager - set the default concurrent run limit to half the CPUs so the EC doesn't get overwhelmed
-	concurrentCallLimit := runtime.NumCPU() / 2
-	if concurrentCallLimit < 1 {
-		concurrentCallLimit = 1
-	}
-	queryMgr := eth.NewQueryManager(ecManager, resources.MulticallAddress, concurrentCallLimit)
-
 	// Context for handling task cancellation during shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -141,37 +177,213 @@ func NewServiceProviderWithCustomServices(cfg config.IConfig, resources *config.
 		cancel:      cancel,
 		apiLogger:   apiLogger,
 		tasksLogger: tasksLogger,
+		startTime:   time.Now(),
+		lock:        &sync.RWMutex{},
 	}
 	return provider, nil
 }
 
 // Closes the service provider and its underlying services
 func (p *ServiceProvider) Close() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.ecManager.Close()
+	err := p.bcManager.Close(context.Background())
+	if err != nil {
+		p.apiLogger.Warn("Error closing Beacon client manager", log.Err(err))
+	}
 	p.apiLogger.Close()
 	p.tasksLogger.Close()
 }
 
+// Set the function used to load the latest config when the service provider is reloaded
+func (p *ServiceProvider) SetConfigLoader(loader ConfigLoader) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.configLoader = loader
+}
+
+// Register a hook to run after the service provider is reloaded. Hooks run in the order they were registered.
+func (p *ServiceProvider) OnReload(hook ReloadHook) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.reloadHooks = append(p.reloadHooks, hook)
+}
+
+// Reload the config with the config loader and apply it without restarting the daemon.
+// The new config is validated first, and nothing changes if it's invalid.
+// Log levels are updated in place. If the Execution client or Beacon Node URLs changed, new client managers (and the
+// transaction and query managers that depend on them) are created; otherwise the existing ones and their connections
+// are kept. Changing the network requires a restart, so a config for a different chain is rejected.
+// After the new config is applied, the reload hooks are run.
+func (p *ServiceProvider) Reload(ctx context.Context) error {
+	hooks, err := p.applyNewConfig()
+	if err != nil {
+		return err
+	}
+
+	// Run the hooks without the lock so they can use the getters
+	for i, hook := range hooks {
+		err := hook(ctx, p)
+		if err != nil {
+			return fmt.Errorf("error running reload hook %d: %w", i, err)
+		}
+	}
+	p.apiLogger.Info("Reloaded service provider.")
+	return nil
+}
+
+// Load the latest config and apply it to the service provider, returning the reload hooks to run afterwards
+func (p *ServiceProvider) applyNewConfig() ([]ReloadHook, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.configLoader == nil {
+		return nil, fmt.Errorf("service provider doesn't have a config loader")
+	}
+
+	// Load and validate the new config
+	cfg, err := p.configLoader()
+	if err != nil {
+		return nil, fmt.Errorf("error loading config: %w", err)
+	}
+	err = config.Validate(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration:\n%w", err)
+	}
+	resources := cfg.GetNetworkResources()
+	if resources.ChainID != p.resources.ChainID {
+		return nil, fmt.Errorf("config is for chain ID %d but the service provider is running on chain ID %d; a restart is required to change networks", resources.ChainID, p.resources.ChainID)
+	}
+
+	// Recreate the EC manager if its URLs changed
+	ecManager := p.ecManager
+	txMgr := p.txMgr
+	queryMgr := p.queryMgr
+	oldPrimaryEcUrl, oldFallbackEcUrl := p.cfg.GetExecutionClientUrls()
+	newPrimaryEcUrl, newFallbackEcUrl := cfg.GetExecutionClientUrls()
+	if oldPrimaryEcUrl != newPrimaryEcUrl || oldFallbackEcUrl != newFallbackEcUrl {
+		ecManager, err = createExecutionClientManager(cfg, resources, p.clientTimeout)
+		if err != nil {
+			return nil, err
+		}
+		txMgr, queryMgr, err = createEthManagers(resources, ecManager)
+		if err != nil {
+			ecManager.Close()
+			return nil, err
+		}
+
+		// Keep the settings that were applied to the old transaction manager
+		txMgr.SetMaxGasPrice(p.txMgr.GetMaxGasPrice())
+	}
+
+	// Recreate the BN manager if its URLs changed
+	bcManager := p.bcManager
+	oldPrimaryBnUrl, oldFallbackBnUrl := p.cfg.GetBeaconNodeUrls()
+	newPrimaryBnUrl, newFallbackBnUrl := cfg.GetBeaconNodeUrls()
+	if oldPrimaryBnUrl != newPrimaryBnUrl || oldFallbackBnUrl != newFallbackBnUrl {
+		bcManager = createBeaconClientManager(cfg, resources, p.clientTimeout)
+	}
+
+	// Update the log levels
+	loggerOpts := cfg.GetLoggerOptions()
+	p.apiLogger.SetLevel(loggerOpts.Level)
+	p.tasksLogger.SetLevel(loggerOpts.Level)
+
+	// Apply the new services, closing the connections of any that were replaced
+	if ecManager != p.ecManager {
+		p.ecManager.Close()
+	}
+	if bcManager != p.bcManager {
+		err = p.bcManager.Close(context.Background())
+		if err != nil {
+			p.apiLogger.Warn("Error closing old Beacon client manager", log.Err(err))
+		}
+	}
+	p.cfg = cfg
+	p.resources = resources
+	p.ecManager = ecManager
+	p.txMgr = txMgr
+	p.queryMgr = queryMgr
+	p.bcManager = bcManager
+
+	hooks := make([]ReloadHook, len(p.reloadHooks))
+	copy(hooks, p.reloadHooks)
+	return hooks, nil
+}
+
+// Get the combined health of the clients, the node wallet, and the daemon itself
+func (p *ServiceProvider) GetHealthStatus(ctx context.Context) *apitypes.HealthStatus {
+	ecManager := p.GetEthClient()
+	bcManager := p.GetBeaconClient()
+
+	// Get the client statuses in parallel since they can be slow
+	var wg sync.WaitGroup
+	var ecStatus *apitypes.ClientManagerStatus
+	var bnStatus *apitypes.ClientManagerStatus
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		ecStatus = ecManager.CheckStatus(ctx, true)
+	}()
+	go func() {
+		defer wg.Done()
+		bnStatus = bcManager.CheckStatus(ctx, true)
+	}()
+	wg.Wait()
+
+	status := &apitypes.HealthStatus{
+		ExecutionClientStatus: *ecStatus,
+		BeaconNodeStatus:      *bnStatus,
+		UptimeSeconds:         time.Since(p.startTime).Seconds(),
+	}
+
+	// Get the wallet status
+	walletStatus, err := p.nodeWallet.GetStatus()
+	if err != nil {
+		p.apiLogger.Warn("Error getting wallet status for health check", log.Err(err))
+		status.SigningCapability = wallet.SigningCapability_None
+	} else {
+		status.WalletStatus = walletStatus
+		status.SigningCapability = wallet.GetSigningCapability(walletStatus)
+	}
+
+	status.IsHealthy = isClientManagerHealthy(status.ExecutionClientStatus) &&
+		isClientManagerHealthy(status.BeaconNodeStatus) &&
+		status.SigningCapability == wallet.SigningCapability_Full
+	return status
+}
+
 // ===============
 // === Getters ===
 // ===============
 
 func (p *ServiceProvider) GetConfig() config.IConfig {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	return p.cfg
 }
 
 func (p *ServiceProvider) GetNetworkResources() *config.NetworkResources {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	return p.resources
 }
 
-func (p *ServiceProvider) GetWallet() *wallet.Wallet {
+func (p *ServiceProvider) GetWallet() *nodewallet.Wallet {
 	return p.nodeWallet
 }
 
 func (p *ServiceProvider) GetEthClient() *ExecutionClientManager {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	return p.ecManager
 }
 
 func (p *ServiceProvider) GetBeaconClient() *BeaconClientManager {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	return p.bcManager
 }
 
@@ -180,10 +392,14 @@ func (p *ServiceProvider) GetDocker() dclient.APIClient {
 }
 
 func (p *ServiceProvider) GetTransactionManager() *eth.TransactionManager {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	return p.txMgr
 }
 
 func (p *ServiceProvider) GetQueryManager() *eth.QueryManager {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	return p.queryMgr
 }
 
@@ -202,3 +418,61 @@ func (p *ServiceProvider) GetBaseContext() context.Context {
 func (p *ServiceProvider) CancelContextOnShutdown() {
 	p.cancel()
 }
+
+// ===============
+// === Helpers ===
+// ===============
+
+// Create an Execution client manager for the URLs in the config
+func createExecutionClientManager(cfg config.IConfig, resources *config.NetworkResources, clientTimeout time.Duration) (*ExecutionClientManager, error) {
+	primaryEcUrl, fallbackEcUrl := cfg.GetExecutionClientUrls()
+	primaryEc, err := ethclient.Dial(primaryEcUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to primary EC at [%s]: %w", primaryEcUrl, err)
+	}
+	if fallbackEcUrl != "" {
+		// Get the fallback EC url, if applicable
+		fallbackEc, err := ethclient.Dial(fallbackEcUrl)
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to fallback EC at [%s]: %w", fallbackEcUrl, err)
+		}
+		return NewExecutionClientManagerWithFallback(primaryEc, fallbackEc, resources.ChainID, clientTimeout), nil
+	}
+	return NewExecutionClientManager(primaryEc, resources.ChainID, clientTimeout), nil
+}
+
+// Create a Beacon Node manager for the URLs in the config
+func createBeaconClientManager(cfg config.IConfig, resources *config.NetworkResources, clientTimeout time.Duration) *BeaconClientManager {
+	primaryBnUrl, fallbackBnUrl := cfg.GetBeaconNodeUrls()
+	primaryBc := client.NewStandardHttpClient(primaryBnUrl, clientTimeout)
+	if fallbackBnUrl != "" {
+		fallbackBc := client.NewStandardHttpClient(fallbackBnUrl, clientTimeout)
+		return NewBeaconClientManagerWithFallback(primaryBc, fallbackBc, resources.ChainID, clientTimeout)
+	}
+	return NewBeaconClientManager(primaryBc, resources.ChainID, clientTimeout)
+}
+
+// Check if a client manager has a working, synced client to use
+func isClientManagerHealthy(status apitypes.ClientManagerStatus) bool {
+	isClientHealthy := func(clientStatus apitypes.ClientStatus) bool {
+		return clientStatus.IsWorking && clientStatus.IsSynced && clientStatus.Error == ""
+	}
+	return isClientHealthy(status.PrimaryClientStatus) || (status.FallbackEnabled && isClientHealthy(status.FallbackClientStatus))
+}
+
+// Create the transaction and query managers that use the provided Execution client manager
+func createEthManagers(resources *config.NetworkResources, ecManager *ExecutionClientManager) (*eth.TransactionManager, *eth.QueryManager, error) {
+	// TX Manager
+	txMgr, err := eth.NewTransactionManager(ecManager, eth.DefaultSafeGasBuffer, eth.DefaultSafeGasMultiplier)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating transaction manager: %w", err)
+	}
+
+	// Query Manager - set the default concurrent run limit to half the CPUs so the EC doesn't get overwhelmed
+	concurrentCallLimit := runtime.NumCPU() / 2
+	if concurrentCallLimit < 1 {
+		concurrentCallLimit = 1
+	}
+	queryMgr := eth.NewQueryManager(ecManager, resources.MulticallAddress, concurrentCallLimit)
+	return txMgr, queryMgr, nil
+}
@@ -3,10 +3,14 @@ package services
 import (
 	"context"
 	"errors"
+	"io"
 	"net"
+	"net/http"
 	"syscall"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
 	"github.com/rocket-pool/node-manager-core/eth"
 )
 
@@ -41,12 +45,66 @@ func GetEthClientLatestBlockTimestamp(ec eth.IExecutionClient) (uint64, error) {
 	return header.Time, nil
 }
 
-// Returns true if the error was a connection failure and a backup client is available
-func isDisconnected(err error) bool {
+// Returns true if the error means the client couldn't be reached or stopped responding properly, so the request
+// should be retried on a fallback client.
+// This covers socket errors (such as refused or reset connections), network errors and timeouts, connections that were
+// dropped mid-response, and 5xx responses from the Beacon Node or Execution Client (such as from a proxy in front of a
+// dead client). Errors that come from the request itself, such as 4xx responses, and requests cancelled by the caller
+// aren't considered disconnections.
+// Note that this can't tell whether a timeout came from the transport or the caller's own context, so callers should
+// check their context before failing over.
+func IsDisconnected(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// The caller cancelled the request, so the client isn't at fault
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	// Socket errors, such as ECONNREFUSED and ECONNRESET
 	var sysErr syscall.Errno
 	if errors.As(err, &sysErr) {
 		return true
 	}
+
+	// Network errors, such as failed dials and DNS lookups
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	// Timeouts, including url.Error timeouts
 	var netErr net.Error
-	return errors.As(err, &netErr)
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	// Timeouts that weren't wrapped in a network error
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	// The connection closed before the full response was read
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	// Server errors from the Beacon Node
+	var bnStatusErr *client.HttpStatusError
+	if errors.As(err, &bnStatusErr) {
+		return bnStatusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	// Server errors from the Execution Client
+	var ecStatusErr rpc.HTTPError
+	if errors.As(err, &ecStatusErr) {
+		return ecStatusErr.StatusCode >= http.StatusInternalServerError
+	}
+	return false
 }
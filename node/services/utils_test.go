@@ -0,0 +1,192 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+	"github.com/rocket-pool/node-manager-core/node/services"
+)
+
+// A network error that reports whether it was a timeout
+type timeoutError struct{}
+
+func (e timeoutError) Error() string   { return "i/o timeout" }
+func (e timeoutError) Timeout() bool   { return true }
+func (e timeoutError) Temporary() bool { return true }
+
+// Wrap a socket error the way the net package reports failed dials and reads
+func newOpError(op string, errno syscall.Errno) error {
+	return &net.OpError{
+		Op:   op,
+		Net:  "tcp",
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8545},
+		Err:  os.NewSyscallError(op, errno),
+	}
+}
+
+func TestIsDisconnected(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil",
+			err:      nil,
+			expected: false,
+		},
+
+		// Socket errors
+		{
+			name:     "geth connection refused",
+			err:      &url.Error{Op: "Post", URL: "http://localhost:8545", Err: newOpError("dial", syscall.ECONNREFUSED)},
+			expected: true,
+		}, {
+			name:     "nethermind connection reset",
+			err:      &url.Error{Op: "Post", URL: "http://localhost:8545", Err: newOpError("read", syscall.ECONNRESET)},
+			expected: true,
+		}, {
+			name:     "bare ECONNREFUSED",
+			err:      syscall.ECONNREFUSED,
+			expected: true,
+		}, {
+			name:     "beacon client connection refused",
+			err:      fmt.Errorf("error getting sync status: %w", &url.Error{Op: "Get", URL: "http://localhost:5052/eth/v1/node/syncing", Err: newOpError("dial", syscall.ECONNREFUSED)}),
+			expected: true,
+		},
+
+		// Timeouts
+		{
+			name:     "url.Error timeout",
+			err:      &url.Error{Op: "Post", URL: "http://localhost:8545", Err: timeoutError{}},
+			expected: true,
+		}, {
+			name:     "transport deadline exceeded",
+			err:      fmt.Errorf("error getting validator status: %w", context.DeadlineExceeded),
+			expected: true,
+		},
+
+		// Connections closed mid-response
+		{
+			name:     "EOF",
+			err:      &url.Error{Op: "Post", URL: "http://localhost:8545", Err: io.EOF},
+			expected: true,
+		}, {
+			name:     "unexpected EOF",
+			err:      fmt.Errorf("error reading response body: %w", io.ErrUnexpectedEOF),
+			expected: true,
+		},
+
+		// Beacon Node status errors
+		{
+			name:     "beacon client 500",
+			err:      fmt.Errorf("error getting beacon head: %w", &client.HttpStatusError{StatusCode: http.StatusInternalServerError, Body: "internal error"}),
+			expected: true,
+		}, {
+			name:     "beacon client 503 from proxy",
+			err:      &client.HttpStatusError{StatusCode: http.StatusServiceUnavailable, Body: "<html>503 Service Unavailable</html>"},
+			expected: true,
+		}, {
+			name:     "beacon client 404",
+			err:      fmt.Errorf("error getting block: %w", &client.HttpStatusError{StatusCode: http.StatusNotFound, Body: `{"code":404,"message":"Block not found"}`}),
+			expected: false,
+		}, {
+			name:     "beacon client 400",
+			err:      &client.HttpStatusError{StatusCode: http.StatusBadRequest, Body: `{"code":400,"message":"Invalid state ID"}`},
+			expected: false,
+		},
+
+		// Execution Client status errors
+		{
+			name:     "geth 502 from proxy",
+			err:      rpc.HTTPError{StatusCode: http.StatusBadGateway, Status: "502 Bad Gateway", Body: []byte("bad gateway")},
+			expected: true,
+		}, {
+			name:     "nethermind 503 wrapped",
+			err:      fmt.Errorf("error getting latest block: %w", rpc.HTTPError{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable"}),
+			expected: true,
+		}, {
+			name:     "geth 401",
+			err:      rpc.HTTPError{StatusCode: http.StatusUnauthorized, Status: "401 Unauthorized", Body: []byte("missing token")},
+			expected: false,
+		},
+
+		// Errors from the request itself
+		{
+			name:     "geth JSON-RPC error",
+			err:      errors.New("header not found"),
+			expected: false,
+		}, {
+			name:     "nethermind execution reverted",
+			err:      errors.New("execution reverted"),
+			expected: false,
+		},
+
+		// Requests that could never succeed
+		{
+			name:     "url.Error that isn't a network problem",
+			err:      &url.Error{Op: "Get", URL: "localhost:5052", Err: errors.New("unsupported protocol scheme \"\"")},
+			expected: false,
+		},
+
+		// The caller gave up, so the client isn't at fault
+		{
+			name:     "caller cancelled context",
+			err:      fmt.Errorf("error getting beacon head: %w", context.Canceled),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := services.IsDisconnected(test.err); result != test.expected {
+				t.Errorf("expected %t for error [%v], got %t", test.expected, test.err, result)
+			}
+		})
+	}
+}
+
+func TestIsDisconnectedRealConnectionRefused(t *testing.T) {
+	// Get a port that nothing is listening on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error creating listener: %v", err)
+	}
+	address := listener.Addr().String()
+	_ = listener.Close()
+
+	_, err = http.Get("http://" + address)
+	if err == nil {
+		t.Fatal("expected the request to a closed port to fail")
+	}
+	if !services.IsDisconnected(err) {
+		t.Errorf("expected a refused connection to be a disconnection: %v", err)
+	}
+}
+
+func TestIsDisconnectedCallerCancelledRequest(t *testing.T) {
+	// A request cancelled by the caller's context isn't a disconnection, even though it fails in the transport
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:1", nil)
+	if err != nil {
+		t.Fatalf("error creating request: %v", err)
+	}
+	_, err = http.DefaultClient.Do(req)
+	if err == nil {
+		t.Fatal("expected the cancelled request to fail")
+	}
+	if services.IsDisconnected(err) {
+		t.Errorf("expected a request cancelled by the caller not to be a disconnection: %v", err)
+	}
+}
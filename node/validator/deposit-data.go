@@ -12,7 +12,13 @@ import (
 	eth2types "github.com/wealdtech/go-eth2-types/v2"
 )
 
-// Get deposit data & root for a given validator key and withdrawal credentials
+const (
+	// The version of the staking-deposit-cli whose deposit data format is emulated by GetDepositData
+	DepositCliVersion string = "2.7.0"
+)
+
+// Get deposit data & root for a given validator key and withdrawal credentials.
+// The result can be serialized to JSON in the same format as the staking-deposit-cli's deposit data files.
 func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials common.Hash, genesisForkVersion []byte, depositAmount uint64, networkName string) (beacon.ExtendedDepositData, error) {
 	// Build deposit data
 	dd := ssz_types.DepositDataNoSignature{
@@ -71,6 +77,7 @@ func GetDepositData(validatorKey *eth2types.BLSPrivateKey, withdrawalCredentials
 		DepositDataRoot:       depositDataRoot[:],
 		ForkVersion:           genesisForkVersion,
 		NetworkName:           networkName,
+		DepositCliVersion:     DepositCliVersion,
 	}, nil
 }
 
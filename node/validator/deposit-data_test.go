@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+const (
+	// The genesis fork version of mainnet, which all deposits are signed with
+	mainnetGenesisForkVersion string = "00000000"
+
+	// A full 32 ETH deposit, in gwei
+	fullDepositAmount uint64 = 32e9
+
+	// The expected deposit for the spec test key, with 0x01 credentials for 0xcccc...cccc
+	expectedDepositSignature string = "a36ef76f6455ba639d0e6667bf6ace95d45a9ca141fd0d5d879de95675760b46" +
+		"1cfd0ee6fd3ea9cf81d857551913beec00189461a2092b0dffdeec9b9bfe2db1" +
+		"7e779d2018821fb6d023f237198bfb3e179be19bb1df4a61d514f100e160eafe"
+	expectedDepositMessageRoot string = "d1012eb513187e85115258e55e63639a55ed91c4c3547baa516bab0ff1a9731b"
+	expectedDepositDataRoot    string = "9de8ce0b83adcd2eae9af91362235cf6eafa380576ce1de234287001112b20db"
+)
+
+// Get the deposit data for the spec test key
+func newSpecDepositData(t *testing.T) (*eth2types.BLSPrivateKey, common.Hash, beacon.ExtendedDepositData) {
+	key := newSpecKey(t)
+	withdrawalCreds := GetWithdrawalCredsFromAddress(common.HexToAddress("0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"))
+	depositData, err := GetDepositData(key, withdrawalCreds, mustDecodeHex(t, mainnetGenesisForkVersion), fullDepositAmount, "mainnet")
+	if err != nil {
+		t.Fatalf("error getting deposit data: %v", err)
+	}
+	return key, withdrawalCreds, depositData
+}
+
+func TestGetDepositData(t *testing.T) {
+	key, withdrawalCreds, depositData := newSpecDepositData(t)
+	pubkey := key.PublicKey().Marshal()
+	forkVersion := mustDecodeHex(t, mainnetGenesisForkVersion)
+
+	// DepositMessage is (pubkey, withdrawal_credentials, amount); the pubkey is 48 bytes, so it's 2 chunks
+	pubkeyRoot := hashChunks(pubkey[:32], pubkey[32:])
+	messageRoot := hashChunks(pubkeyRoot, withdrawalCreds[:], uint64Chunk(fullDepositAmount))
+	if !bytes.Equal(depositData.DepositMessageRoot, messageRoot) {
+		t.Errorf("expected deposit message root %x, got %x", messageRoot, []byte(depositData.DepositMessageRoot))
+	}
+
+	// Deposits are signed with the genesis fork version and a zero genesis validators root
+	domain := computeDomain(eth2types.DomainDeposit[:], forkVersion, make([]byte, 32))
+	signingRoot := hashChunks(messageRoot, domain)
+	signature, err := eth2types.BLSSignatureFromBytes(depositData.Signature)
+	if err != nil {
+		t.Fatalf("error parsing signature: %v", err)
+	}
+	if !signature.Verify(signingRoot, key.PublicKey()) {
+		t.Fatal("signature doesn't verify against the signing root computed by hand")
+	}
+
+	// DepositData adds the 96-byte signature, which is 3 chunks
+	signatureRoot := hashChunks(depositData.Signature[:32], depositData.Signature[32:64], depositData.Signature[64:])
+	dataRoot := hashChunks(pubkeyRoot, withdrawalCreds[:], uint64Chunk(fullDepositAmount), signatureRoot)
+	if !bytes.Equal(depositData.DepositDataRoot, dataRoot) {
+		t.Errorf("expected deposit data root %x, got %x", dataRoot, []byte(depositData.DepositDataRoot))
+	}
+
+	// Check the known-good values
+	if hex.EncodeToString(depositData.Signature) != expectedDepositSignature {
+		t.Errorf("expected signature %s, got %x", expectedDepositSignature, []byte(depositData.Signature))
+	}
+	if hex.EncodeToString(depositData.DepositMessageRoot) != expectedDepositMessageRoot {
+		t.Errorf("expected deposit message root %s, got %x", expectedDepositMessageRoot, []byte(depositData.DepositMessageRoot))
+	}
+	if hex.EncodeToString(depositData.DepositDataRoot) != expectedDepositDataRoot {
+		t.Errorf("expected deposit data root %s, got %x", expectedDepositDataRoot, []byte(depositData.DepositDataRoot))
+	}
+}
+
+func TestDepositDataJson(t *testing.T) {
+	key, withdrawalCreds, depositData := newSpecDepositData(t)
+
+	// The staking-deposit-cli writes a list of deposits, with unprefixed hex strings and the amount as a number
+	serialized, err := json.Marshal([]beacon.ExtendedDepositData{depositData})
+	if err != nil {
+		t.Fatalf("error serializing deposit data: %v", err)
+	}
+	var files []map[string]any
+	if err := json.Unmarshal(serialized, &files); err != nil {
+		t.Fatalf("error deserializing deposit data: %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected 1 deposit, got %d", len(files))
+	}
+	expected := map[string]any{
+		"pubkey":                 hex.EncodeToString(key.PublicKey().Marshal()),
+		"withdrawal_credentials": hex.EncodeToString(withdrawalCreds[:]),
+		"amount":                 float64(fullDepositAmount),
+		"signature":              expectedDepositSignature,
+		"deposit_message_root":   expectedDepositMessageRoot,
+		"deposit_data_root":      expectedDepositDataRoot,
+		"fork_version":           mainnetGenesisForkVersion,
+		"network_name":           "mainnet",
+		"deposit_cli_version":    DepositCliVersion,
+	}
+	if len(files[0]) != len(expected) {
+		t.Errorf("expected %d fields, got %d: %v", len(expected), len(files[0]), files[0])
+	}
+	for name, value := range expected {
+		if files[0][name] != value {
+			t.Errorf("expected %s to be %v, got %v", name, value, files[0][name])
+		}
+	}
+
+	// Files from the staking-deposit-cli can be read back in
+	var roundTrip []beacon.ExtendedDepositData
+	if err := json.Unmarshal(serialized, &roundTrip); err != nil {
+		t.Fatalf("error deserializing deposit data: %v", err)
+	}
+	if hex.EncodeToString(roundTrip[0].DepositDataRoot) != expectedDepositDataRoot {
+		t.Errorf("expected deposit data root %s after a round trip, got %x", expectedDepositDataRoot, []byte(roundTrip[0].DepositDataRoot))
+	}
+}
@@ -0,0 +1,144 @@
+package eip3076
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+)
+
+// Create an interchange that only contains the history for the provided validators
+func (i *Interchange) Filter(pubkeys []beacon.ValidatorPubkey) *Interchange {
+	pubkeyMap := make(map[beacon.ValidatorPubkey]bool, len(pubkeys))
+	for _, pubkey := range pubkeys {
+		pubkeyMap[pubkey] = true
+	}
+
+	filtered := &Interchange{
+		Metadata: i.Metadata,
+		Data:     []*ValidatorHistory{},
+	}
+	for _, history := range i.Data {
+		if pubkeyMap[history.Pubkey] {
+			filtered.Data = append(filtered.Data, history)
+		}
+	}
+	return filtered
+}
+
+// Merge two interchanges into a single one, combining the history of any validators that appear in both.
+// Returns an error if the interchanges are for different chains, or if they have conflicting entries for the
+// same slot or target epoch, since importing them would make it impossible to tell which one was actually signed.
+func Merge(first *Interchange, second *Interchange) (*Interchange, error) {
+	if first.Metadata.GenesisValidatorsRoot != second.Metadata.GenesisValidatorsRoot {
+		return nil, fmt.Errorf("cannot merge interchanges with different genesis validators roots (%s and %s)", first.Metadata.GenesisValidatorsRoot.Hex(), second.Metadata.GenesisValidatorsRoot.Hex())
+	}
+
+	merged := &Interchange{
+		Metadata: Metadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    first.Metadata.GenesisValidatorsRoot,
+		},
+		Data: []*ValidatorHistory{},
+	}
+
+	// Combine the entries for each validator, preserving the order they first appear in
+	histories := map[beacon.ValidatorPubkey]*validatorHistoryBuilder{}
+	for _, interchange := range []*Interchange{first, second} {
+		for _, history := range interchange.Data {
+			builder, exists := histories[history.Pubkey]
+			if !exists {
+				builder = newValidatorHistoryBuilder(history.Pubkey)
+				histories[history.Pubkey] = builder
+				merged.Data = append(merged.Data, builder.history)
+			}
+			err := builder.add(history)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, builder := range histories {
+		builder.finalize()
+	}
+	return merged, nil
+}
+
+// Combines multiple histories for the same validator, checking for conflicts along the way
+type validatorHistoryBuilder struct {
+	history      *ValidatorHistory
+	blocks       map[client.Uinteger]*SignedBlock
+	attestations map[client.Uinteger]*SignedAttestation
+}
+
+// Create a new builder for the provided validator
+func newValidatorHistoryBuilder(pubkey beacon.ValidatorPubkey) *validatorHistoryBuilder {
+	return &validatorHistoryBuilder{
+		history: &ValidatorHistory{
+			Pubkey: pubkey,
+		},
+		blocks:       map[client.Uinteger]*SignedBlock{},
+		attestations: map[client.Uinteger]*SignedAttestation{},
+	}
+}
+
+// Add a validator history to the builder
+func (b *validatorHistoryBuilder) add(history *ValidatorHistory) error {
+	for _, block := range history.SignedBlocks {
+		existing, exists := b.blocks[block.Slot]
+		if !exists {
+			newBlock := block
+			b.blocks[block.Slot] = &newBlock
+			continue
+		}
+		if rootsConflict(existing.SigningRoot, block.SigningRoot) {
+			return fmt.Errorf("validator %s has conflicting signed blocks for slot %d", b.history.Pubkey.HexWithPrefix(), block.Slot)
+		}
+		if existing.SigningRoot == nil {
+			existing.SigningRoot = block.SigningRoot
+		}
+	}
+
+	for _, attestation := range history.SignedAttestations {
+		existing, exists := b.attestations[attestation.TargetEpoch]
+		if !exists {
+			newAttestation := attestation
+			b.attestations[attestation.TargetEpoch] = &newAttestation
+			continue
+		}
+		if existing.SourceEpoch != attestation.SourceEpoch || rootsConflict(existing.SigningRoot, attestation.SigningRoot) {
+			return fmt.Errorf("validator %s has conflicting signed attestations for target epoch %d", b.history.Pubkey.HexWithPrefix(), attestation.TargetEpoch)
+		}
+		if existing.SigningRoot == nil {
+			existing.SigningRoot = attestation.SigningRoot
+		}
+	}
+	return nil
+}
+
+// Write the combined blocks and attestations into the builder's history, sorted by slot and target epoch
+func (b *validatorHistoryBuilder) finalize() {
+	b.history.SignedBlocks = make([]SignedBlock, 0, len(b.blocks))
+	for _, block := range b.blocks {
+		b.history.SignedBlocks = append(b.history.SignedBlocks, *block)
+	}
+	sort.Slice(b.history.SignedBlocks, func(i, j int) bool {
+		return b.history.SignedBlocks[i].Slot < b.history.SignedBlocks[j].Slot
+	})
+
+	b.history.SignedAttestations = make([]SignedAttestation, 0, len(b.attestations))
+	for _, attestation := range b.attestations {
+		b.history.SignedAttestations = append(b.history.SignedAttestations, *attestation)
+	}
+	sort.Slice(b.history.SignedAttestations, func(i, j int) bool {
+		return b.history.SignedAttestations[i].TargetEpoch < b.history.SignedAttestations[j].TargetEpoch
+	})
+}
+
+// True if both signing roots are known and they're different
+func rootsConflict(first *common.Hash, second *common.Hash) bool {
+	return first != nil && second != nil && *first != *second
+}
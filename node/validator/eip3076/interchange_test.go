@@ -0,0 +1,307 @@
+package eip3076
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon"
+)
+
+const (
+	// The example interchange from EIP-3076
+	eipExampleInterchange string = `{
+  "metadata": {
+    "interchange_format_version": "5",
+    "genesis_validators_root": "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"
+  },
+  "data": [
+    {
+      "pubkey": "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
+      "signed_blocks": [
+        {
+          "slot": "81952",
+          "signing_root": "0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"
+        },
+        {
+          "slot": "81951"
+        }
+      ],
+      "signed_attestations": [
+        {
+          "source_epoch": "2290",
+          "target_epoch": "3007",
+          "signing_root": "0x587d6a4f59a58fe24f406e0502413e77fe1babddee641fda30034ed37ecc884d"
+        },
+        {
+          "source_epoch": "2290",
+          "target_epoch": "3008"
+        }
+      ]
+    }
+  ]
+}`
+
+	examplePubkey       string = "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed"
+	otherPubkey         string = "0xa99a76ed7796f7be22d5b7e85deeb7c5677e88e511e0b337618f8c4eb61349b4bf2d153f649f7b53359fe8b94a38e44c"
+	exampleGenesisRoot  string = "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"
+	exampleBlockRoot    string = "0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"
+	exampleAttesterRoot string = "0x587d6a4f59a58fe24f406e0502413e77fe1babddee641fda30034ed37ecc884d"
+)
+
+// Parse the EIP-3076 example interchange
+func parseExample(t *testing.T) *Interchange {
+	interchange, err := Parse([]byte(eipExampleInterchange))
+	if err != nil {
+		t.Fatalf("error parsing the EIP-3076 example: %v", err)
+	}
+	return interchange
+}
+
+// Get a pointer to a hash
+func hashPtr(value string) *common.Hash {
+	hash := common.HexToHash(value)
+	return &hash
+}
+
+// Create an interchange with a single validator for the example chain
+func newInterchange(t *testing.T, pubkey string, blocks []SignedBlock, attestations []SignedAttestation) *Interchange {
+	parsedPubkey, err := beacon.HexToValidatorPubkey(pubkey)
+	if err != nil {
+		t.Fatalf("error parsing pubkey: %v", err)
+	}
+	return &Interchange{
+		Metadata: Metadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+			GenesisValidatorsRoot:    common.HexToHash(exampleGenesisRoot),
+		},
+		Data: []*ValidatorHistory{
+			{
+				Pubkey:             parsedPubkey,
+				SignedBlocks:       blocks,
+				SignedAttestations: attestations,
+			},
+		},
+	}
+}
+
+func TestParseExample(t *testing.T) {
+	interchange := parseExample(t)
+	if interchange.Metadata.GenesisValidatorsRoot != common.HexToHash(exampleGenesisRoot) {
+		t.Errorf("expected genesis validators root %s, got %s", exampleGenesisRoot, interchange.Metadata.GenesisValidatorsRoot.Hex())
+	}
+	if len(interchange.Data) != 1 {
+		t.Fatalf("expected 1 validator, got %d", len(interchange.Data))
+	}
+
+	history := interchange.Data[0]
+	if history.Pubkey.HexWithPrefix() != examplePubkey {
+		t.Errorf("expected pubkey %s, got %s", examplePubkey, history.Pubkey.HexWithPrefix())
+	}
+	if len(history.SignedBlocks) != 2 {
+		t.Fatalf("expected 2 signed blocks, got %d", len(history.SignedBlocks))
+	}
+	if history.SignedBlocks[0].Slot != 81952 || history.SignedBlocks[0].SigningRoot == nil || *history.SignedBlocks[0].SigningRoot != common.HexToHash(exampleBlockRoot) {
+		t.Errorf("unexpected first signed block: %+v", history.SignedBlocks[0])
+	}
+	if history.SignedBlocks[1].Slot != 81951 || history.SignedBlocks[1].SigningRoot != nil {
+		t.Errorf("expected the second signed block to be slot 81951 without a signing root: %+v", history.SignedBlocks[1])
+	}
+	if len(history.SignedAttestations) != 2 {
+		t.Fatalf("expected 2 signed attestations, got %d", len(history.SignedAttestations))
+	}
+	first := history.SignedAttestations[0]
+	if first.SourceEpoch != 2290 || first.TargetEpoch != 3007 || first.SigningRoot == nil || *first.SigningRoot != common.HexToHash(exampleAttesterRoot) {
+		t.Errorf("unexpected first signed attestation: %+v", first)
+	}
+	second := history.SignedAttestations[1]
+	if second.SourceEpoch != 2290 || second.TargetEpoch != 3008 || second.SigningRoot != nil {
+		t.Errorf("expected the second signed attestation to be 2290 -> 3008 without a signing root: %+v", second)
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	serialized, err := parseExample(t).Marshal()
+	if err != nil {
+		t.Fatalf("error serializing interchange: %v", err)
+	}
+
+	// The output must be semantically identical to the example
+	var expected, actual any
+	if err := json.Unmarshal([]byte(eipExampleInterchange), &expected); err != nil {
+		t.Fatalf("error deserializing the example: %v", err)
+	}
+	if err := json.Unmarshal(serialized, &actual); err != nil {
+		t.Fatalf("error deserializing the serialized interchange: %v", err)
+	}
+	expectedJson, _ := json.Marshal(expected)
+	actualJson, _ := json.Marshal(actual)
+	if string(expectedJson) != string(actualJson) {
+		t.Errorf("expected %s, got %s", expectedJson, actualJson)
+	}
+}
+
+func TestMarshalEmptyHistory(t *testing.T) {
+	// Validators without any history still need empty lists, and an empty interchange needs an empty data list
+	interchange := newInterchange(t, otherPubkey, nil, nil)
+	serialized, err := interchange.Marshal()
+	if err != nil {
+		t.Fatalf("error serializing interchange: %v", err)
+	}
+	if !strings.Contains(string(serialized), `"signed_blocks":[]`) || !strings.Contains(string(serialized), `"signed_attestations":[]`) {
+		t.Errorf("expected empty history lists, got %s", serialized)
+	}
+
+	interchange.Data = nil
+	serialized, err = interchange.Marshal()
+	if err != nil {
+		t.Fatalf("error serializing interchange: %v", err)
+	}
+	if !strings.Contains(string(serialized), `"data":[]`) {
+		t.Errorf("expected an empty data list, got %s", serialized)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "invalid JSON",
+			data: `{"metadata":`,
+		}, {
+			name: "unsupported version",
+			data: strings.Replace(eipExampleInterchange, `"interchange_format_version": "5"`, `"interchange_format_version": "4"`, 1),
+		}, {
+			name: "missing version",
+			data: `{"metadata":{"genesis_validators_root":"` + exampleGenesisRoot + `"},"data":[]}`,
+		}, {
+			name: "null validator",
+			data: `{"metadata":{"interchange_format_version":"5","genesis_validators_root":"` + exampleGenesisRoot + `"},"data":[null]}`,
+		}, {
+			name: "invalid slot",
+			data: strings.Replace(eipExampleInterchange, `"81952"`, `"slot"`, 1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Parse([]byte(test.data)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	example := parseExample(t)
+	other := newInterchange(t, otherPubkey, []SignedBlock{{Slot: 100}}, nil)
+	combined, err := Merge(example, other)
+	if err != nil {
+		t.Fatalf("error merging interchanges: %v", err)
+	}
+
+	pubkey, _ := beacon.HexToValidatorPubkey(otherPubkey)
+	filtered := combined.Filter([]beacon.ValidatorPubkey{pubkey})
+	if len(filtered.Data) != 1 || filtered.Data[0].Pubkey != pubkey {
+		t.Fatalf("expected only %s after filtering, got %d validators", otherPubkey, len(filtered.Data))
+	}
+	if filtered.Metadata != combined.Metadata {
+		t.Errorf("expected the metadata to be preserved")
+	}
+
+	filtered = combined.Filter(nil)
+	if filtered.Data == nil || len(filtered.Data) != 0 {
+		t.Errorf("expected an empty data list when filtering to no validators, got %v", filtered.Data)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	// Merging the example with an overlapping history for the same validator
+	example := parseExample(t)
+	update := newInterchange(t, examplePubkey,
+		[]SignedBlock{
+			{Slot: 81951, SigningRoot: hashPtr("0x01")},
+			{Slot: 81952, SigningRoot: hashPtr(exampleBlockRoot)},
+			{Slot: 81960},
+		},
+		[]SignedAttestation{
+			{SourceEpoch: 2290, TargetEpoch: 3007},
+			{SourceEpoch: 3008, TargetEpoch: 3009},
+		},
+	)
+	merged, err := Merge(example, update)
+	if err != nil {
+		t.Fatalf("error merging interchanges: %v", err)
+	}
+	if len(merged.Data) != 1 {
+		t.Fatalf("expected 1 validator, got %d", len(merged.Data))
+	}
+
+	// Entries are combined, sorted, and missing signing roots are filled in from the other file
+	history := merged.Data[0]
+	expectedSlots := []uint64{81951, 81952, 81960}
+	if len(history.SignedBlocks) != len(expectedSlots) {
+		t.Fatalf("expected %d signed blocks, got %d", len(expectedSlots), len(history.SignedBlocks))
+	}
+	for i, slot := range expectedSlots {
+		if uint64(history.SignedBlocks[i].Slot) != slot {
+			t.Errorf("expected block %d to be slot %d, got %d", i, slot, history.SignedBlocks[i].Slot)
+		}
+	}
+	if history.SignedBlocks[0].SigningRoot == nil || *history.SignedBlocks[0].SigningRoot != common.HexToHash("0x01") {
+		t.Errorf("expected the missing signing root for slot 81951 to be filled in")
+	}
+	expectedTargets := []uint64{3007, 3008, 3009}
+	if len(history.SignedAttestations) != len(expectedTargets) {
+		t.Fatalf("expected %d signed attestations, got %d", len(expectedTargets), len(history.SignedAttestations))
+	}
+	for i, target := range expectedTargets {
+		if uint64(history.SignedAttestations[i].TargetEpoch) != target {
+			t.Errorf("expected attestation %d to target epoch %d, got %d", i, target, history.SignedAttestations[i].TargetEpoch)
+		}
+	}
+	if root := history.SignedAttestations[0].SigningRoot; root == nil || *root != common.HexToHash(exampleAttesterRoot) {
+		t.Errorf("expected the signing root for target epoch 3007 to be kept")
+	}
+
+	// The inputs aren't modified
+	if len(example.Data[0].SignedBlocks) != 2 || example.Data[0].SignedBlocks[1].SigningRoot != nil {
+		t.Error("expected the original interchange to be unchanged")
+	}
+}
+
+func TestMergeConflicts(t *testing.T) {
+	tests := []struct {
+		name   string
+		second *Interchange
+	}{
+		{
+			name:   "different block signing roots",
+			second: newInterchange(t, examplePubkey, []SignedBlock{{Slot: 81952, SigningRoot: hashPtr("0x01")}}, nil),
+		}, {
+			name:   "different attestation signing roots",
+			second: newInterchange(t, examplePubkey, nil, []SignedAttestation{{SourceEpoch: 2290, TargetEpoch: 3007, SigningRoot: hashPtr("0x01")}}),
+		}, {
+			name:   "different attestation source epochs",
+			second: newInterchange(t, examplePubkey, nil, []SignedAttestation{{SourceEpoch: 2289, TargetEpoch: 3008}}),
+		}, {
+			name: "different chains",
+			second: func() *Interchange {
+				interchange := newInterchange(t, otherPubkey, nil, nil)
+				interchange.Metadata.GenesisValidatorsRoot = common.HexToHash("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95")
+				return interchange
+			}(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Merge(parseExample(t), test.second); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
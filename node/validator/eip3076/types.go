@@ -0,0 +1,102 @@
+package eip3076
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+)
+
+const (
+	// The version of the interchange format supported by this package
+	InterchangeFormatVersion string = "5"
+)
+
+// A slashing protection interchange file, as defined in EIP-3076
+type Interchange struct {
+	Metadata Metadata            `json:"metadata"`
+	Data     []*ValidatorHistory `json:"data"`
+}
+
+// Metadata for an interchange file
+type Metadata struct {
+	InterchangeFormatVersion string      `json:"interchange_format_version"`
+	GenesisValidatorsRoot    common.Hash `json:"genesis_validators_root"`
+}
+
+// The signing history of a single validator
+type ValidatorHistory struct {
+	Pubkey             beacon.ValidatorPubkey `json:"pubkey"`
+	SignedBlocks       []SignedBlock          `json:"signed_blocks"`
+	SignedAttestations []SignedAttestation    `json:"signed_attestations"`
+}
+
+// A block signed by a validator
+type SignedBlock struct {
+	Slot        client.Uinteger `json:"slot"`
+	SigningRoot *common.Hash    `json:"signing_root,omitempty"`
+}
+
+// An attestation signed by a validator
+type SignedAttestation struct {
+	SourceEpoch client.Uinteger `json:"source_epoch"`
+	TargetEpoch client.Uinteger `json:"target_epoch"`
+	SigningRoot *common.Hash    `json:"signing_root,omitempty"`
+}
+
+// Serializes the validator history to JSON. EIP-3076 requires the pubkey to have a 0x prefix.
+func (h ValidatorHistory) MarshalJSON() ([]byte, error) {
+	signedBlocks := h.SignedBlocks
+	if signedBlocks == nil {
+		signedBlocks = []SignedBlock{}
+	}
+	signedAttestations := h.SignedAttestations
+	if signedAttestations == nil {
+		signedAttestations = []SignedAttestation{}
+	}
+	return json.Marshal(struct {
+		Pubkey             string              `json:"pubkey"`
+		SignedBlocks       []SignedBlock       `json:"signed_blocks"`
+		SignedAttestations []SignedAttestation `json:"signed_attestations"`
+	}{
+		Pubkey:             h.Pubkey.HexWithPrefix(),
+		SignedBlocks:       signedBlocks,
+		SignedAttestations: signedAttestations,
+	})
+}
+
+// Deserialize an interchange file from JSON
+func Parse(data []byte) (*Interchange, error) {
+	var interchange Interchange
+	err := json.Unmarshal(data, &interchange)
+	if err != nil {
+		return nil, fmt.Errorf("error deserializing slashing protection interchange: %w", err)
+	}
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return nil, fmt.Errorf("unsupported slashing protection interchange format version [%s], expected [%s]", interchange.Metadata.InterchangeFormatVersion, InterchangeFormatVersion)
+	}
+	for i, history := range interchange.Data {
+		if history == nil {
+			return nil, fmt.Errorf("slashing protection interchange has an empty entry at index %d", i)
+		}
+	}
+	return &interchange, nil
+}
+
+// Serialize an interchange file into JSON
+func (i *Interchange) Marshal() ([]byte, error) {
+	data := i.Data
+	if data == nil {
+		data = []*ValidatorHistory{}
+	}
+	bytes, err := json.Marshal(Interchange{
+		Metadata: i.Metadata,
+		Data:     data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error serializing slashing protection interchange: %w", err)
+	}
+	return bytes, nil
+}
@@ -156,3 +156,28 @@ func (ks *LodestarKeystoreManager) LoadValidatorKey(pubkey beacon.ValidatorPubke
 
 	return privateKey, nil
 }
+
+// Get the pubkeys of all of the validator keys stored on disk
+func (ks *LodestarKeystoreManager) GetStoredPubkeys() ([]beacon.ValidatorPubkey, error) {
+	pubkeys, err := getPubkeysFromDir(filepath.Join(ks.keystoreDir, ks.validatorsDir), "")
+	if err != nil {
+		return nil, fmt.Errorf("error getting Lodestar validator keys: %w", err)
+	}
+	return pubkeys, nil
+}
+
+// Delete a validator key from disk; this does nothing if the key isn't stored
+func (ks *LodestarKeystoreManager) DeleteValidatorKey(pubkey beacon.ValidatorPubkey) error {
+	// Delete the key
+	err := removeIfExists(filepath.Join(ks.keystoreDir, ks.validatorsDir, pubkey.HexWithPrefix()))
+	if err != nil {
+		return fmt.Errorf("error deleting Lodestar key directory for pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+
+	// Delete the secret
+	err = removeIfExists(filepath.Join(ks.keystoreDir, ks.secretsDir, pubkey.HexWithPrefix()))
+	if err != nil {
+		return fmt.Errorf("error deleting Lodestar secret for pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	return nil
+}
@@ -83,6 +83,12 @@ func (ks *PrysmKeystoreManager) StoreValidatorKey(key *eth2types.BLSPrivateKey,
 	ks.as.PrivateKeys = append(ks.as.PrivateKeys, key.Marshal())
 	ks.as.PublicKeys = append(ks.as.PublicKeys, key.PublicKey().Marshal())
 
+	// Save it
+	return ks.saveAccountStore()
+}
+
+// Encrypt the account store and write it to disk
+func (ks *PrysmKeystoreManager) saveAccountStore() error {
 	// Encode account store
 	asBytes, err := json.Marshal(ks.as)
 	if err != nil {
@@ -253,3 +259,57 @@ func (ks *PrysmKeystoreManager) LoadValidatorKey(pubkey beacon.ValidatorPubkey)
 	// Return nothing if the private key wasn't found
 	return nil, nil
 }
+
+// Get the pubkeys of all of the validator keys stored on disk
+func (ks *PrysmKeystoreManager) GetStoredPubkeys() ([]beacon.ValidatorPubkey, error) {
+	// Initialize the account store
+	err := ks.initialize()
+	if err != nil {
+		return nil, err
+	}
+
+	pubkeys := make([]beacon.ValidatorPubkey, 0, len(ks.as.PublicKeys))
+	for _, pubkeyBytes := range ks.as.PublicKeys {
+		if len(pubkeyBytes) != beacon.ValidatorPubkeyLength {
+			return nil, fmt.Errorf("prysm's keystore has a public key with invalid length %d", len(pubkeyBytes))
+		}
+		pubkeys = append(pubkeys, beacon.ValidatorPubkey(pubkeyBytes))
+	}
+	return pubkeys, nil
+}
+
+// Delete a validator key from disk; this does nothing if the key isn't stored
+func (ks *PrysmKeystoreManager) DeleteValidatorKey(pubkey beacon.ValidatorPubkey) error {
+	// Initialize the account store
+	err := ks.initialize()
+	if err != nil {
+		return err
+	}
+
+	// Remove the validator key from the account store
+	privateKeys := [][]byte{}
+	publicKeys := [][]byte{}
+	for ki := 0; ki < len(ks.as.PrivateKeys); ki++ {
+		if bytes.Equal(pubkey[:], ks.as.PublicKeys[ki]) {
+			continue
+		}
+		privateKeys = append(privateKeys, ks.as.PrivateKeys[ki])
+		publicKeys = append(publicKeys, ks.as.PublicKeys[ki])
+	}
+	if len(publicKeys) == len(ks.as.PublicKeys) {
+		return nil
+	}
+	oldAccountStore := ks.as
+	ks.as = &prysmAccountStore{
+		PrivateKeys: privateKeys,
+		PublicKeys:  publicKeys,
+	}
+
+	// Save it, reverting the account store if it failed
+	err = ks.saveAccountStore()
+	if err != nil {
+		ks.as = oldAccountStore
+		return fmt.Errorf("error deleting key for validator %s from the Prysm keystore: %w", pubkey.HexWithPrefix(), err)
+	}
+	return nil
+}
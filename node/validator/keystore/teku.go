@@ -154,3 +154,28 @@ func (ks *TekuKeystoreManager) LoadValidatorKey(pubkey beacon.ValidatorPubkey) (
 
 	return privateKey, nil
 }
+
+// Get the pubkeys of all of the validator keys stored on disk
+func (ks *TekuKeystoreManager) GetStoredPubkeys() ([]beacon.ValidatorPubkey, error) {
+	pubkeys, err := getPubkeysFromDir(filepath.Join(ks.keystoreDir, ks.validatorsDir), ".json")
+	if err != nil {
+		return nil, fmt.Errorf("error getting Teku validator keys: %w", err)
+	}
+	return pubkeys, nil
+}
+
+// Delete a validator key from disk; this does nothing if the key isn't stored
+func (ks *TekuKeystoreManager) DeleteValidatorKey(pubkey beacon.ValidatorPubkey) error {
+	// Delete the key
+	err := removeIfExists(filepath.Join(ks.keystoreDir, ks.validatorsDir, pubkey.HexWithPrefix()+".json"))
+	if err != nil {
+		return fmt.Errorf("error deleting Teku keystore for pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+
+	// Delete the secret
+	err = removeIfExists(filepath.Join(ks.keystoreDir, ks.secretsDir, pubkey.HexWithPrefix()+".txt"))
+	if err != nil {
+		return fmt.Errorf("error deleting Teku secret for pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	return nil
+}
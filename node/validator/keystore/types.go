@@ -19,4 +19,10 @@ type IKeystoreManager interface {
 
 	// Get the path of the keystore directory managed by this manager
 	GetKeystoreDir() string
+
+	// Get the pubkeys of all of the validator keys stored on disk
+	GetStoredPubkeys() ([]beacon.ValidatorPubkey, error)
+
+	// Delete the validator key corresponding to the provided pubkey from disk; this does nothing if the key isn't stored
+	DeleteValidatorKey(pubkey beacon.ValidatorPubkey) error
 }
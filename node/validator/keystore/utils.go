@@ -0,0 +1,46 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/rocket-pool/node-manager-core/beacon"
+)
+
+// Get the pubkeys of the validators in a directory where each entry is named after a validator pubkey (with a 0x prefix),
+// followed by the provided suffix. Entries that don't match this format are ignored.
+func getPubkeysFromDir(dir string, suffix string) ([]beacon.ValidatorPubkey, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []beacon.ValidatorPubkey{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory [%s]: %w", dir, err)
+	}
+
+	pubkeys := []beacon.ValidatorPubkey{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, "0x") || !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		pubkey, err := beacon.HexToValidatorPubkey(strings.TrimSuffix(name, suffix))
+		if err != nil {
+			continue
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
+}
+
+// Remove a file or directory, ignoring it if it doesn't exist
+func removeIfExists(path string) error {
+	err := os.RemoveAll(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+const (
+	// The default mount path of the Vault KV (version 2) secrets engine
+	DefaultVaultMountPath string = "secret"
+
+	// The path under the mount where validator keys are stored
+	vaultValidatorsPath string = "validators"
+
+	// How long to wait for a response from Vault
+	vaultRequestTimeout time.Duration = 30 * time.Second
+
+	// The fraction of an AppRole token's lease that can pass before it's renewed
+	vaultTokenRenewalFraction float64 = 2.0 / 3.0
+)
+
+// Settings for connecting to a HashiCorp Vault server.
+// Either Token, or both RoleID and SecretID for AppRole authentication, must be provided.
+type VaultConfig struct {
+	// The URL of the Vault server, such as "https://vault.example.com:8200"
+	Address string
+
+	// The token to authenticate with
+	Token string
+
+	// The mount path of the KV (version 2) secrets engine; defaults to DefaultVaultMountPath
+	MountPath string
+
+	// The AppRole role ID to log in with, if Token isn't set
+	RoleID string
+
+	// The AppRole secret ID to log in with, if Token isn't set
+	SecretID string
+}
+
+// The secret stored in Vault for each validator key
+type vaultValidatorSecret struct {
+	PrivateKey     string `json:"privateKey"`
+	DerivationPath string `json:"derivationPath"`
+}
+
+// Keystore manager that stores validator keys in the KV (version 2) secrets engine of a HashiCorp Vault server.
+// Each key is stored as hex-encoded private key bytes under validators/<pubkey>.
+// Tokens from AppRole logins are renewed before their lease runs out, and the manager logs in again if a token can't be
+// renewed or Vault rejects it.
+type VaultKeystoreManager struct {
+	address   string
+	mountPath string
+	roleID    string
+	secretID  string
+	client    http.Client
+
+	// The current token and its lease; a zero expiration means the token doesn't expire
+	token          string
+	tokenRenewable bool
+	tokenRenewTime time.Time
+	tokenExpiry    time.Time
+	tokenLock      *sync.Mutex
+}
+
+// The auth section of a Vault login or token renewal response
+type vaultAuthResponse struct {
+	Auth struct {
+		ClientToken   string `json:"client_token"`
+		LeaseDuration int64  `json:"lease_duration"`
+		Renewable     bool   `json:"renewable"`
+	} `json:"auth"`
+}
+
+// Create a new Vault keystore manager. If the config uses AppRole authentication, this logs in to Vault to get a token.
+func NewVaultKeystoreManager(cfg VaultConfig) (*VaultKeystoreManager, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("the Vault address is required")
+	}
+	mountPath := strings.Trim(cfg.MountPath, "/")
+	if mountPath == "" {
+		mountPath = DefaultVaultMountPath
+	}
+	ks := &VaultKeystoreManager{
+		address:   strings.TrimRight(cfg.Address, "/"),
+		mountPath: mountPath,
+		token:     cfg.Token,
+		client: http.Client{
+			Timeout: vaultRequestTimeout,
+		},
+		tokenLock: &sync.Mutex{},
+	}
+
+	// Log in with AppRole if there isn't a token
+	if ks.token == "" {
+		if cfg.RoleID == "" || cfg.SecretID == "" {
+			return nil, errors.New("either a Vault token or an AppRole role ID and secret ID are required")
+		}
+		ks.roleID = cfg.RoleID
+		ks.secretID = cfg.SecretID
+		if err := ks.loginWithAppRole(); err != nil {
+			return nil, fmt.Errorf("error logging in to Vault with AppRole: %w", err)
+		}
+	}
+	return ks, nil
+}
+
+// Get the Vault path that validator keys are stored under
+func (ks *VaultKeystoreManager) GetKeystoreDir() string {
+	return fmt.Sprintf("%s/v1/%s/data/%s", ks.address, ks.mountPath, vaultValidatorsPath)
+}
+
+// Store a validator key
+func (ks *VaultKeystoreManager) StoreValidatorKey(key *eth2types.BLSPrivateKey, derivationPath string) error {
+	pubkey := beacon.ValidatorPubkey(key.PublicKey().Marshal())
+	body := map[string]any{
+		"data": vaultValidatorSecret{
+			PrivateKey:     hex.EncodeToString(key.Marshal()),
+			DerivationPath: derivationPath,
+		},
+	}
+	_, status, err := ks.sendAuthenticatedRequest(http.MethodPost, ks.getDataPath(pubkey), body)
+	if err != nil {
+		return fmt.Errorf("error storing validator key %s in Vault: %w", pubkey.HexWithPrefix(), err)
+	}
+	if status != http.StatusOK && status != http.StatusNoContent {
+		return fmt.Errorf("error storing validator key %s in Vault: HTTP status %d", pubkey.HexWithPrefix(), status)
+	}
+	return nil
+}
+
+// Load a private key
+func (ks *VaultKeystoreManager) LoadValidatorKey(pubkey beacon.ValidatorPubkey) (*eth2types.BLSPrivateKey, error) {
+	responseBody, status, err := ks.sendAuthenticatedRequest(http.MethodGet, ks.getDataPath(pubkey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the Vault secret for pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("error reading the Vault secret for pubkey %s: HTTP status %d", pubkey.HexWithPrefix(), status)
+	}
+
+	// Get the private key
+	var response struct {
+		Data struct {
+			Data vaultValidatorSecret `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("error deserializing the Vault secret for pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	keyBytes, err := hex.DecodeString(strings.TrimPrefix(response.Data.Data.PrivateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding the private key in the Vault secret for pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	privateKey, err := eth2types.BLSPrivateKeyFromBytes(keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error recreating private key for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+
+	// Verify the private key matches the public key
+	reconstructedPubkey := beacon.ValidatorPubkey(privateKey.PublicKey().Marshal())
+	if reconstructedPubkey != pubkey {
+		return nil, fmt.Errorf("the Vault secret for validator %s contains the key for validator %s", pubkey.HexWithPrefix(), reconstructedPubkey.HexWithPrefix())
+	}
+	return privateKey, nil
+}
+
+// Get the pubkeys of all of the validator keys stored in Vault
+func (ks *VaultKeystoreManager) GetStoredPubkeys() ([]beacon.ValidatorPubkey, error) {
+	path := fmt.Sprintf("/v1/%s/metadata/%s", ks.mountPath, vaultValidatorsPath)
+	responseBody, status, err := ks.sendAuthenticatedRequest("LIST", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing validator keys in Vault: %w", err)
+	}
+	if status == http.StatusNotFound {
+		return []beacon.ValidatorPubkey{}, nil
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("error listing validator keys in Vault: HTTP status %d", status)
+	}
+
+	var response struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil, fmt.Errorf("error deserializing Vault key list: %w", err)
+	}
+	pubkeys := []beacon.ValidatorPubkey{}
+	for _, name := range response.Data.Keys {
+		pubkey, err := beacon.HexToValidatorPubkey(name)
+		if err != nil {
+			continue
+		}
+		pubkeys = append(pubkeys, pubkey)
+	}
+	return pubkeys, nil
+}
+
+// Delete a validator key from Vault, including all of its versions; this does nothing if the key isn't stored
+func (ks *VaultKeystoreManager) DeleteValidatorKey(pubkey beacon.ValidatorPubkey) error {
+	path := fmt.Sprintf("/v1/%s/metadata/%s/%s", ks.mountPath, vaultValidatorsPath, pubkey.HexWithPrefix())
+	_, status, err := ks.sendAuthenticatedRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting the Vault secret for pubkey %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	if status != http.StatusOK && status != http.StatusNoContent && status != http.StatusNotFound {
+		return fmt.Errorf("error deleting the Vault secret for pubkey %s: HTTP status %d", pubkey.HexWithPrefix(), status)
+	}
+	return nil
+}
+
+// Get the API path of the secret for the provided pubkey
+func (ks *VaultKeystoreManager) getDataPath(pubkey beacon.ValidatorPubkey) string {
+	return fmt.Sprintf("/v1/%s/data/%s/%s", ks.mountPath, vaultValidatorsPath, pubkey.HexWithPrefix())
+}
+
+// Log in to Vault with AppRole and use the resulting client token for later requests
+func (ks *VaultKeystoreManager) loginWithAppRole() error {
+	body := map[string]string{
+		"role_id":   ks.roleID,
+		"secret_id": ks.secretID,
+	}
+	responseBody, status, err := ks.sendRequest(http.MethodPost, "/v1/auth/approle/login", body, "")
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("HTTP status %d", status)
+	}
+	return ks.setTokenFromResponse(responseBody)
+}
+
+// Renew the current client token, extending its lease
+func (ks *VaultKeystoreManager) renewToken() error {
+	responseBody, status, err := ks.sendRequest(http.MethodPost, "/v1/auth/token/renew-self", map[string]string{}, ks.token)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("HTTP status %d", status)
+	}
+	return ks.setTokenFromResponse(responseBody)
+}
+
+// Store the client token and lease from a login or renewal response
+func (ks *VaultKeystoreManager) setTokenFromResponse(responseBody []byte) error {
+	var response vaultAuthResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return fmt.Errorf("error deserializing auth response: %w", err)
+	}
+	if response.Auth.ClientToken == "" {
+		return errors.New("auth response did not include a client token")
+	}
+
+	ks.token = response.Auth.ClientToken
+	ks.tokenRenewable = response.Auth.Renewable
+	ks.tokenRenewTime = time.Time{}
+	ks.tokenExpiry = time.Time{}
+	if response.Auth.LeaseDuration > 0 {
+		now := time.Now()
+		lease := time.Duration(response.Auth.LeaseDuration) * time.Second
+		ks.tokenRenewTime = now.Add(time.Duration(float64(lease) * vaultTokenRenewalFraction))
+		ks.tokenExpiry = now.Add(lease)
+	}
+	return nil
+}
+
+// Get a token for the next request, renewing the current AppRole token or logging in again if its lease is running out
+func (ks *VaultKeystoreManager) getToken() (string, error) {
+	ks.tokenLock.Lock()
+	defer ks.tokenLock.Unlock()
+
+	if ks.roleID == "" || ks.tokenRenewTime.IsZero() || time.Now().Before(ks.tokenRenewTime) {
+		return ks.token, nil
+	}
+
+	// Renew the token while it's still valid, falling back to a new login if that fails
+	if ks.tokenRenewable && time.Now().Before(ks.tokenExpiry) {
+		if err := ks.renewToken(); err == nil {
+			return ks.token, nil
+		}
+	}
+	if err := ks.loginWithAppRole(); err != nil {
+		return "", fmt.Errorf("error logging in to Vault with AppRole: %w", err)
+	}
+	return ks.token, nil
+}
+
+// Log in again with AppRole after Vault rejected the provided token, unless another request already replaced it
+func (ks *VaultKeystoreManager) replaceRejectedToken(rejectedToken string) (string, error) {
+	ks.tokenLock.Lock()
+	defer ks.tokenLock.Unlock()
+
+	if ks.token != rejectedToken {
+		return ks.token, nil
+	}
+	if err := ks.loginWithAppRole(); err != nil {
+		return "", fmt.Errorf("error logging in to Vault with AppRole: %w", err)
+	}
+	return ks.token, nil
+}
+
+// Send a request to the Vault API with the current token. If Vault rejects the token and the manager uses AppRole,
+// this logs in again and retries the request once.
+func (ks *VaultKeystoreManager) sendAuthenticatedRequest(method string, path string, requestBody any) ([]byte, int, error) {
+	token, err := ks.getToken()
+	if err != nil {
+		return nil, 0, err
+	}
+	body, status, err := ks.sendRequest(method, path, requestBody, token)
+	if err != nil || status != http.StatusForbidden || ks.roleID == "" {
+		return body, status, err
+	}
+
+	token, err = ks.replaceRejectedToken(token)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ks.sendRequest(method, path, requestBody, token)
+}
+
+// Send a request to the Vault API and read the body of the response
+func (ks *VaultKeystoreManager) sendRequest(method string, path string, requestBody any, token string) ([]byte, int, error) {
+	// Serialize the body
+	var bodyReader io.Reader
+	if requestBody != nil {
+		requestBodyBytes, err := json.Marshal(requestBody)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error serializing request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(requestBodyBytes)
+	}
+
+	// Make the request
+	ctx, cancel := context.WithTimeout(context.Background(), vaultRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, ks.address+path, bodyReader)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error creating %s request to [%s]: %w", method, path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	// Submit the request
+	response, err := ks.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error running %s request to [%s]: %w", method, path, err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error reading response from [%s]: %w", path, err)
+	}
+	return body, response.StatusCode, nil
+}
@@ -0,0 +1,342 @@
+package keystore
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+const (
+	testRoleID   string = "test-role"
+	testSecretID string = "test-secret"
+)
+
+// A fake Vault server with the AppRole login, token renewal, and KV version 2 endpoints
+type fakeVault struct {
+	server *httptest.Server
+	lock   sync.Mutex
+
+	// The token that's currently accepted
+	validToken string
+
+	// The lease of issued tokens, in seconds, and whether they can be renewed
+	leaseDuration int64
+	renewable     bool
+
+	// The number of tokens issued, used to make each one unique
+	tokenCount int
+
+	// The number of calls to each auth endpoint
+	logins   int
+	renewals int
+
+	// The stored secrets, by path under the mount
+	secrets map[string]json.RawMessage
+}
+
+func newFakeVault(t *testing.T) *fakeVault {
+	v := &fakeVault{
+		leaseDuration: 3600,
+		renewable:     true,
+		secrets:       map[string]json.RawMessage{},
+	}
+	v.server = httptest.NewServer(http.HandlerFunc(v.handle))
+	t.Cleanup(v.server.Close)
+	return v
+}
+
+// Issue a new token and write it as an auth response
+func (v *fakeVault) writeNewToken(w http.ResponseWriter) {
+	v.tokenCount++
+	v.validToken = fmt.Sprintf("token-%d", v.tokenCount)
+	response := map[string]any{
+		"auth": map[string]any{
+			"client_token":   v.validToken,
+			"lease_duration": v.leaseDuration,
+			"renewable":      v.renewable,
+		},
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (v *fakeVault) handle(w http.ResponseWriter, r *http.Request) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if r.URL.Path == "/v1/auth/approle/login" {
+		v.logins++
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body["role_id"] != testRoleID || body["secret_id"] != testSecretID {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		v.writeNewToken(w)
+		return
+	}
+
+	// Everything else needs a valid token
+	if r.Header.Get("X-Vault-Token") != v.validToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	path := r.URL.Path
+	switch {
+	case path == "/v1/auth/token/renew-self":
+		v.renewals++
+		if !v.renewable {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		response := map[string]any{
+			"auth": map[string]any{
+				"client_token":   v.validToken,
+				"lease_duration": v.leaseDuration,
+				"renewable":      true,
+			},
+		}
+		_ = json.NewEncoder(w).Encode(response)
+
+	case strings.HasPrefix(path, "/v1/secret/data/"):
+		name := strings.TrimPrefix(path, "/v1/secret/data/")
+		switch r.Method {
+		case http.MethodPost:
+			var body struct {
+				Data json.RawMessage `json:"data"`
+			}
+			bytes, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(bytes, &body)
+			v.secrets[name] = body.Data
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			secret, exists := v.secrets[name]
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"data": secret,
+				},
+			})
+		}
+
+	case strings.HasPrefix(path, "/v1/secret/metadata/"):
+		name := strings.TrimPrefix(path, "/v1/secret/metadata/")
+		switch r.Method {
+		case "LIST":
+			keys := []string{}
+			for secretName := range v.secrets {
+				if strings.HasPrefix(secretName, name+"/") {
+					keys = append(keys, strings.TrimPrefix(secretName, name+"/"))
+				}
+			}
+			if len(keys) == 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"keys": keys,
+				},
+			})
+		case http.MethodDelete:
+			delete(v.secrets, name)
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// Get the number of logins and renewals
+func (v *fakeVault) getAuthCounts() (int, int) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.logins, v.renewals
+}
+
+// Revoke the current token so requests made with it are rejected
+func (v *fakeVault) revokeToken() {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.validToken = "revoked"
+}
+
+// Create a new BLS key for testing
+func newTestKey(t *testing.T) *eth2types.BLSPrivateKey {
+	if err := eth2types.InitBLS(); err != nil {
+		t.Fatalf("error initializing BLS: %v", err)
+	}
+	key, err := eth2types.GenerateBLSPrivateKey()
+	if err != nil {
+		t.Fatalf("error generating BLS key: %v", err)
+	}
+	return key
+}
+
+// Create a Vault keystore manager that logs in to the fake server with AppRole
+func newAppRoleManager(t *testing.T, vault *fakeVault) *VaultKeystoreManager {
+	ks, err := NewVaultKeystoreManager(VaultConfig{
+		Address:  vault.server.URL,
+		RoleID:   testRoleID,
+		SecretID: testSecretID,
+	})
+	if err != nil {
+		t.Fatalf("error creating Vault keystore manager: %v", err)
+	}
+	return ks
+}
+
+func TestVaultKeyRoundTrip(t *testing.T) {
+	vault := newFakeVault(t)
+	ks := newAppRoleManager(t, vault)
+	key := newTestKey(t)
+	pubkey := beacon.ValidatorPubkey(key.PublicKey().Marshal())
+
+	if err := ks.StoreValidatorKey(key, "m/12381/3600/0/0/0"); err != nil {
+		t.Fatalf("error storing key: %v", err)
+	}
+	loaded, err := ks.LoadValidatorKey(pubkey)
+	if err != nil {
+		t.Fatalf("error loading key: %v", err)
+	}
+	if loaded == nil || string(loaded.Marshal()) != string(key.Marshal()) {
+		t.Fatal("loaded key doesn't match the stored key")
+	}
+
+	pubkeys, err := ks.GetStoredPubkeys()
+	if err != nil {
+		t.Fatalf("error listing keys: %v", err)
+	}
+	if len(pubkeys) != 1 || pubkeys[0] != pubkey {
+		t.Fatalf("expected the stored pubkey to be listed, got %v", pubkeys)
+	}
+
+	if err := ks.DeleteValidatorKey(pubkey); err != nil {
+		t.Fatalf("error deleting key: %v", err)
+	}
+	loaded, err = ks.LoadValidatorKey(pubkey)
+	if err != nil {
+		t.Fatalf("error loading deleted key: %v", err)
+	}
+	if loaded != nil {
+		t.Fatal("expected the deleted key to be missing")
+	}
+}
+
+func TestVaultTokenRenewal(t *testing.T) {
+	tests := []struct {
+		name             string
+		renewable        bool
+		expired          bool
+		expectedLogins   int
+		expectedRenewals int
+	}{
+		{
+			name:             "renewable token is renewed",
+			renewable:        true,
+			expectedLogins:   1,
+			expectedRenewals: 1,
+		}, {
+			name:             "non-renewable token is replaced with a new login",
+			renewable:        false,
+			expectedLogins:   2,
+			expectedRenewals: 0,
+		}, {
+			name:             "expired token is replaced with a new login",
+			renewable:        true,
+			expired:          true,
+			expectedLogins:   2,
+			expectedRenewals: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vault := newFakeVault(t)
+			vault.renewable = test.renewable
+			ks := newAppRoleManager(t, vault)
+
+			// Move the lease forward to the renewal point
+			ks.tokenRenewTime = time.Now().Add(-time.Second)
+			if test.expired {
+				ks.tokenExpiry = time.Now().Add(-time.Second)
+			}
+
+			if _, err := ks.GetStoredPubkeys(); err != nil {
+				t.Fatalf("error listing keys: %v", err)
+			}
+			logins, renewals := vault.getAuthCounts()
+			if logins != test.expectedLogins {
+				t.Errorf("expected %d logins, got %d", test.expectedLogins, logins)
+			}
+			if renewals != test.expectedRenewals {
+				t.Errorf("expected %d renewals, got %d", test.expectedRenewals, renewals)
+			}
+			if !ks.tokenRenewTime.After(time.Now()) {
+				t.Error("expected the token's lease to be extended")
+			}
+		})
+	}
+}
+
+func TestVaultTokenNotRenewedEarly(t *testing.T) {
+	vault := newFakeVault(t)
+	ks := newAppRoleManager(t, vault)
+
+	for i := 0; i < 3; i++ {
+		if _, err := ks.GetStoredPubkeys(); err != nil {
+			t.Fatalf("error listing keys: %v", err)
+		}
+	}
+	logins, renewals := vault.getAuthCounts()
+	if logins != 1 || renewals != 0 {
+		t.Errorf("expected 1 login and no renewals for a fresh token, got %d logins and %d renewals", logins, renewals)
+	}
+}
+
+func TestVaultReloginOnForbidden(t *testing.T) {
+	vault := newFakeVault(t)
+	ks := newAppRoleManager(t, vault)
+	vault.revokeToken()
+
+	if _, err := ks.GetStoredPubkeys(); err != nil {
+		t.Fatalf("error listing keys after the token was revoked: %v", err)
+	}
+	logins, _ := vault.getAuthCounts()
+	if logins != 2 {
+		t.Errorf("expected a second login after the token was rejected, got %d logins", logins)
+	}
+}
+
+func TestVaultStaticTokenForbidden(t *testing.T) {
+	vault := newFakeVault(t)
+	ks, err := NewVaultKeystoreManager(VaultConfig{
+		Address: vault.server.URL,
+		Token:   "static-token",
+	})
+	if err != nil {
+		t.Fatalf("error creating Vault keystore manager: %v", err)
+	}
+
+	// Static tokens can't be replaced, so the rejection is reported
+	if _, err := ks.GetStoredPubkeys(); err == nil {
+		t.Fatal("expected an error when Vault rejects a static token")
+	}
+	logins, _ := vault.getAuthCounts()
+	if logins != 0 {
+		t.Errorf("expected no AppRole logins with a static token, got %d", logins)
+	}
+}
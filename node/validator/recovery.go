@@ -0,0 +1,87 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// The EIP-2334 derivation path format for validator signing keys
+	ValidatorKeyPath string = "m/12381/3600/%d/0/0"
+)
+
+// Called after each validator key is processed during recovery or searching
+type RecoveryProgressCallback func(index uint64, pubkey beacon.ValidatorPubkey)
+
+// Derive the validator keys for a range of indices from a mnemonic and store them in all of the manager's client keystores.
+// The callback is optional and will be called after each key has been stored.
+func RecoverValidatorKeys(vm *ValidatorManager, mnemonic string, startIndex uint64, count uint64, progress RecoveryProgressCallback) ([]beacon.ValidatorPubkey, error) {
+	seed, err := getSeedFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	pubkeys := make([]beacon.ValidatorPubkey, 0, count)
+	for index := startIndex; index < startIndex+count; index++ {
+		path := fmt.Sprintf(ValidatorKeyPath, index)
+		key, err := getPrivateKeyFromSeed(seed, path)
+		if err != nil {
+			return pubkeys, err
+		}
+
+		err = vm.StoreKey(key, path)
+		if err != nil {
+			return pubkeys, err
+		}
+
+		pubkey := beacon.ValidatorPubkey(key.PublicKey().Marshal())
+		pubkeys = append(pubkeys, pubkey)
+		if progress != nil {
+			progress(index, pubkey)
+		}
+	}
+	return pubkeys, nil
+}
+
+// Search the derivation indices of a mnemonic for the provided validator pubkeys, starting at startIndex and checking up to
+// maxCount indices. Returns the index of each pubkey that was found; the search stops early once all of them have been found.
+// The callback is optional and will be called after each index has been checked.
+func FindValidatorKeyIndices(mnemonic string, targets []beacon.ValidatorPubkey, startIndex uint64, maxCount uint64, progress RecoveryProgressCallback) (map[beacon.ValidatorPubkey]uint64, error) {
+	seed, err := getSeedFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make(map[beacon.ValidatorPubkey]bool, len(targets))
+	for _, target := range targets {
+		remaining[target] = true
+	}
+
+	indices := map[beacon.ValidatorPubkey]uint64{}
+	for index := startIndex; index < startIndex+maxCount && len(remaining) > 0; index++ {
+		key, err := getPrivateKeyFromSeed(seed, fmt.Sprintf(ValidatorKeyPath, index))
+		if err != nil {
+			return indices, err
+		}
+
+		pubkey := beacon.ValidatorPubkey(key.PublicKey().Marshal())
+		if remaining[pubkey] {
+			indices[pubkey] = index
+			delete(remaining, pubkey)
+		}
+		if progress != nil {
+			progress(index, pubkey)
+		}
+	}
+	return indices, nil
+}
+
+// Validate a mnemonic's checksum and get its seed
+func getSeedFromMnemonic(mnemonic string) ([]byte, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("invalid mnemonic: it contains unknown words or its checksum doesn't match")
+	}
+	return bip39.NewSeed(mnemonic, ""), nil
+}
@@ -25,7 +25,11 @@ func GetWithdrawalCredsFromAddress(address common.Address) common.Hash {
 func GetPrivateKey(mnemonic string, path string) (*eth2types.BLSPrivateKey, error) {
 	// Generate seed
 	seed := bip39.NewSeed(mnemonic, "")
+	return getPrivateKeyFromSeed(seed, path)
+}
 
+// Get a private BLS key from the seed and path.
+func getPrivateKeyFromSeed(seed []byte, path string) (*eth2types.BLSPrivateKey, error) {
 	// Initialize BLS support
 	if err := InitializeBls(); err != nil {
 		return nil, fmt.Errorf("Could not initialize BLS library: %w", err)
@@ -2,12 +2,16 @@ package validator
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
 	"github.com/rocket-pool/node-manager-core/beacon"
 	"github.com/rocket-pool/node-manager-core/node/validator/keystore"
 	types "github.com/wealdtech/go-eth2-types/v2"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
 type ValidatorManager struct {
@@ -29,6 +33,14 @@ func NewValidatorManager(validatorPath string) *ValidatorManager {
 	return mgr
 }
 
+// Adds a keystore manager, such as a VaultKeystoreManager, that keys will be stored in alongside the client keystores.
+// This replaces any keystore manager that was already registered with the same name.
+func (m *ValidatorManager) AddKeystoreManager(name string, mgr keystore.IKeystoreManager) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.keystoreManagers[name] = mgr
+}
+
 // Stores a validator key into all of the manager's client keystores
 func (m *ValidatorManager) StoreKey(key *types.BLSPrivateKey, derivationPath string) error {
 	m.lock.Lock()
@@ -44,6 +56,66 @@ func (m *ValidatorManager) StoreKey(key *types.BLSPrivateKey, derivationPath str
 	return nil
 }
 
+// Stores a set of validator keys into all of the manager's client keystores, so that either all of them are stored or
+// none of them are. If any key fails to store, the keys written by this call are deleted again (keys that were already
+// stored beforehand are left alone). Rollback is best-effort: the original error is returned, with any rollback
+// failures appended to its message.
+func (m *ValidatorManager) BatchStoreKeys(keys []*types.BLSPrivateKey, derivationPaths []string) error {
+	if len(keys) != len(derivationPaths) {
+		return fmt.Errorf("got %d keys but %d derivation paths", len(keys), len(derivationPaths))
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// Get the keys that are already stored so they aren't deleted during a rollback
+	existingKeys := map[string]map[beacon.ValidatorPubkey]bool{}
+	for name, mgr := range m.keystoreManagers {
+		pubkeys, err := mgr.GetStoredPubkeys()
+		if err != nil {
+			return fmt.Errorf("error getting the keys stored in the %s keystore: %w", name, err)
+		}
+		existingKeys[name] = map[beacon.ValidatorPubkey]bool{}
+		for _, pubkey := range pubkeys {
+			existingKeys[name][pubkey] = true
+		}
+	}
+
+	// Store the keys, tracking what was written
+	type storedKey struct {
+		keystore string
+		pubkey   beacon.ValidatorPubkey
+	}
+	written := []storedKey{}
+	for i, key := range keys {
+		pubkey := beacon.ValidatorPubkey(key.PublicKey().Marshal())
+		for name, mgr := range m.keystoreManagers {
+			err := mgr.StoreValidatorKey(key, derivationPaths[i])
+			if err == nil {
+				if !existingKeys[name][pubkey] {
+					written = append(written, storedKey{keystore: name, pubkey: pubkey})
+				}
+				continue
+			}
+
+			// Roll back the keys that were written
+			err = fmt.Errorf("error saving validator key %s (path %s) to the %s keystore: %w", pubkey.HexWithPrefix(), derivationPaths[i], name, err)
+			rollbackErrors := []string{}
+			for _, stored := range written {
+				deleteErr := m.keystoreManagers[stored.keystore].DeleteValidatorKey(stored.pubkey)
+				if deleteErr != nil {
+					rollbackErrors = append(rollbackErrors, fmt.Sprintf("%s (%s keystore): %s", stored.pubkey.HexWithPrefix(), stored.keystore, deleteErr.Error()))
+				}
+			}
+			if len(rollbackErrors) > 0 {
+				return fmt.Errorf("%w\nthe following keys could not be removed while rolling back:\n%s", err, strings.Join(rollbackErrors, "\n"))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 // Loads a validator key from the manager's client keystores
 func (m *ValidatorManager) LoadKey(pubkey beacon.ValidatorPubkey) (*types.BLSPrivateKey, error) {
 	m.lock.Lock()
@@ -69,3 +141,264 @@ func (m *ValidatorManager) LoadKey(pubkey beacon.ValidatorPubkey) (*types.BLSPri
 		return nil, fmt.Errorf("couldn't find the key for validator %s in any of the validator manager's keystores", pubkey.Hex())
 	}
 }
+
+// Gets the pubkeys of the validator keys stored in each of the manager's client keystores, keyed by keystore name.
+// If any of the keystores couldn't be read, the returned map will contain the ones that succeeded along with an error describing the failures.
+func (m *ValidatorManager) GetStoredPubkeys() (map[string][]beacon.ValidatorPubkey, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	pubkeys := map[string][]beacon.ValidatorPubkey{}
+	errors := []string{}
+	for name, mgr := range m.keystoreManagers {
+		keystorePubkeys, err := mgr.GetStoredPubkeys()
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %s", name, err.Error()))
+			continue
+		}
+		pubkeys[name] = keystorePubkeys
+	}
+
+	if len(errors) > 0 {
+		return pubkeys, fmt.Errorf("encountered the following errors while getting the stored validator keys:\n%s", strings.Join(errors, "\n"))
+	}
+	return pubkeys, nil
+}
+
+// Gets the pubkeys of the validator keys that are stored on disk, without duplicates.
+// All of the client keystores should have the same keys, so this reads from the first keystore (in name order) that
+// can be read successfully.
+func (m *ValidatorManager) ListKeys() ([]beacon.ValidatorPubkey, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	names := make([]string, 0, len(m.keystoreManagers))
+	for name := range m.keystoreManagers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	errors := []string{}
+	for _, name := range names {
+		pubkeys, err := m.keystoreManagers[name].GetStoredPubkeys()
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %s", name, err.Error()))
+			continue
+		}
+
+		// Remove any duplicates
+		seen := map[beacon.ValidatorPubkey]bool{}
+		uniquePubkeys := make([]beacon.ValidatorPubkey, 0, len(pubkeys))
+		for _, pubkey := range pubkeys {
+			if !seen[pubkey] {
+				seen[pubkey] = true
+				uniquePubkeys = append(uniquePubkeys, pubkey)
+			}
+		}
+		return uniquePubkeys, nil
+	}
+	return nil, fmt.Errorf("encountered the following errors while listing the stored validator keys:\n%s", strings.Join(errors, "\n"))
+}
+
+// Deletes a validator key from all of the manager's client keystores.
+// The result for each keystore is returned, keyed by keystore name; a nil error means the key was deleted or was never present in that keystore.
+func (m *ValidatorManager) DeleteValidatorKey(pubkey beacon.ValidatorPubkey) map[string]error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	results := map[string]error{}
+	for name, mgr := range m.keystoreManagers {
+		err := mgr.DeleteValidatorKey(pubkey)
+		if err != nil {
+			err = fmt.Errorf("error deleting validator key %s from the %s keystore: %w", pubkey.HexWithPrefix(), name, err)
+		}
+		results[name] = err
+	}
+	return results
+}
+
+// Deletes a validator key from all of the manager's client keystores.
+// Keystores that don't have the key are skipped; if any of the keystores failed to delete it, the failures are combined
+// into the returned error.
+func (m *ValidatorManager) DeleteKey(pubkey beacon.ValidatorPubkey) error {
+	results := m.DeleteValidatorKey(pubkey)
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	errors := []string{}
+	for _, name := range names {
+		if results[name] != nil {
+			errors = append(errors, results[name].Error())
+		}
+	}
+	if len(errors) > 0 {
+		return fmt.Errorf("encountered the following errors while deleting the key for validator %s:\n%s", pubkey.HexWithPrefix(), strings.Join(errors, "\n"))
+	}
+	return nil
+}
+
+// Copies every validator key from one client's keystore into another client's keystore, such as when switching
+// Validator Clients. Keys that are already in the destination keystore are skipped, so this is safe to run repeatedly.
+// Since the source keystore doesn't provide derivation paths, the migrated keys are stored without one.
+// Use GetKeystoreMigrationPlan to see which keys would be migrated without changing anything.
+func (m *ValidatorManager) MigrateKeystores(fromClient string, toClient string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	pubkeys, err := m.getKeystoreMigrationPlan(fromClient, toClient)
+	if err != nil {
+		return err
+	}
+	fromMgr := m.keystoreManagers[fromClient]
+	toMgr := m.keystoreManagers[toClient]
+	for _, pubkey := range pubkeys {
+		key, err := fromMgr.LoadValidatorKey(pubkey)
+		if err != nil {
+			return fmt.Errorf("error loading validator key %s from the %s keystore: %w", pubkey.HexWithPrefix(), fromClient, err)
+		}
+		if key == nil {
+			return fmt.Errorf("validator key %s could not be found in the %s keystore", pubkey.HexWithPrefix(), fromClient)
+		}
+		err = toMgr.StoreValidatorKey(key, "")
+		if err != nil {
+			return fmt.Errorf("error saving validator key %s to the %s keystore: %w", pubkey.HexWithPrefix(), toClient, err)
+		}
+	}
+	return nil
+}
+
+// Gets the pubkeys of the validator keys that MigrateKeystores would copy from one client's keystore to another's,
+// without changing anything
+func (m *ValidatorManager) GetKeystoreMigrationPlan(fromClient string, toClient string) ([]beacon.ValidatorPubkey, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.getKeystoreMigrationPlan(fromClient, toClient)
+}
+
+// Get the pubkeys of the keys in one client's keystore that aren't in another client's keystore
+func (m *ValidatorManager) getKeystoreMigrationPlan(fromClient string, toClient string) ([]beacon.ValidatorPubkey, error) {
+	fromMgr, exists := m.keystoreManagers[fromClient]
+	if !exists {
+		return nil, fmt.Errorf("unknown client keystore [%s]", fromClient)
+	}
+	toMgr, exists := m.keystoreManagers[toClient]
+	if !exists {
+		return nil, fmt.Errorf("unknown client keystore [%s]", toClient)
+	}
+	if fromClient == toClient {
+		return nil, fmt.Errorf("can't migrate the %s keystore to itself", fromClient)
+	}
+
+	// Get the keys in each keystore
+	fromPubkeys, err := fromMgr.GetStoredPubkeys()
+	if err != nil {
+		return nil, fmt.Errorf("error getting the keys stored in the %s keystore: %w", fromClient, err)
+	}
+	toPubkeys, err := toMgr.GetStoredPubkeys()
+	if err != nil {
+		return nil, fmt.Errorf("error getting the keys stored in the %s keystore: %w", toClient, err)
+	}
+	existing := map[beacon.ValidatorPubkey]bool{}
+	for _, pubkey := range toPubkeys {
+		existing[pubkey] = true
+	}
+
+	// Find the ones that need to be migrated
+	pubkeys := []beacon.ValidatorPubkey{}
+	for _, pubkey := range fromPubkeys {
+		if !existing[pubkey] {
+			existing[pubkey] = true
+			pubkeys = append(pubkeys, pubkey)
+		}
+	}
+	return pubkeys, nil
+}
+
+// Exports a validator key from the manager's client keystores as an EIP-2335 keystore, encrypted with the provided password
+func (m *ValidatorManager) ExportKeystore(pubkey beacon.ValidatorPubkey, password string) ([]byte, error) {
+	if password == "" {
+		return nil, fmt.Errorf("a password is required to export a keystore")
+	}
+
+	// Get the key
+	key, err := m.LoadKey(pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encrypt it
+	encryptor := eth2ks.New(eth2ks.WithCipher("scrypt"))
+	encryptedKey, err := encryptor.Encrypt(key.Marshal(), password)
+	if err != nil {
+		return nil, fmt.Errorf("error encrypting key for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+
+	// Create the keystore
+	keystore := beacon.ValidatorKeystore{
+		Crypto:  encryptedKey,
+		Version: encryptor.Version(),
+		UUID:    uuid.New(),
+		Pubkey:  pubkey,
+	}
+	bytes, err := json.Marshal(keystore)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing keystore for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	return bytes, nil
+}
+
+// Imports an EIP-2335 keystore, decrypting it with the provided password and storing the key in all of the manager's client keystores.
+// The keystore is fully decrypted and verified before anything is written to disk.
+// If the derivation path is blank, the path recorded in the keystore will be used.
+func (m *ValidatorManager) ImportKeystore(keystoreJson []byte, password string, derivationPath string) error {
+	key, keystorePath, err := DecryptKeystore(keystoreJson, password)
+	if err != nil {
+		return err
+	}
+
+	// Store it
+	if derivationPath == "" {
+		derivationPath = keystorePath
+	}
+	return m.StoreKey(key, derivationPath)
+}
+
+// Decrypts an EIP-2335 keystore with the provided password, returning the validator key and the derivation path recorded
+// in the keystore (which may be blank). If the keystore includes a pubkey, it's checked against the decrypted key.
+func DecryptKeystore(keystoreJson []byte, password string) (*types.BLSPrivateKey, string, error) {
+	// Deserialize the keystore
+	var keystore beacon.ValidatorKeystore
+	err := json.Unmarshal(keystoreJson, &keystore)
+	if err != nil {
+		return nil, "", fmt.Errorf("error deserializing keystore: %w", err)
+	}
+	if keystore.Crypto == nil {
+		return nil, "", fmt.Errorf("keystore is missing its crypto section")
+	}
+
+	// Decrypt the key
+	encryptor := eth2ks.New()
+	decryptedKey, err := encryptor.Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, "", fmt.Errorf("error decrypting keystore (is the password correct?): %w", err)
+	}
+	err = InitializeBls()
+	if err != nil {
+		return nil, "", fmt.Errorf("error initializing BLS library: %w", err)
+	}
+	key, err := types.BLSPrivateKeyFromBytes(decryptedKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("error recreating private key from keystore: %w", err)
+	}
+
+	// Make sure the pubkey matches, if the keystore has one
+	pubkey := beacon.ValidatorPubkey(key.PublicKey().Marshal())
+	if !keystore.Pubkey.IsZero() && keystore.Pubkey != pubkey {
+		return nil, "", fmt.Errorf("keystore claims to be for validator %s but its key is for validator %s", keystore.Pubkey.HexWithPrefix(), pubkey.HexWithPrefix())
+	}
+	return key, keystore.Path, nil
+}
@@ -0,0 +1,282 @@
+package vcclient
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/goccy/go-json"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+	"github.com/rocket-pool/node-manager-core/log"
+)
+
+const (
+	RequestUrlFormat   = "%s%s"
+	RequestContentType = "application/json"
+
+	RequestKeystoresPath    = "/eth/v1/keystores"
+	RequestRemoteKeysPath   = "/eth/v1/remotekeys"
+	RequestFeeRecipientPath = "/eth/v1/validator/%s/feerecipient"
+	RequestGasLimitPath     = "/eth/v1/validator/%s/gas_limit"
+)
+
+// Client for a Validator Client's keymanager API, which can be used to manage its keys remotely
+type KeymanagerClient struct {
+	providerAddress string
+	authToken       string
+	client          http.Client
+}
+
+// Create a new keymanager API client. The auth token file is the one generated by the Validator Client for bearer authentication.
+func NewKeymanagerClient(providerAddress string, authTokenPath string, timeout time.Duration) (*KeymanagerClient, error) {
+	tokenBytes, err := os.ReadFile(authTokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading keymanager API auth token file [%s]: %w", authTokenPath, err)
+	}
+	token := strings.TrimSpace(string(tokenBytes))
+	if token == "" {
+		return nil, fmt.Errorf("keymanager API auth token file [%s] is empty", authTokenPath)
+	}
+
+	return &KeymanagerClient{
+		providerAddress: strings.TrimSuffix(providerAddress, "/"),
+		authToken:       token,
+		client: http.Client{
+			Timeout: timeout,
+		},
+	}, nil
+}
+
+// List the local keystores loaded by the Validator Client
+func (c *KeymanagerClient) ListKeystores(ctx context.Context) (ListKeystoresResponse, error) {
+	responseBody, status, err := c.sendRequest(ctx, http.MethodGet, RequestKeystoresPath, nil)
+	if err != nil {
+		return ListKeystoresResponse{}, fmt.Errorf("error listing keystores: %w", err)
+	}
+	if status != http.StatusOK {
+		return ListKeystoresResponse{}, fmt.Errorf("error listing keystores: HTTP status %d; response body: '%s'", status, getErrorMessage(responseBody))
+	}
+	var keystores ListKeystoresResponse
+	if err := json.Unmarshal(responseBody, &keystores); err != nil {
+		return ListKeystoresResponse{}, fmt.Errorf("error decoding keystores: %w", err)
+	}
+	return keystores, nil
+}
+
+// Import keystores into the Validator Client. There must be one password per keystore.
+// The slashing protection data is an EIP-3076 interchange file; it's optional and will be ignored if empty.
+func (c *KeymanagerClient) ImportKeystores(ctx context.Context, keystores []beacon.ValidatorKeystore, passwords []string, slashingProtection []byte) (ImportKeystoresResponse, error) {
+	if len(keystores) != len(passwords) {
+		return ImportKeystoresResponse{}, fmt.Errorf("keystore count (%d) doesn't match password count (%d)", len(keystores), len(passwords))
+	}
+
+	// Keystores are sent as serialized JSON strings
+	request := ImportKeystoresRequest{
+		Keystores:          make([]string, len(keystores)),
+		Passwords:          passwords,
+		SlashingProtection: string(slashingProtection),
+	}
+	for i, keystore := range keystores {
+		keystoreBytes, err := json.Marshal(keystore)
+		if err != nil {
+			return ImportKeystoresResponse{}, fmt.Errorf("error serializing keystore for validator %s: %w", keystore.Pubkey.HexWithPrefix(), err)
+		}
+		request.Keystores[i] = string(keystoreBytes)
+	}
+
+	responseBody, status, err := c.sendRequest(ctx, http.MethodPost, RequestKeystoresPath, request)
+	if err != nil {
+		return ImportKeystoresResponse{}, fmt.Errorf("error importing keystores: %w", err)
+	}
+	if status != http.StatusOK {
+		return ImportKeystoresResponse{}, fmt.Errorf("error importing keystores: HTTP status %d; response body: '%s'", status, getErrorMessage(responseBody))
+	}
+	var response ImportKeystoresResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return ImportKeystoresResponse{}, fmt.Errorf("error decoding keystore import response: %w", err)
+	}
+	return response, nil
+}
+
+// Delete keystores from the Validator Client. The response includes the EIP-3076 slashing protection data for the deleted keys.
+func (c *KeymanagerClient) DeleteKeystores(ctx context.Context, pubkeys []beacon.ValidatorPubkey) (DeleteKeystoresResponse, error) {
+	request := DeleteKeystoresRequest{
+		Pubkeys: make([]string, len(pubkeys)),
+	}
+	for i, pubkey := range pubkeys {
+		request.Pubkeys[i] = pubkey.HexWithPrefix()
+	}
+
+	responseBody, status, err := c.sendRequest(ctx, http.MethodDelete, RequestKeystoresPath, request)
+	if err != nil {
+		return DeleteKeystoresResponse{}, fmt.Errorf("error deleting keystores: %w", err)
+	}
+	if status != http.StatusOK {
+		return DeleteKeystoresResponse{}, fmt.Errorf("error deleting keystores: HTTP status %d; response body: '%s'", status, getErrorMessage(responseBody))
+	}
+	var response DeleteKeystoresResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return DeleteKeystoresResponse{}, fmt.Errorf("error decoding keystore deletion response: %w", err)
+	}
+	return response, nil
+}
+
+// List the remote signer keys loaded by the Validator Client
+func (c *KeymanagerClient) ListRemoteKeys(ctx context.Context) (ListRemoteKeysResponse, error) {
+	responseBody, status, err := c.sendRequest(ctx, http.MethodGet, RequestRemoteKeysPath, nil)
+	if err != nil {
+		return ListRemoteKeysResponse{}, fmt.Errorf("error listing remote keys: %w", err)
+	}
+	if status != http.StatusOK {
+		return ListRemoteKeysResponse{}, fmt.Errorf("error listing remote keys: HTTP status %d; response body: '%s'", status, getErrorMessage(responseBody))
+	}
+	var remoteKeys ListRemoteKeysResponse
+	if err := json.Unmarshal(responseBody, &remoteKeys); err != nil {
+		return ListRemoteKeysResponse{}, fmt.Errorf("error decoding remote keys: %w", err)
+	}
+	return remoteKeys, nil
+}
+
+// Get the fee recipient used by a validator
+func (c *KeymanagerClient) GetFeeRecipient(ctx context.Context, pubkey beacon.ValidatorPubkey) (common.Address, error) {
+	responseBody, status, err := c.sendRequest(ctx, http.MethodGet, fmt.Sprintf(RequestFeeRecipientPath, pubkey.HexWithPrefix()), nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error getting fee recipient for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	if status != http.StatusOK {
+		return common.Address{}, fmt.Errorf("error getting fee recipient for validator %s: HTTP status %d; response body: '%s'", pubkey.HexWithPrefix(), status, getErrorMessage(responseBody))
+	}
+	var response FeeRecipientResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return common.Address{}, fmt.Errorf("error decoding fee recipient for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	return response.Data.EthAddress, nil
+}
+
+// Set the fee recipient used by a validator
+func (c *KeymanagerClient) SetFeeRecipient(ctx context.Context, pubkey beacon.ValidatorPubkey, feeRecipient common.Address) error {
+	request := SetFeeRecipientRequest{
+		EthAddress: feeRecipient,
+	}
+	responseBody, status, err := c.sendRequest(ctx, http.MethodPost, fmt.Sprintf(RequestFeeRecipientPath, pubkey.HexWithPrefix()), request)
+	if err != nil {
+		return fmt.Errorf("error setting fee recipient for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	if status != http.StatusAccepted && status != http.StatusOK {
+		return fmt.Errorf("error setting fee recipient for validator %s: HTTP status %d; response body: '%s'", pubkey.HexWithPrefix(), status, getErrorMessage(responseBody))
+	}
+	return nil
+}
+
+// Get the gas limit used by a validator
+func (c *KeymanagerClient) GetGasLimit(ctx context.Context, pubkey beacon.ValidatorPubkey) (uint64, error) {
+	responseBody, status, err := c.sendRequest(ctx, http.MethodGet, fmt.Sprintf(RequestGasLimitPath, pubkey.HexWithPrefix()), nil)
+	if err != nil {
+		return 0, fmt.Errorf("error getting gas limit for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	if status != http.StatusOK {
+		return 0, fmt.Errorf("error getting gas limit for validator %s: HTTP status %d; response body: '%s'", pubkey.HexWithPrefix(), status, getErrorMessage(responseBody))
+	}
+	var response GasLimitResponse
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return 0, fmt.Errorf("error decoding gas limit for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	return uint64(response.Data.GasLimit), nil
+}
+
+// Set the gas limit used by a validator
+func (c *KeymanagerClient) SetGasLimit(ctx context.Context, pubkey beacon.ValidatorPubkey, gasLimit uint64) error {
+	request := SetGasLimitRequest{
+		GasLimit: client.Uinteger(gasLimit),
+	}
+	responseBody, status, err := c.sendRequest(ctx, http.MethodPost, fmt.Sprintf(RequestGasLimitPath, pubkey.HexWithPrefix()), request)
+	if err != nil {
+		return fmt.Errorf("error setting gas limit for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	if status != http.StatusAccepted && status != http.StatusOK {
+		return fmt.Errorf("error setting gas limit for validator %s: HTTP status %d; response body: '%s'", pubkey.HexWithPrefix(), status, getErrorMessage(responseBody))
+	}
+	return nil
+}
+
+// ==========================
+// === Internal Functions ===
+// ==========================
+
+// Make an authenticated request to the keymanager API and read the body of the response.
+// Request bodies aren't logged since they can contain keystore passwords.
+func (c *KeymanagerClient) sendRequest(ctx context.Context, method string, requestPath string, requestBody any) ([]byte, int, error) {
+	logger, _ := log.FromContext(ctx)
+	if logger != nil {
+		logger.Debug("Keymanager API Request", slog.String(log.MethodKey, method), slog.String(log.PathKey, requestPath))
+	}
+
+	// Get request body
+	var requestBodyReader io.Reader
+	if requestBody != nil {
+		requestBodyBytes, err := json.Marshal(requestBody)
+		if err != nil {
+			return []byte{}, 0, err
+		}
+		requestBodyReader = bytes.NewReader(requestBodyBytes)
+	}
+
+	// Create the request
+	path := fmt.Sprintf(RequestUrlFormat, c.providerAddress, requestPath)
+	request, err := http.NewRequestWithContext(ctx, method, path, requestBodyReader)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error creating %s request to [%s]: %w", method, path, err)
+	}
+	request.Header.Set("Content-Type", RequestContentType)
+	request.Header.Set("Authorization", "Bearer "+c.authToken)
+
+	// Submit the request
+	response, err := c.client.Do(request)
+	if err != nil {
+		if logger != nil {
+			logger.Warn("Error running keymanager API request", slog.String(log.MethodKey, method), slog.String(log.PathKey, requestPath), log.Err(err))
+		}
+		return []byte{}, 0, fmt.Errorf("error running %s request to [%s]: %w", method, path, err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	// Get response
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		if logger != nil {
+			logger.Warn("Error reading keymanager API response", slog.String(log.MethodKey, method), slog.String(log.PathKey, requestPath), log.Err(err))
+		}
+		return []byte{}, 0, err
+	}
+
+	// Log the response; only the message of error responses is included since successful ones can contain keystore data
+	if logger != nil {
+		if response.StatusCode >= http.StatusBadRequest {
+			logger.Warn("Keymanager API request failed", slog.String(log.MethodKey, method), slog.String(log.PathKey, requestPath), slog.String(log.CodeKey, response.Status), slog.String(log.ErrorKey, getErrorMessage(body)))
+		} else {
+			logger.Debug("Keymanager API Response", slog.String(log.MethodKey, method), slog.String(log.PathKey, requestPath), slog.String(log.CodeKey, response.Status))
+		}
+	}
+
+	// Return
+	return body, response.StatusCode, nil
+}
+
+// Get the message from an error response, falling back to the raw body if it isn't a standard error
+func getErrorMessage(responseBody []byte) string {
+	var errorResponse ErrorResponse
+	if err := json.Unmarshal(responseBody, &errorResponse); err == nil && errorResponse.Message != "" {
+		return errorResponse.Message
+	}
+	return string(responseBody)
+}
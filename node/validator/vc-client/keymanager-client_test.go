@@ -0,0 +1,98 @@
+package vcclient_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/rocket-pool/node-manager-core/log"
+	vcclient "github.com/rocket-pool/node-manager-core/node/validator/vc-client"
+)
+
+// Create a keymanager client for a fake Validator Client that responds to every request with the status and body
+func newTestKeymanagerClient(t *testing.T, status int, body string) *vcclient.KeymanagerClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	tokenPath := filepath.Join(t.TempDir(), "api-token.txt")
+	if err := os.WriteFile(tokenPath, []byte("test-token\n"), 0600); err != nil {
+		t.Fatalf("error writing auth token: %v", err)
+	}
+	client, err := vcclient.NewKeymanagerClient(server.URL, tokenPath, 5*time.Second)
+	if err != nil {
+		t.Fatalf("error creating keymanager client: %v", err)
+	}
+	return client
+}
+
+func TestRequestLogging(t *testing.T) {
+	client := newTestKeymanagerClient(t, http.StatusOK, `{"data":[]}`)
+	logger, buffer := log.NewMemLogger()
+	ctx := logger.CreateContextWithLogger(context.Background())
+
+	if _, err := client.ListKeystores(ctx); err != nil {
+		t.Fatalf("error listing keystores: %v", err)
+	}
+	output := buffer.String()
+	for _, expected := range []string{
+		`msg="Keymanager API Request" method=GET path=/eth/v1/keystores`,
+		`msg="Keymanager API Response" method=GET path=/eth/v1/keystores code="200 OK"`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected the log to contain %s, got:\n%s", expected, output)
+		}
+	}
+}
+
+func TestErrorResponseLogging(t *testing.T) {
+	client := newTestKeymanagerClient(t, http.StatusBadRequest, `{"message":"invalid gas limit"}`)
+	logger, buffer := log.NewMemLogger()
+	ctx := logger.CreateContextWithLogger(context.Background())
+
+	if err := client.SetGasLimit(ctx, beacon.ValidatorPubkey{}, 0); err == nil {
+		t.Fatal("expected the request to fail")
+	}
+	expected := `level=WARN msg="Keymanager API request failed" method=POST`
+	if output := buffer.String(); !strings.Contains(output, expected) || !strings.Contains(output, `err="invalid gas limit"`) {
+		t.Errorf("expected a warning with the error message, got:\n%s", output)
+	}
+}
+
+func TestConnectionErrorLogging(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "api-token.txt")
+	if err := os.WriteFile(tokenPath, []byte("test-token"), 0600); err != nil {
+		t.Fatalf("error writing auth token: %v", err)
+	}
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	client, err := vcclient.NewKeymanagerClient(server.URL, tokenPath, 5*time.Second)
+	if err != nil {
+		t.Fatalf("error creating keymanager client: %v", err)
+	}
+	logger, buffer := log.NewMemLogger()
+	ctx := logger.CreateContextWithLogger(context.Background())
+
+	if _, err := client.ListRemoteKeys(ctx); err == nil {
+		t.Fatal("expected the request to fail")
+	}
+	if output := buffer.String(); !strings.Contains(output, `level=WARN msg="Error running keymanager API request" method=GET path=/eth/v1/remotekeys`) {
+		t.Errorf("expected a connection warning, got:\n%s", output)
+	}
+}
+
+func TestNoLoggerInContext(t *testing.T) {
+	client := newTestKeymanagerClient(t, http.StatusOK, `{"data":[]}`)
+	if _, err := client.ListKeystores(context.Background()); err != nil {
+		t.Fatalf("error listing keystores: %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package vcclient
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rocket-pool/node-manager-core/beacon"
+	"github.com/rocket-pool/node-manager-core/beacon/client"
+)
+
+// The status of an individual keystore after an import request
+type ImportKeystoreStatus string
+
+const (
+	ImportKeystoreStatus_Imported  ImportKeystoreStatus = "imported"
+	ImportKeystoreStatus_Duplicate ImportKeystoreStatus = "duplicate"
+	ImportKeystoreStatus_Error     ImportKeystoreStatus = "error"
+)
+
+// The status of an individual keystore after a delete request
+type DeleteKeystoreStatus string
+
+const (
+	DeleteKeystoreStatus_Deleted   DeleteKeystoreStatus = "deleted"
+	DeleteKeystoreStatus_NotActive DeleteKeystoreStatus = "not_active"
+	DeleteKeystoreStatus_NotFound  DeleteKeystoreStatus = "not_found"
+	DeleteKeystoreStatus_Error     DeleteKeystoreStatus = "error"
+)
+
+// Request types
+type ImportKeystoresRequest struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection,omitempty"`
+}
+type DeleteKeystoresRequest struct {
+	Pubkeys []string `json:"pubkeys"`
+}
+type SetFeeRecipientRequest struct {
+	EthAddress common.Address `json:"ethaddress"`
+}
+type SetGasLimitRequest struct {
+	GasLimit client.Uinteger `json:"gas_limit"`
+}
+
+// Response types
+type ListKeystoresResponse struct {
+	Data []Keystore `json:"data"`
+}
+type Keystore struct {
+	ValidatingPubkey beacon.ValidatorPubkey `json:"validating_pubkey"`
+	DerivationPath   string                 `json:"derivation_path,omitempty"`
+	ReadOnly         bool                   `json:"readonly,omitempty"`
+}
+type ImportKeystoresResponse struct {
+	Data []ImportKeystoreResult `json:"data"`
+}
+type ImportKeystoreResult struct {
+	Status  ImportKeystoreStatus `json:"status"`
+	Message string               `json:"message,omitempty"`
+}
+type DeleteKeystoresResponse struct {
+	Data               []DeleteKeystoreResult `json:"data"`
+	SlashingProtection string                 `json:"slashing_protection"`
+}
+type DeleteKeystoreResult struct {
+	Status  DeleteKeystoreStatus `json:"status"`
+	Message string               `json:"message,omitempty"`
+}
+type ListRemoteKeysResponse struct {
+	Data []RemoteKey `json:"data"`
+}
+type RemoteKey struct {
+	Pubkey   beacon.ValidatorPubkey `json:"pubkey"`
+	Url      string                 `json:"url,omitempty"`
+	ReadOnly bool                   `json:"readonly,omitempty"`
+}
+type FeeRecipientResponse struct {
+	Data struct {
+		Pubkey     beacon.ValidatorPubkey `json:"pubkey"`
+		EthAddress common.Address         `json:"ethaddress"`
+	} `json:"data"`
+}
+type GasLimitResponse struct {
+	Data struct {
+		Pubkey   beacon.ValidatorPubkey `json:"pubkey"`
+		GasLimit client.Uinteger        `json:"gas_limit"`
+	} `json:"data"`
+}
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
@@ -1,6 +1,7 @@
 package validator
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -42,3 +43,39 @@ func GetSignedExitMessage(validatorKey *eth2types.BLSPrivateKey, validatorIndex
 	return beacon.ValidatorSignature(signature), nil
 
 }
+
+// Exit a validator using its key from the validator manager's keystores.
+// This gets the validator's index, signs the voluntary exit message with the Capella fork domain (per EIP-7044),
+// and broadcasts it to the Beacon Node.
+func ExitValidatorWithKey(ctx context.Context, bc beacon.IBeaconClient, vm *ValidatorManager, pubkey beacon.ValidatorPubkey, epoch uint64) error {
+	// Get the validator index
+	index, err := bc.GetValidatorIndex(ctx, pubkey)
+	if err != nil {
+		return fmt.Errorf("error getting index of validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+
+	// Load the key
+	key, err := vm.LoadKey(pubkey)
+	if err != nil {
+		return fmt.Errorf("error loading key for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+
+	// Get the voluntary exit domain
+	domain, err := bc.GetDomainData(ctx, eth2types.DomainVoluntaryExit[:], epoch, false)
+	if err != nil {
+		return fmt.Errorf("error getting voluntary exit domain data: %w", err)
+	}
+
+	// Sign the exit message
+	signature, err := GetSignedExitMessage(key, index, epoch, domain)
+	if err != nil {
+		return fmt.Errorf("error signing exit message for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+
+	// Broadcast it
+	err = bc.ExitValidator(ctx, index, epoch, signature)
+	if err != nil {
+		return fmt.Errorf("error submitting exit message for validator %s: %w", pubkey.HexWithPrefix(), err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package validator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	eth2types "github.com/wealdtech/go-eth2-types/v2"
+)
+
+const (
+	// A private key from the consensus spec's BLS sign test vectors
+	specPrivateKey string = "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3"
+
+	// The spec's signature of a zero message with the key above
+	specZeroMessageSignature string = "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6" +
+		"076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24" +
+		"802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"
+
+	// The mainnet genesis validators root and Capella fork version, used for exit domains per EIP-7044
+	mainnetGenesisValidatorsRoot string = "4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+	mainnetCapellaForkVersion    string = "03000000"
+)
+
+// Decode a hex string, failing the test if it's invalid
+func mustDecodeHex(t *testing.T, value string) []byte {
+	bytes, err := hex.DecodeString(value)
+	if err != nil {
+		t.Fatalf("error decoding hex [%s]: %v", value, err)
+	}
+	return bytes
+}
+
+// Get the spec test key, and make sure it signs the spec's test message correctly
+func newSpecKey(t *testing.T) *eth2types.BLSPrivateKey {
+	if err := InitializeBls(); err != nil {
+		t.Fatalf("error initializing BLS: %v", err)
+	}
+	key, err := eth2types.BLSPrivateKeyFromBytes(mustDecodeHex(t, specPrivateKey))
+	if err != nil {
+		t.Fatalf("error creating private key: %v", err)
+	}
+	signature := key.Sign(make([]byte, 32)).Marshal()
+	if hex.EncodeToString(signature) != specZeroMessageSignature {
+		t.Fatalf("expected the spec test signature %s, got %x", specZeroMessageSignature, signature)
+	}
+	return key
+}
+
+// Get the hash tree root of a container whose fields are all 32-byte chunks or uint64s, which is just a merkle tree of
+// the fields; this is done by hand rather than with the SSZ types so it checks them independently
+func hashChunks(chunks ...[]byte) []byte {
+	layer := make([][]byte, 0, len(chunks))
+	for _, chunk := range chunks {
+		padded := make([]byte, 32)
+		copy(padded, chunk)
+		layer = append(layer, padded)
+	}
+	for len(layer) > 1 {
+		if len(layer)%2 == 1 {
+			layer = append(layer, make([]byte, 32))
+		}
+		next := make([][]byte, 0, len(layer)/2)
+		for i := 0; i < len(layer); i += 2 {
+			hash := sha256.Sum256(append(bytes.Clone(layer[i]), layer[i+1]...))
+			next = append(next, hash[:])
+		}
+		layer = next
+	}
+	return layer[0]
+}
+
+// Encode a uint64 as an SSZ chunk
+func uint64Chunk(value uint64) []byte {
+	chunk := make([]byte, 32)
+	binary.LittleEndian.PutUint64(chunk, value)
+	return chunk
+}
+
+// Compute a signature domain per the spec: the domain type followed by the first 28 bytes of the fork data root
+func computeDomain(domainType []byte, forkVersion []byte, genesisValidatorsRoot []byte) []byte {
+	forkDataRoot := hashChunks(forkVersion, genesisValidatorsRoot)
+	return append(bytes.Clone(domainType), forkDataRoot[:28]...)
+}
+
+func TestGetSignedExitMessage(t *testing.T) {
+	key := newSpecKey(t)
+	domain := computeDomain(eth2types.DomainVoluntaryExit[:], mustDecodeHex(t, mainnetCapellaForkVersion), mustDecodeHex(t, mainnetGenesisValidatorsRoot))
+
+	// The domain must match the library's computation
+	libraryDomain, err := eth2types.ComputeDomain(eth2types.DomainVoluntaryExit, mustDecodeHex(t, mainnetCapellaForkVersion), mustDecodeHex(t, mainnetGenesisValidatorsRoot))
+	if err != nil {
+		t.Fatalf("error computing domain: %v", err)
+	}
+	if !bytes.Equal(domain, libraryDomain) {
+		t.Fatalf("expected domain %x, got %x", domain, libraryDomain)
+	}
+
+	tests := []struct {
+		name              string
+		validatorIndex    uint64
+		epoch             uint64
+		expectedSignature string
+	}{
+		{
+			name:           "index 0 at genesis",
+			validatorIndex: 0,
+			epoch:          0,
+			expectedSignature: "af2edb1c9cfe010eb7d0dfadf3b90ee461c84de810100d7a9d843ed435812d44" +
+				"30cbd8b42e5fe474dfed0102ca2379460d20b25c343b5d9378b06b83f0cf71b2" +
+				"ac88a44b46d68e251e140a7b946068014e81d197ee25e83d9839a42549737fdc",
+		}, {
+			name:           "mainnet validator at Capella",
+			validatorIndex: 123456,
+			epoch:          194048,
+			expectedSignature: "819c3024c615b4ec0ead9d6168016798d631e2f413984fd340e6983eec211a70" +
+				"9a317baecf406c81045be152e2480a3e0223eaa249432ff9a384872c366b7c59" +
+				"c50dbb44711c07fbe77a87ed150b6c2868e10dd34bc7e5580fa40564ecb59189",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signature, err := GetSignedExitMessage(key, strconv.FormatUint(test.validatorIndex, 10), test.epoch, domain)
+			if err != nil {
+				t.Fatalf("error signing exit: %v", err)
+			}
+
+			// VoluntaryExit is (epoch, validator_index), and the signing root is (object_root, domain)
+			objectRoot := hashChunks(uint64Chunk(test.epoch), uint64Chunk(test.validatorIndex))
+			signingRoot := hashChunks(objectRoot, domain)
+
+			// The signature must verify against the signing root computed by hand
+			blsSignature, err := eth2types.BLSSignatureFromBytes(signature[:])
+			if err != nil {
+				t.Fatalf("error parsing signature: %v", err)
+			}
+			if !blsSignature.Verify(signingRoot, key.PublicKey()) {
+				t.Fatal("signature doesn't verify against the spec signing root")
+			}
+			if hex.EncodeToString(signature[:]) != test.expectedSignature {
+				t.Errorf("expected signature %s, got %x", test.expectedSignature, signature[:])
+			}
+		})
+	}
+}
+
+func TestGetSignedExitMessageInvalidIndex(t *testing.T) {
+	key := newSpecKey(t)
+	for _, index := range []string{"", "-1", "0x10", "abc"} {
+		if _, err := GetSignedExitMessage(key, index, 0, make([]byte, 32)); err == nil {
+			t.Errorf("expected an error for validator index [%s]", index)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package wallet
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/rocket-pool/node-manager-core/wallet"
+)
+
+// Interface for hardware wallets, which sign with a key that never leaves the device.
+// LedgerSigner implements this; use NewHardwareWalletSigner to use any implementation as the node wallet.
+type IHardwareWallet interface {
+	// The address of the key on the device
+	GetAddress() (common.Address, error)
+
+	// Sign a transaction for the provided chain
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// Sign arbitrary data as a personal message
+	SignData(data []byte) ([]byte, error)
+}
+
+var _ IHardwareWallet = (*LedgerSigner)(nil)
+
+// Signer that adapts an IHardwareWallet for use with Wallet.UseHardwareWallet.
+// EIP-712 typed data isn't part of IHardwareWallet, so signing it returns ErrNotSupported.
+type hardwareWalletSigner struct {
+	hardwareWallet IHardwareWallet
+	chainID        *big.Int
+}
+
+// Creates a signer that delegates to the provided hardware wallet, signing transactions for the provided chain
+func NewHardwareWalletSigner(hardwareWallet IHardwareWallet, chainID uint) ISigner {
+	return &hardwareWalletSigner{
+		hardwareWallet: hardwareWallet,
+		chainID:        big.NewInt(int64(chainID)),
+	}
+}
+
+// Get the address of the key on the device
+func (s *hardwareWalletSigner) GetAddress() (common.Address, error) {
+	return s.hardwareWallet.GetAddress()
+}
+
+// Get a transactor that requests signatures from the device
+func (s *hardwareWalletSigner) GetTransactor() (*bind.TransactOpts, error) {
+	address, err := s.hardwareWallet.GetAddress()
+	if err != nil {
+		return nil, err
+	}
+	return &bind.TransactOpts{
+		From: address,
+		Signer: func(signerAddress common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if signerAddress != address {
+				return nil, bind.ErrNotAuthorized
+			}
+			return s.hardwareWallet.SignTx(tx, s.chainID)
+		},
+	}, nil
+}
+
+// Sign a message with the key on the device
+func (s *hardwareWalletSigner) SignMessage(message []byte) ([]byte, error) {
+	return s.hardwareWallet.SignData(message)
+}
+
+// Sign arbitrary data as a personal message with the key on the device
+func (s *hardwareWalletSigner) SignData(data []byte) ([]byte, error) {
+	return s.hardwareWallet.SignData(data)
+}
+
+// Sign a transaction with the key on the device
+func (s *hardwareWalletSigner) SignTransaction(serializedTx []byte) ([]byte, error) {
+	tx := types.Transaction{}
+	err := tx.UnmarshalBinary(serializedTx)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling TX: %w", err)
+	}
+	signedTx, err := s.hardwareWallet.SignTx(&tx, s.chainID)
+	if err != nil {
+		return nil, err
+	}
+	signedData, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling signed TX to binary: %w", err)
+	}
+	return signedData, nil
+}
+
+// Typed data signing isn't supported by IHardwareWallet
+func (s *hardwareWalletSigner) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// Typed data signing isn't supported by IHardwareWallet
+func (s *hardwareWalletSigner) SignTypedDataHash(domainSeparator []byte, structHash []byte) ([]byte, error) {
+	return nil, ErrNotSupported
+}
+
+// Wallet manager for hardware wallets, which delegates all signing to a signer for the device (such as a LedgerSigner).
+// Nothing about the wallet is stored on disk, since the private key never leaves the device.
+type hardwareWalletManager struct {
+	ISigner
+}
+
+// Creates a new wallet manager for the provided hardware wallet signer
+func newHardwareWalletManager(signer ISigner) *hardwareWalletManager {
+	return &hardwareWalletManager{
+		ISigner: signer,
+	}
+}
+
+// Get the type of this wallet manager
+func (m *hardwareWalletManager) GetType() wallet.WalletType {
+	return wallet.WalletType_Hardware
+}
+
+// Hardware wallets don't have any wallet data to serialize
+func (m *hardwareWalletManager) SerializeData() (string, error) {
+	return "", ErrNotSupported
+}
@@ -0,0 +1,252 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// The error message go-ethereum's Ledger driver returns when the device replies without a signature, which happens
+	// when the user rejects the request on the device
+	ledgerRejectedMessage string = "reply lacks signature"
+)
+
+// Signer for a node key stored on a Ledger hardware wallet; the private key never leaves the device, so every
+// signature has to be approved on it.
+type LedgerSigner struct {
+	hub     accounts.Backend
+	path    accounts.DerivationPath
+	chainID *big.Int
+	wallet  accounts.Wallet
+	account accounts.Account
+	lock    *sync.Mutex
+}
+
+// Creates a new Ledger signer. The hub should be go-ethereum's Ledger USB hub (usbwallet.NewLedgerHub()), which
+// enumerates the connected devices. The derivation path is a format string that the wallet index is applied to,
+// such as wallet.LedgerLiveNodeKeyPath.
+func NewLedgerSigner(hub accounts.Backend, derivationPath string, walletIndex uint, chainID uint) (*LedgerSigner, error) {
+	path, err := accounts.ParseDerivationPath(fmt.Sprintf(derivationPath, walletIndex))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing derivation path [%s] with index %d: %w", derivationPath, walletIndex, err)
+	}
+	return &LedgerSigner{
+		hub:     hub,
+		path:    path,
+		chainID: big.NewInt(int64(chainID)),
+		lock:    &sync.Mutex{},
+	}, nil
+}
+
+// Get the address of the key on the device at the signer's derivation path
+func (s *LedgerSigner) GetAddress() (common.Address, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.connect()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return s.account.Address, nil
+}
+
+// Get a transactor that requests signatures from the device
+func (s *LedgerSigner) GetTransactor() (*bind.TransactOpts, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	return &bind.TransactOpts{
+		From:   s.account.Address,
+		Signer: s.GetSignerFn(),
+	}, nil
+}
+
+// Get a signer function that requests signatures from the device, for use in transact opts
+func (s *LedgerSigner) GetSignerFn() bind.SignerFn {
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		err := s.connect()
+		if err != nil {
+			return nil, err
+		}
+		if address != s.account.Address {
+			return nil, bind.ErrNotAuthorized
+		}
+		return s.signTx(tx, s.chainID)
+	}
+}
+
+// Sign a transaction for the provided chain with the key on the device
+func (s *LedgerSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	return s.signTx(tx, chainID)
+}
+
+// Sign arbitrary data as a personal message with the key on the device
+func (s *LedgerSigner) SignData(data []byte) ([]byte, error) {
+	return s.SignMessage(data)
+}
+
+// Sign a message with the key on the device
+func (s *LedgerSigner) SignMessage(message []byte) ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	signedMessage, err := s.wallet.SignText(s.account, message)
+	if errors.Is(err, accounts.ErrNotSupported) {
+		return nil, ErrNotSupported
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error signing message: %w", s.translateError(err))
+	}
+	return signedMessage, nil
+}
+
+// Sign EIP-712 typed data with the key on the device
+func (s *LedgerSigner) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	_, rawData, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing typed data: %w", err)
+	}
+	return s.signTypedDataRaw([]byte(rawData))
+}
+
+// Sign EIP-712 typed data that has already been hashed into its domain separator and struct hash with the key on the
+// device
+func (s *LedgerSigner) SignTypedDataHash(domainSeparator []byte, structHash []byte) ([]byte, error) {
+	rawData, err := getTypedDataRaw(domainSeparator, structHash)
+	if err != nil {
+		return nil, err
+	}
+	return s.signTypedDataRaw(rawData)
+}
+
+// Sign the EIP-712 encoding of typed data (0x1901 || domain separator || struct hash) with the key on the device
+func (s *LedgerSigner) signTypedDataRaw(rawData []byte) ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.connect()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := s.wallet.SignData(s.account, accounts.MimetypeTypedData, rawData)
+	if err != nil {
+		return nil, fmt.Errorf("error signing typed data: %w", s.translateError(err))
+	}
+	if len(signature) != crypto.SignatureLength {
+		return nil, fmt.Errorf("hardware wallet returned a signature with invalid length %d", len(signature))
+	}
+
+	// Use 27/28 for the recovery ID
+	if signature[crypto.RecoveryIDOffset] < 27 {
+		signature[crypto.RecoveryIDOffset] += 27
+	}
+	return signature, nil
+}
+
+// Sign a transaction with the key on the device
+func (s *LedgerSigner) SignTransaction(serializedTx []byte) ([]byte, error) {
+	tx := types.Transaction{}
+	err := tx.UnmarshalBinary(serializedTx)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling TX: %w", err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err = s.connect()
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := s.signTx(&tx, s.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	signedData, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling signed TX to binary: %w", err)
+	}
+	return signedData, nil
+}
+
+// Sign a transaction with the device; assumes the lock is held and the signer is connected
+func (s *LedgerSigner) signTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signedTx, err := s.wallet.SignTx(s.account, tx, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("error signing TX: %w", s.translateError(err))
+	}
+	return signedTx, nil
+}
+
+// Find the device, open it, and derive the account at the signer's path if that hasn't been done already
+func (s *LedgerSigner) connect() error {
+	if s.wallet != nil {
+		return nil
+	}
+
+	wallets := s.hub.Wallets()
+	if len(wallets) == 0 {
+		return ErrHardwareWalletNotConnected
+	}
+	wallet := wallets[0]
+
+	err := wallet.Open("")
+	if err != nil && !errors.Is(err, accounts.ErrWalletAlreadyOpen) {
+		return fmt.Errorf("%w: %s", ErrHardwareWalletNotConnected, err.Error())
+	}
+	account, err := wallet.Derive(s.path, true)
+	if err != nil {
+		_ = wallet.Close()
+		return fmt.Errorf("error deriving node address from the hardware wallet: %w", s.translateError(err))
+	}
+
+	s.wallet = wallet
+	s.account = account
+	return nil
+}
+
+// Convert errors from the device into the hardware wallet errors where possible. If the device was disconnected, the
+// signer will try to find it again on the next request.
+func (s *LedgerSigner) translateError(err error) error {
+	if strings.Contains(err.Error(), ledgerRejectedMessage) {
+		return ErrHardwareWalletRejected
+	}
+	if errors.Is(err, accounts.ErrWalletClosed) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		if s.wallet != nil {
+			_ = s.wallet.Close()
+			s.wallet = nil
+		}
+		return fmt.Errorf("%w: %s", ErrHardwareWalletNotConnected, err.Error())
+	}
+	return err
+}
@@ -19,12 +19,19 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/google/uuid"
 	"github.com/tyler-smith/go-bip39"
 	eth2util "github.com/wealdtech/go-eth2-util"
 	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
+const (
+	// The error message the keystore encryptor returns when the password doesn't match the keystore's checksum.
+	// The encryptor doesn't export an error value for this, so the message has to be matched instead.
+	keystoreBadPasswordMessage string = "invalid checksum"
+)
+
 // Simple class to wrap a node's local wallet keystore.
 // Note that this does *not* manage the wallet data file on disk, though it does manage the
 // legacy keystore used by some integrations.
@@ -152,6 +159,19 @@ func (m *localWalletManager) VerifyPassword(password string) (bool, error) {
 	return bytes.Equal(trueBytes, candidateBytes), nil
 }
 
+// Checks if the provided password can decrypt the keystore in the provided wallet data.
+// Returns false if the password is wrong, or an error if the keystore couldn't be decrypted for any other reason.
+func canDecryptLocalWallet(data *wallet.LocalWalletData, password string) (bool, error) {
+	_, err := eth2ks.New().Decrypt(data.Crypto, password)
+	if err == nil {
+		return true, nil
+	}
+	if err.Error() == keystoreBadPasswordMessage {
+		return false, nil
+	}
+	return false, fmt.Errorf("error decrypting wallet keystore: %w", err)
+}
+
 // Load the node wallet's private key from the keystore
 func (m *localWalletManager) LoadWallet(data *wallet.LocalWalletData, password string) error {
 	// Decrypt the seed
@@ -214,6 +234,46 @@ func (m *localWalletManager) SignMessage(message []byte) ([]byte, error) {
 	return signedMessage, nil
 }
 
+// Signs arbitrary data as a personal message with the node wallet's private key
+func (m *localWalletManager) SignData(data []byte) ([]byte, error) {
+	return m.SignMessage(data)
+}
+
+// Signs EIP-712 typed data with the node wallet's private key
+func (m *localWalletManager) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	// Hash the domain separator and struct per EIP-712
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("error hashing typed data: %w", err)
+	}
+
+	signature, err := crypto.Sign(hash, m.nodePrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error signing typed data: %w", err)
+	}
+
+	// Use 27/28 for the recovery ID, like SignMessage
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// Signs EIP-712 typed data that has already been hashed into its domain separator and struct hash
+func (m *localWalletManager) SignTypedDataHash(domainSeparator []byte, structHash []byte) ([]byte, error) {
+	rawData, err := getTypedDataRaw(domainSeparator, structHash)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(crypto.Keccak256(rawData), m.nodePrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("error signing typed data: %w", err)
+	}
+
+	// Use 27/28 for the recovery ID, like SignMessage
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
 // Signs a transaction with the node wallet's private key
 func (m *localWalletManager) SignTransaction(serializedTx []byte) ([]byte, error) {
 	tx := types.Transaction{}
@@ -0,0 +1,250 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/rocket-pool/node-manager-core/wallet"
+	eth2ks "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// The "Mail" example from the EIP-712 specification, which ethers.js's TypedDataEncoder tests also use.
+// The signer is the spec's private key, keccak256("cow").
+var mailTypedData = apitypes.TypedData{
+	Types: apitypes.Types{
+		"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		},
+		"Person": {
+			{Name: "name", Type: "string"},
+			{Name: "wallet", Type: "address"},
+		},
+		"Mail": {
+			{Name: "from", Type: "Person"},
+			{Name: "to", Type: "Person"},
+			{Name: "contents", Type: "string"},
+		},
+	},
+	PrimaryType: "Mail",
+	Domain: apitypes.TypedDataDomain{
+		Name:              "Ether Mail",
+		Version:           "1",
+		ChainId:           math.NewHexOrDecimal256(1),
+		VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+	},
+	Message: apitypes.TypedDataMessage{
+		"from": map[string]any{
+			"name":   "Cow",
+			"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+		},
+		"to": map[string]any{
+			"name":   "Bob",
+			"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+		},
+		"contents": "Hello, Bob!",
+	},
+}
+
+const (
+	mailSignerAddress   string = "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"
+	mailDomainSeparator string = "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"
+	mailStructHash      string = "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e"
+	mailSigningHash     string = "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2"
+
+	// r || s || v for the payload above, from the EIP-712 reference example
+	mailSignature string = "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d" +
+		"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562" +
+		"1c"
+)
+
+// Create a local wallet manager that signs with the EIP-712 example key
+func newMailSigner(t *testing.T) *localWalletManager {
+	key, err := crypto.ToECDSA(crypto.Keccak256([]byte("cow")))
+	if err != nil {
+		t.Fatalf("error creating private key: %v", err)
+	}
+	if address := crypto.PubkeyToAddress(key.PublicKey); address != common.HexToAddress(mailSignerAddress) {
+		t.Fatalf("expected signer address %s, got %s", mailSignerAddress, address.Hex())
+	}
+	return &localWalletManager{
+		nodePrivateKey: key,
+		chainID:        big.NewInt(1),
+	}
+}
+
+func TestSignTypedDataFixture(t *testing.T) {
+	m := newMailSigner(t)
+
+	// Check the intermediate hashes so a mismatch points at the right step
+	hash, _, err := apitypes.TypedDataAndHash(mailTypedData)
+	if err != nil {
+		t.Fatalf("error hashing typed data: %v", err)
+	}
+	if hex.EncodeToString(hash) != mailSigningHash {
+		t.Errorf("expected signing hash %s, got %x", mailSigningHash, hash)
+	}
+
+	signature, err := m.SignTypedData(mailTypedData)
+	if err != nil {
+		t.Fatalf("error signing typed data: %v", err)
+	}
+	if len(signature) != crypto.SignatureLength {
+		t.Fatalf("expected a %d byte signature, got %d bytes", crypto.SignatureLength, len(signature))
+	}
+	if v := signature[crypto.RecoveryIDOffset]; v != 27 && v != 28 {
+		t.Errorf("expected a 27/28 recovery ID, got %d", v)
+	}
+	if hex.EncodeToString(signature) != mailSignature {
+		t.Errorf("expected signature %s, got %x", mailSignature, signature)
+	}
+
+	// The signature must recover to the signer
+	recoverable := bytes.Clone(signature)
+	recoverable[crypto.RecoveryIDOffset] -= 27
+	pubkey, err := crypto.SigToPub(hash, recoverable)
+	if err != nil {
+		t.Fatalf("error recovering signer: %v", err)
+	}
+	if address := crypto.PubkeyToAddress(*pubkey); address != common.HexToAddress(mailSignerAddress) {
+		t.Errorf("expected the signature to recover to %s, got %s", mailSignerAddress, address.Hex())
+	}
+}
+
+func TestSignTypedDataHashFixture(t *testing.T) {
+	m := newMailSigner(t)
+	domainSeparator, _ := hex.DecodeString(mailDomainSeparator)
+	structHash, _ := hex.DecodeString(mailStructHash)
+
+	signature, err := m.SignTypedDataHash(domainSeparator, structHash)
+	if err != nil {
+		t.Fatalf("error signing typed data hash: %v", err)
+	}
+	if hex.EncodeToString(signature) != mailSignature {
+		t.Errorf("expected signature %s, got %x", mailSignature, signature)
+	}
+
+	// The hashes have to be the right length
+	if _, err := m.SignTypedDataHash(domainSeparator[:31], structHash); err == nil {
+		t.Error("expected an error for a short domain separator")
+	}
+	if _, err := m.SignTypedDataHash(domainSeparator, append(structHash, 0)); err == nil {
+		t.Error("expected an error for a long struct hash")
+	}
+}
+
+func TestSignData(t *testing.T) {
+	m := newMailSigner(t)
+	data := []byte("node identity proof")
+
+	signature, err := m.SignData(data)
+	if err != nil {
+		t.Fatalf("error signing data: %v", err)
+	}
+	message, err := m.SignMessage(data)
+	if err != nil {
+		t.Fatalf("error signing message: %v", err)
+	}
+	if !bytes.Equal(signature, message) {
+		t.Errorf("expected SignData to match SignMessage, got %x and %x", signature, message)
+	}
+
+	// The signature must recover to the signer from the personal sign hash
+	recoverable := bytes.Clone(signature)
+	recoverable[crypto.RecoveryIDOffset] -= 27
+	pubkey, err := crypto.SigToPub(accounts.TextHash(data), recoverable)
+	if err != nil {
+		t.Fatalf("error recovering signer: %v", err)
+	}
+	if address := crypto.PubkeyToAddress(*pubkey); address != common.HexToAddress(mailSignerAddress) {
+		t.Errorf("expected the signature to recover to %s, got %s", mailSignerAddress, address.Hex())
+	}
+}
+
+func TestCanDecryptLocalWallet(t *testing.T) {
+	const password string = "correct horse battery staple"
+	keystore, err := eth2ks.New().Encrypt(bytes.Repeat([]byte{0x42}, 32), password)
+	if err != nil {
+		t.Fatalf("error encrypting keystore: %v", err)
+	}
+
+	// Create a copy of the keystore with one of its sections changed
+	withSection := func(section string, value any) map[string]any {
+		modified := map[string]any{}
+		for key, existing := range keystore {
+			modified[key] = existing
+		}
+		modified[section] = value
+		return modified
+	}
+
+	tests := []struct {
+		name       string
+		crypto     map[string]any
+		password   string
+		canDecrypt bool
+		isValid    bool
+	}{
+		{
+			name:       "correct password",
+			crypto:     keystore,
+			password:   password,
+			canDecrypt: true,
+			isValid:    true,
+		}, {
+			name:     "wrong password",
+			crypto:   keystore,
+			password: "wrong password",
+			isValid:  true,
+		}, {
+			name:     "empty password",
+			crypto:   keystore,
+			password: "",
+			isValid:  true,
+		}, {
+			name:     "missing keystore",
+			crypto:   nil,
+			password: password,
+		}, {
+			name:     "missing checksum",
+			crypto:   withSection("checksum", nil),
+			password: password,
+		}, {
+			name:     "unsupported KDF",
+			crypto:   withSection("kdf", map[string]any{"function": "unknown", "params": map[string]any{}}),
+			password: password,
+		}, {
+			name:     "malformed cipher",
+			crypto:   withSection("cipher", "not a cipher"),
+			password: password,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := &wallet.LocalWalletData{Crypto: test.crypto}
+			canDecrypt, err := canDecryptLocalWallet(data, test.password)
+			if !test.isValid {
+				if err == nil {
+					t.Errorf("expected an error for a malformed keystore, got %t", canDecrypt)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if canDecrypt != test.canDecrypt {
+				t.Errorf("expected %t, got %t", test.canDecrypt, canDecrypt)
+			}
+		})
+	}
+}
@@ -1 +1,22 @@
 package wallet
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Build the EIP-712 encoding of typed data that gets hashed for signing: 0x1901 || domain separator || struct hash
+func getTypedDataRaw(domainSeparator []byte, structHash []byte) ([]byte, error) {
+	if len(domainSeparator) != common.HashLength {
+		return nil, fmt.Errorf("domain separator must be %d bytes but it was %d", common.HashLength, len(domainSeparator))
+	}
+	if len(structHash) != common.HashLength {
+		return nil, fmt.Errorf("struct hash must be %d bytes but it was %d", common.HashLength, len(structHash))
+	}
+	rawData := make([]byte, 0, 2+2*common.HashLength)
+	rawData = append(rawData, 0x19, 0x01)
+	rawData = append(rawData, domainSeparator...)
+	rawData = append(rawData, structHash...)
+	return rawData, nil
+}
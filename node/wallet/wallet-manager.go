@@ -3,14 +3,12 @@ package wallet
 import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/rocket-pool/node-manager-core/wallet"
 )
 
-// Interface for wallet managers
-type IWalletManager interface {
-	// The type of wallet
-	GetType() wallet.WalletType
-
+// Interface for anything that can sign transactions and messages on behalf of the node address
+type ISigner interface {
 	// The wallet address
 	GetAddress() (common.Address, error)
 
@@ -20,9 +18,26 @@ type IWalletManager interface {
 	// Sign a message with the wallet's private key
 	SignMessage(message []byte) ([]byte, error)
 
+	// Sign arbitrary data as a personal message with the wallet's private key; this is the same as SignMessage
+	SignData(data []byte) ([]byte, error)
+
 	// Sign a transaction with the wallet's private key
 	SignTransaction(serializedTx []byte) ([]byte, error)
 
+	// Sign EIP-712 typed data with the wallet's private key
+	SignTypedData(typedData apitypes.TypedData) ([]byte, error)
+
+	// Sign EIP-712 typed data that has already been hashed into its domain separator and struct hash
+	SignTypedDataHash(domainSeparator []byte, structHash []byte) ([]byte, error)
+}
+
+// Interface for wallet managers
+type IWalletManager interface {
+	ISigner
+
+	// The type of wallet
+	GetType() wallet.WalletType
+
 	// Serialize the wallet data as JSON
 	SerializeData() (string, error)
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/tyler-smith/go-bip39"
 )
 
@@ -44,6 +45,12 @@ var (
 
 	// Provided password is not correct to unlock the wallet keystore
 	ErrInvalidPassword = errors.New("provided password is not correct for the loaded wallet")
+
+	// Attempted to use a hardware wallet, but it isn't connected or unlocked
+	ErrHardwareWalletNotConnected = errors.New("hardware wallet is not connected - please connect and unlock it, then open its Ethereum app")
+
+	// The user rejected a signing request on the hardware wallet
+	ErrHardwareWalletRejected = errors.New("the request was rejected on the hardware wallet")
 )
 
 // Wallet
@@ -98,7 +105,7 @@ func (w *Wallet) GetStatus() (wallet.WalletStatus, error) {
 	if w.walletManager != nil {
 		status.Wallet.IsLoaded = true
 		status.Wallet.Type = w.walletManager.GetType()
-		status.Wallet.IsOnDisk = true
+		status.Wallet.IsOnDisk = status.Wallet.Type == wallet.WalletType_Local
 		status.Wallet.WalletAddress, err = w.walletManager.GetAddress()
 		if err != nil {
 			return status, fmt.Errorf("error getting wallet address: %w", err)
@@ -115,11 +122,26 @@ func (w *Wallet) GetStatus() (wallet.WalletStatus, error) {
 	return status, nil
 }
 
+// Gets the signing capability of the wallet, which indicates whether it can sign transactions for the node address or
+// can only build them (e.g., when masquerading as another address)
+func (w *Wallet) GetSigningCapability() (wallet.SigningCapability, error) {
+	status, err := w.GetStatus()
+	if err != nil {
+		return wallet.SigningCapability_None, err
+	}
+	return wallet.GetSigningCapability(status), nil
+}
+
 // Reloads the wallet artifacts from disk
 func (w *Wallet) Reload(logger *slog.Logger) error {
 	w.lock.Lock()
 	defer w.lock.Unlock()
 
+	// A hardware wallet and its node address stay in use, since they don't have anything on disk to reload
+	if w.walletManager != nil && w.walletManager.GetType() == wallet.WalletType_Hardware {
+		return nil
+	}
+
 	// Load the password
 	password, isPasswordSaved, err := w.passwordManager.GetPasswordFromDisk()
 	if err != nil {
@@ -206,6 +228,12 @@ func (w *Wallet) SignMessage(message []byte) ([]byte, error) {
 	return w.walletManager.SignMessage(message)
 }
 
+// Sign arbitrary data as a personal message with the wallet's private key, using Ethereum's personal sign prefix.
+// This is the same as SignMessage.
+func (w *Wallet) SignData(data []byte) ([]byte, error) {
+	return w.SignMessage(data)
+}
+
 // Sign a transaction with the wallet's private key
 func (w *Wallet) SignTransaction(serializedTx []byte) ([]byte, error) {
 	w.lock.Lock()
@@ -217,6 +245,46 @@ func (w *Wallet) SignTransaction(serializedTx []byte) ([]byte, error) {
 	return w.walletManager.SignTransaction(serializedTx)
 }
 
+// Sign EIP-712 typed data with the wallet's private key
+func (w *Wallet) SignTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.walletManager == nil {
+		return nil, ErrWalletNotLoaded
+	}
+	return w.walletManager.SignTypedData(typedData)
+}
+
+// Sign EIP-712 typed data that has already been hashed into its domain separator and struct hash with the wallet's
+// private key
+func (w *Wallet) SignTypedDataHash(domainSeparator []byte, structHash []byte) ([]byte, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.walletManager == nil {
+		return nil, ErrWalletNotLoaded
+	}
+	return w.walletManager.SignTypedDataHash(domainSeparator, structHash)
+}
+
+// Use a hardware wallet, such as a LedgerSigner or an IHardwareWallet wrapped with NewHardwareWalletSigner, as the node
+// wallet. All signing is delegated to the device, and the node address is set to the device's address.
+// The device can't be saved, so none of this is written to disk: the local wallet and node address files are left
+// alone, and the hardware wallet has to be set up again each time the node starts.
+func (w *Wallet) UseHardwareWallet(signer ISigner) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	address, err := signer.GetAddress()
+	if err != nil {
+		return fmt.Errorf("error getting hardware wallet address: %w", err)
+	}
+	w.addressManager.SetAddress(address)
+	w.walletManager = newHardwareWalletManager(signer)
+	return nil
+}
+
 // Masquerade as another node address, running all node functions as that address (in read only mode)
 func (w *Wallet) MasqueradeAsAddress(newAddress common.Address) error {
 	w.lock.Lock()
@@ -337,6 +405,32 @@ func (w *Wallet) SetPassword(password string, save bool) error {
 	return nil
 }
 
+// Checks if the provided password can decrypt the wallet keystore on disk, without loading the wallet or changing any
+// of its state
+func (w *Wallet) VerifyPassword(password string) (bool, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	isWalletOnDisk, err := w.isWalletDataOnDisk()
+	if err != nil {
+		return false, fmt.Errorf("error checking if wallet data is on disk: %w", err)
+	}
+	if !isWalletOnDisk {
+		return false, ErrKeystoreNotPresent
+	}
+	data, err := w.readWalletData()
+	if err != nil {
+		return false, err
+	}
+
+	switch data.Type {
+	case wallet.WalletType_Local:
+		return canDecryptLocalWallet(&data.LocalData, password)
+	default:
+		return false, ErrNotSupported
+	}
+}
+
 // Retrieves the wallet's password
 func (w *Wallet) GetPassword() (string, bool, error) {
 	w.lock.Lock()
@@ -478,8 +572,8 @@ func (w *Wallet) isWalletDataOnDisk() (bool, error) {
 	return true, nil
 }
 
-// Load the wallet data from disk
-func (w *Wallet) loadWalletData(password string) (IWalletManager, error) {
+// Read the wallet data from disk without decrypting it
+func (w *Wallet) readWalletData() (*wallet.WalletData, error) {
 	// Read the file
 	bytes, err := os.ReadFile(w.walletDataPath)
 	if err != nil {
@@ -492,6 +586,15 @@ func (w *Wallet) loadWalletData(password string) (IWalletManager, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error deserializing wallet data at [%s]: %w", w.walletDataPath, err)
 	}
+	return data, nil
+}
+
+// Load the wallet data from disk
+func (w *Wallet) loadWalletData(password string) (IWalletManager, error) {
+	data, err := w.readWalletData()
+	if err != nil {
+		return nil, err
+	}
 
 	// Load the proper type
 	var manager IWalletManager
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/goccy/go-json"
 	"github.com/rocket-pool/node-manager-core/beacon"
 	"github.com/rocket-pool/node-manager-core/eth"
@@ -283,6 +284,24 @@ func ValidateByteArray(name, value string) ([]byte, error) {
 	return bytes, nil
 }
 
+// Validate an EIP-712 signature, which must be 65 bytes long
+func ValidateEip712Signature(name, value string) ([]byte, error) {
+	// Remove a 0x prefix if present
+	value = strings.TrimPrefix(value, "0x")
+
+	// Try to parse the string (removing the prefix)
+	bytes, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid %s '%s': %w", name, value, err)
+	}
+
+	// Signature should be 65 bytes long
+	if len(bytes) != crypto.SignatureLength {
+		return nil, fmt.Errorf("Invalid %s '%s': it must be %d bytes long.", name, value, crypto.SignatureLength)
+	}
+	return bytes, nil
+}
+
 // Validate a duration
 func ValidateDuration(name, value string) (time.Duration, error) {
 	duration, err := time.ParseDuration(value)
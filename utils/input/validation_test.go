@@ -0,0 +1,74 @@
+package input
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateEip712Signature(t *testing.T) {
+	// The signature from the EIP-712 reference example
+	signature := "4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d" +
+		"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562" +
+		"1c"
+
+	tests := []struct {
+		name    string
+		value   string
+		isValid bool
+	}{
+		{
+			name:    "with prefix",
+			value:   "0x" + signature,
+			isValid: true,
+		}, {
+			name:    "without prefix",
+			value:   signature,
+			isValid: true,
+		}, {
+			name:    "uppercase hex",
+			value:   "0x" + strings.ToUpper(signature),
+			isValid: true,
+		}, {
+			name:    "invalid hex",
+			value:   "0x" + signature[:128] + "zz",
+			isValid: false,
+		}, {
+			name:    "odd length",
+			value:   "0x" + signature[:129],
+			isValid: false,
+		}, {
+			name:    "missing recovery ID",
+			value:   "0x" + signature[:128],
+			isValid: false,
+		}, {
+			name:    "extra byte",
+			value:   "0x" + signature + "00",
+			isValid: false,
+		}, {
+			name:    "empty",
+			value:   "",
+			isValid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bytes, err := ValidateEip712Signature("signature", test.value)
+			if !test.isValid {
+				if err == nil {
+					t.Errorf("expected an error for [%s]", test.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error for [%s]: %v", test.value, err)
+			}
+			if len(bytes) != 65 {
+				t.Errorf("expected 65 bytes, got %d", len(bytes))
+			}
+			if bytes[64] != 0x1c {
+				t.Errorf("expected the recovery ID to be 0x1c, got 0x%02x", bytes[64])
+			}
+		})
+	}
+}
@@ -10,9 +10,7 @@ import (
 
 // Check if the node wallet is ready for transacting
 func IsWalletReady(status wallet.WalletStatus) bool {
-	return status.Address.HasAddress &&
-		status.Wallet.IsLoaded &&
-		status.Address.NodeAddress == status.Wallet.WalletAddress
+	return wallet.GetSigningCapability(status) == wallet.SigningCapability_Full
 }
 
 // Generates a random password
@@ -50,6 +50,21 @@ const (
 	WalletType_Hardware WalletType = "hardware"
 )
 
+// An enum describing what the node wallet is able to do with transactions
+type SigningCapability string
+
+const (
+	// There's no node address, so transactions can't be built or signed
+	SigningCapability_None SigningCapability = "none"
+
+	// There's a node address but no wallet for it is loaded (e.g., it's masquerading as another address), so transactions
+	// can be built and simulated but can't be signed
+	SigningCapability_AddressOnly SigningCapability = "addressOnly"
+
+	// The wallet for the node address is loaded, so transactions can be built and signed
+	SigningCapability_Full SigningCapability = "full"
+)
+
 // Keystore for local node wallets - note that this is NOT an EIP-2335 keystore.
 type LocalWalletData struct {
 	// Encrypted seed information
@@ -1,12 +1,27 @@
 package wallet
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 // Check if the node wallet is ready for transacting
 func IsWalletReady(status WalletStatus) bool {
-	return status.Address.HasAddress &&
-		status.Wallet.IsLoaded &&
-		status.Address.NodeAddress == status.Wallet.WalletAddress
+	return GetSigningCapability(status) == SigningCapability_Full
+}
+
+// Get the signing capability of the node wallet based on its status
+func GetSigningCapability(status WalletStatus) SigningCapability {
+	if !status.Address.HasAddress {
+		return SigningCapability_None
+	}
+	if status.Wallet.IsLoaded && status.Address.NodeAddress == status.Wallet.WalletAddress {
+		return SigningCapability_Full
+	}
+	return SigningCapability_AddressOnly
 }
 
 // Convert a derivation path type to an actual path value
@@ -23,3 +38,28 @@ func GetDerivationPath(pathType DerivationPath) (string, error) {
 		return "", fmt.Errorf("[%s] is not a valid derivation path type", string(pathType))
 	}
 }
+
+// Recover the address that signed a message using the EIP-191 personal_sign format.
+// The signature's recovery ID can be either 27/28 or 0/1.
+func VerifyMessageSignature(message []byte, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature must be %d bytes but it was %d bytes", crypto.SignatureLength, len(signature))
+	}
+
+	// Normalize the recovery ID to 0/1 without modifying the original signature
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	if sig[crypto.RecoveryIDOffset] > 1 {
+		return common.Address{}, fmt.Errorf("signature has invalid recovery ID %d", signature[crypto.RecoveryIDOffset])
+	}
+
+	// Recover the signer
+	pubkey, err := crypto.SigToPub(accounts.TextHash(message), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("error recovering signer from signature: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pubkey), nil
+}